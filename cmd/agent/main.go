@@ -6,12 +6,16 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	_ "github.com/sumandas0/k8s-cluster-agent/docs"
 	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/factory"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/healthhistory"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
 	"github.com/sumandas0/k8s-cluster-agent/internal/kubernetes"
 	"github.com/sumandas0/k8s-cluster-agent/internal/logging"
+	"github.com/sumandas0/k8s-cluster-agent/internal/redaction"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/router"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/server"
 )
@@ -67,9 +71,45 @@ func main() {
 		os.Exit(1)
 	}
 
-	services := factory.NewServices(k8sClients, cfg, logger)
+	scoring, err := scoringpolicy.Load(cfg.HealthScoringPolicyFile)
+	if err != nil {
+		logger.Error("failed to load health scoring policy", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("loaded health scoring policy", "version", scoring.Version())
+
+	var history *healthhistory.Store
+	if cfg.HealthHistoryInterval > 0 {
+		history, err = healthhistory.Open(cfg.HealthHistoryFile, cfg.HealthHistoryRetention, cfg.HealthHistoryMaxSeries)
+		if err != nil {
+			// A damaged history file should not keep the agent down, nor be
+			// overwritten by the new history; when it cannot be moved out of
+			// the way the history is kept in memory only.
+			path := cfg.HealthHistoryFile
+			if aside, asideErr := healthhistory.SetAside(path, time.Now()); asideErr != nil {
+				logger.Warn("failed to load health history, starting empty without saving", "error", err, "setAsideError", asideErr)
+				path = ""
+			} else {
+				logger.Warn("failed to load health history, starting empty", "error", err, "movedTo", aside)
+			}
+			history = healthhistory.New(path, cfg.HealthHistoryRetention, cfg.HealthHistoryMaxSeries)
+		}
+	}
+
+	services := factory.NewServices(k8sClients, cfg, scoring, history, logger)
+
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go services.HealthHistory.Run(backgroundCtx)
+	go services.Throttling.Run(backgroundCtx)
+
+	redactor, err := redaction.New(redaction.PolicyFromConfig(cfg))
+	if err != nil {
+		logger.Error("failed to initialize redaction policy", "error", err)
+		os.Exit(1)
+	}
 
-	r := router.NewRouter(services, logger)
+	r := router.NewRouter(services, redactor, logger)
 
 	httpServer := server.New(cfg, r, logger)
 
@@ -85,6 +125,7 @@ func main() {
 	<-quit
 
 	logger.Info("received shutdown signal")
+	stopBackground()
 
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
@@ -22,9 +22,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cluster/pod-issues": {
+        "/allocation": {
             "get": {
-                "description": "Returns an aggregated view of pod issues across the cluster with pattern detection and trend analysis",
+                "description": "Sums requests, limits, metrics-server usage and idle resources of the running pods across all namespaces, grouped by namespace or by a pod label, and estimates their cost",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,38 +34,26 @@ const docTemplate = `{
                 "tags": [
                     "Cluster"
                 ],
-                "summary": "Get cluster-wide pod issues",
+                "summary": "Get cluster resource allocation and cost",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by namespace (default: all)",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by severity (critical, warning, info)",
-                        "name": "severity",
+                        "description": "namespace (default) or label:\u003ckey\u003e, e.g. label:team",
+                        "name": "groupBy",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Cluster issues dashboard",
+                        "description": "Cluster allocation report",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AllocationReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterIssues"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
                     "408": {
@@ -83,32 +71,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthz": {
-            "get": {
-                "description": "Returns the health status of the K8s Cluster Agent service",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health check endpoint",
-                "responses": {
-                    "200": {
-                        "description": "Service is healthy",
-                        "schema": {
-                            "$ref": "#/definitions/internal_transport_http_handlers.HealthResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/namespace/{namespace}/error": {
+        "/capacity": {
             "get": {
-                "description": "Returns a comprehensive error analysis report for all pods in the specified namespace",
+                "description": "Computes per node and in total how many additional pods with the given requests fit, taking current allocations, taints and node selectors into account, and reports fragmentation and the largest pod that fits anywhere",
                 "consumes": [
                     "application/json"
                 ],
@@ -116,35 +81,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Namespace"
+                    "Nodes"
                 ],
-                "summary": "Get namespace error analysis",
+                "summary": "Get cluster capacity for a pod shape",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Namespace name",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "description": "CPU request per pod, e.g. 2 or 500m",
+                        "name": "cpu",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Memory request per pod, e.g. 4Gi",
+                        "name": "memory",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "nvidia.com/gpu request per pod",
+                        "name": "gpu",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Node selector as comma-separated key=value pairs",
+                        "name": "nodeSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tolerations as key[=value][:effect]",
+                        "name": "tolerations",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Namespace error analysis report",
+                        "description": "Capacity report",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorReport"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_CapacityReport"
                         }
                     },
                     "400": {
@@ -153,6 +129,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -162,9 +144,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/nodes/{nodeName}/utilization": {
+        "/cluster/health-score": {
             "get": {
-                "description": "Returns CPU and memory utilization metrics for the specified node (requires metrics server)",
+                "description": "Returns a priority-weighted health score (0-100) for the cluster with a score per namespace and the worst workloads and pods",
                 "consumes": [
                     "application/json"
                 ],
@@ -172,47 +154,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Nodes"
-                ],
-                "summary": "Get node utilization metrics",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Node name",
-                        "name": "nodeName",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Cluster"
                 ],
+                "summary": "Get cluster health score",
                 "responses": {
                     "200": {
-                        "description": "Node utilization metrics",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilization"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - invalid parameters",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Node not found",
+                        "description": "Cluster health score",
                         "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AggregateHealthScore"
                         }
                     },
                     "408": {
@@ -226,19 +175,13 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
-                    },
-                    "503": {
-                        "description": "Metrics server not available",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/pods/{namespace}/{podName}/describe": {
+        "/cluster/health-score/trends": {
             "get": {
-                "description": "Returns comprehensive pod information including status, containers, volumes, and conditions",
+                "description": "Returns the recorded health trend of every workload in the cluster, the most degrading first, with the latest score, the slope per hour and when a decline started",
                 "consumes": [
                     "application/json"
                 ],
@@ -246,43 +189,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Cluster"
                 ],
-                "summary": "Get pod description",
+                "summary": "Get cluster workload health trends",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Namespace name",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "description": "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)",
+                        "name": "since",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Include the recorded scores of each workload (default: false)",
+                        "name": "includePoints",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod description",
+                        "description": "Cluster workload health trends",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_WorkloadHealthTrends"
                         }
                     },
                     "400": {
@@ -291,20 +219,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pod not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
-                    "408": {
-                        "description": "Request timeout",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                    "503": {
+                        "description": "Health score history is disabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
@@ -312,9 +228,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{namespace}/{podName}/failure-events": {
+        "/cluster/pod-issues": {
             "get": {
-                "description": "Returns categorized and analyzed failure events with insights and recommendations",
+                "description": "Returns an aggregated view of pod issues across the cluster with pattern detection and trend analysis",
                 "consumes": [
                     "application/json"
                 ],
@@ -322,42 +238,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Cluster"
                 ],
-                "summary": "Get pod failure events analysis",
+                "summary": "Get cluster-wide pod issues",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Namespace name",
+                        "description": "Filter by namespace (default: all)",
                         "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by severity (critical, warning, info)",
+                        "name": "severity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector for the analyzed pods, e.g. app=checkout",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field selector for the analyzed pods",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only analyze pods whose owner or Deployment has this name",
+                        "name": "ownerName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only analyze pods on this node",
+                        "name": "nodeName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only report issues of this category, e.g. CrashLoop",
+                        "name": "issueType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum critical issues per page (1-500, default: 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod failure events analysis",
+                        "description": "Cluster issues dashboard",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFailureEvents"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_ClusterIssues"
                         }
                     },
                     "400": {
@@ -366,12 +310,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pod not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
                     "408": {
                         "description": "Request timeout",
                         "schema": {
@@ -387,9 +325,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{namespace}/{podName}/health-score": {
+        "/healthz": {
             "get": {
-                "description": "Returns a health score (0-100) with detailed component analysis including restarts, container states, events, and uptime",
+                "description": "Returns the health status of the K8s Cluster Agent service",
                 "consumes": [
                     "application/json"
                 ],
@@ -397,9 +335,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Health"
                 ],
-                "summary": "Get pod health score",
+                "summary": "Health check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "Service is healthy",
+                        "schema": {
+                            "$ref": "#/definitions/internal_transport_http_handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespace/{namespace}/allocation": {
+            "get": {
+                "description": "Sums requests, limits, metrics-server usage and idle (requested but unused) resources of the running pods in the namespace and estimates their cost from per-vCPU-hour, per-GiB-hour and per-GPU-hour prices, with node-pool-specific prices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespace"
+                ],
+                "summary": "Get namespace resource allocation and cost",
                 "parameters": [
                     {
                         "type": "string",
@@ -410,29 +371,16 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
-                        "in": "path",
-                        "required": true
+                        "description": "namespace (default) or label:\u003ckey\u003e, e.g. label:app",
+                        "name": "groupBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod health score with detailed analysis",
+                        "description": "Namespace allocation report",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthScore"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AllocationReport"
                         }
                     },
                     "400": {
@@ -441,12 +389,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pod not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
                     "408": {
                         "description": "Request timeout",
                         "schema": {
@@ -462,9 +404,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{namespace}/{podName}/resources": {
+        "/namespace/{namespace}/error": {
             "get": {
-                "description": "Returns detailed resource requirements (CPU, memory) for all containers in the pod",
+                "description": "Returns a comprehensive error analysis report for the pods in the specified namespace. Pods of every owner kind are analyzed unless ownerKinds narrows them; Job and CronJob pods are judged by their Job's outcome",
                 "consumes": [
                     "application/json"
                 ],
@@ -472,9 +414,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Namespace"
                 ],
-                "summary": "Get pod resource information",
+                "summary": "Get namespace error analysis",
                 "parameters": [
                     {
                         "type": "string",
@@ -485,45 +427,62 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Pod resource information",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodResources"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                        "description": "Comma-separated owner kinds to analyze, e.g. DaemonSet,Job; Pod selects pods without an owner (default: all)",
+                        "name": "ownerKinds",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad request - invalid parameters",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Label selector for the analyzed pods, e.g. app=checkout",
+                        "name": "labelSelector",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Pod not found",
+                    {
+                        "type": "string",
+                        "description": "Field selector for the analyzed pods, e.g. status.phase!=Running",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only analyze pods whose owner or workload has this name",
+                        "name": "ownerName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only analyze pods on this node",
+                        "name": "nodeName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only report issues of this type, e.g. CrashLoopBackOff",
+                        "name": "issueType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum problematic pods per page (1-500, default: all)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace error analysis report",
                         "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceErrorReport"
                         }
                     },
-                    "408": {
-                        "description": "Request timeout",
+                    "400": {
+                        "description": "Bad request - invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
@@ -537,9 +496,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{namespace}/{podName}/scheduling": {
+        "/namespace/{namespace}/events": {
             "get": {
-                "description": "Returns comprehensive scheduling analysis including placement decisions, failure reasons, and constraints",
+                "description": "Aggregates the namespace's Warning events by reason and involved object kind, with counts, affected objects and first/last seen times",
                 "consumes": [
                     "application/json"
                 ],
@@ -547,9 +506,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Namespace"
                 ],
-                "summary": "Get pod scheduling information",
+                "summary": "Get namespace event digest",
                 "parameters": [
                     {
                         "type": "string",
@@ -557,32 +516,57 @@ const docTemplate = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace event digest",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceEventDigest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespace/{namespace}/health-score": {
+            "get": {
+                "description": "Returns a priority-weighted health score (0-100) for the namespace built from the health scores of its running pods grouped by workload, with replica readiness penalties and the worst workloads and pods",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespace"
+                ],
+                "summary": "Get namespace health score",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
+                        "description": "Namespace name",
+                        "name": "namespace",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod scheduling information",
+                        "description": "Namespace health score",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodScheduling"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AggregateHealthScore"
                         }
                     },
                     "400": {
@@ -591,12 +575,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pod not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
-                        }
-                    },
                     "408": {
                         "description": "Request timeout",
                         "schema": {
@@ -612,9 +590,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{namespace}/{podName}/scheduling/explain": {
+        "/namespace/{namespace}/health-score/trends": {
             "get": {
-                "description": "Returns Elasticsearch-style detailed explanations for pod scheduling decisions with per-node analysis",
+                "description": "Returns the recorded health trend of every workload in the namespace, the most degrading first, with the latest score, the slope per hour and when a decline started",
                 "consumes": [
                     "application/json"
                 ],
@@ -622,9 +600,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pods"
+                    "Namespace"
                 ],
-                "summary": "Get detailed pod scheduling explanation",
+                "summary": "Get namespace workload health trends",
                 "parameters": [
                     {
                         "type": "string",
@@ -635,29 +613,66 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pod name",
-                        "name": "podName",
+                        "description": "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include the recorded scores of each workload (default: false)",
+                        "name": "includePoints",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace workload health trends",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_WorkloadHealthTrends"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Health score history is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespace/{namespace}/quotas": {
+            "get": {
+                "description": "Returns used and hard values of every ResourceQuota with percentages, scopes and the workloads consuming the most quota, and the LimitRange minimums, maximums and defaults applied to containers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespace"
+                ],
+                "summary": "Get namespace quota utilization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod scheduling explanation",
+                        "description": "Namespace quota report",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingExplanation"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceQuotaReport"
                         }
                     },
                     "400": {
@@ -666,8 +681,46 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pod not found",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespace/{namespace}/security": {
+            "get": {
+                "description": "Evaluates all pods in the namespace against the Pod Security Standards and reports how many would be blocked if baseline or restricted were enforced",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespace"
+                ],
+                "summary": "Get namespace security posture audit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace security audit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceSecurityAudit"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
@@ -687,9 +740,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/readyz": {
+        "/namespace/{namespace}/startup-stats": {
             "get": {
-                "description": "Returns the readiness status of the K8s Cluster Agent service",
+                "description": "Returns p50/p95/max startup phase durations per workload for pods in the namespace that became Ready without restarting",
                 "consumes": [
                     "application/json"
                 ],
@@ -697,641 +750,4796 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Namespace"
+                ],
+                "summary": "Get namespace startup statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Readiness check endpoint",
                 "responses": {
                     "200": {
-                        "description": "Service is ready",
+                        "description": "Namespace startup statistics",
                         "schema": {
-                            "$ref": "#/definitions/internal_transport_http_handlers.HealthResponse"
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceStartupStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AffinityExplanation": {
-            "type": "object",
-            "properties": {
-                "nodeAffinity": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeAffinityDetail"
-                },
-                "nodeSelector": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SelectorExplanation"
-                },
-                "summary": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterIssues": {
-            "type": "object",
-            "properties": {
-                "calculatedAt": {
-                    "type": "string"
-                },
-                "criticalIssues": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue"
-                    }
-                },
-                "healthyPods": {
-                    "type": "integer"
-                },
-                "issueCategories": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
+        "/nodes": {
+            "get": {
+                "description": "Lists nodes with kubelet version, container runtime, OS, architecture, taints, allocatable resources and cordon state, grouped by region, zone and node pool. Flags kubelet version skew against the control plane and pools whose nodes differ",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "List nodes with topology and platform details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kubernetes label selector, e.g. kubernetes.io/arch=arm64",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Node label that identifies the pool (default: well-known GKE, EKS, AKS and Karpenter labels)",
+                        "name": "poolLabel",
+                        "in": "query"
                     }
-                },
-                "issueVelocity": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueVelocity"
-                },
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node inventory",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeInventory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/utilization": {
+            "get": {
+                "description": "Returns CPU and memory utilization for every node matching the label selector, with cluster-wide totals (requires metrics server)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "List node utilization metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sort order: cpu, memory or name (default: cpu)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kubernetes label selector, e.g. topology.kubernetes.io/zone=us-east-1a",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return nodes with CPU utilization above this percentage",
+                        "name": "cpuAbove",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return nodes with memory utilization above this percentage",
+                        "name": "memoryAbove",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node utilization list",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeUtilizationList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Metrics server not available",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{nodeName}/drain-simulation": {
+            "post": {
+                "description": "Reports which pods on the node are DaemonSet-managed, mirror, unmanaged or use local storage, which evictions PodDisruptionBudgets would block, and whether each evicted pod fits on another node. Nothing in the cluster is changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Simulate draining a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Drain simulation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_DrainSimulation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{nodeName}/eviction-ranking": {
+            "get": {
+                "description": "Ranks the pods on the node in the order the kubelet would evict them under memory or ephemeral-storage pressure (usage above request, then priority, then overshoot) and reports headroom against the kubelet's hard eviction thresholds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get node eviction ranking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pressure signal: memory or ephemeral-storage (default: memory)",
+                        "name": "signal",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Eviction ranking",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_EvictionRanking"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Metrics server not available",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{nodeName}/health": {
+            "get": {
+                "description": "Scores the node from its conditions (including node-problem-detector conditions), kubelet Lease heartbeat, recent node events and cordon state, and reports kubelet and runtime versions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get node health diagnostics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node health",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeHealth"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{nodeName}/pods": {
+            "get": {
+                "description": "Returns requests, limits, QoS class and live usage (when pod metrics are available) for every pod on the node, top consumers first, with totals against allocatable",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get per-pod resource breakdown for a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: cpu, memory or name (default: cpu)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node pod breakdown",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodePodBreakdown"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{nodeName}/utilization": {
+            "get": {
+                "description": "Returns CPU and memory utilization metrics for the specified node (requires metrics server)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nodes"
+                ],
+                "summary": "Get node utilization metrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node utilization metrics",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeUtilization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Node not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Metrics server not available",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/compare": {
+            "get": {
+                "description": "Diffs images and digests, env, resources, node, node labels and taints, volumes, QoS, owner revision and container states of two pods.\nNoisy fields such as UIDs, timestamps and IPs are ignored. Credential-like env values are redacted unless unredacted=true is sent with a valid bearer token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Compare two pods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First pod as namespace/name",
+                        "name": "a",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second pod as namespace/name",
+                        "name": "b",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return env values without redaction (requires bearer token)",
+                        "name": "unredacted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod comparison",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodComparison"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token for unredacted mode",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Unredacted mode is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/describe": {
+            "get": {
+                "description": "Returns comprehensive pod information including status, containers, volumes, and conditions.\nCredential-like environment values and non-allowlisted annotations are redacted unless unredacted=true is sent with a valid bearer token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod description",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return environment values and annotations without redaction (requires bearer token)",
+                        "name": "unredacted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod description",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodDescription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token for unredacted mode",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Unredacted mode is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/failure-events": {
+            "get": {
+                "description": "Returns categorized and analyzed failure events with insights and recommendations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod failure events analysis",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod failure events analysis",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodFailureEvents"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/health-score": {
+            "get": {
+                "description": "Returns a health score (0-100) with detailed component analysis including restarts, container states, events, and uptime",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod health score",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod health score with detailed analysis",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodHealthScore"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/health-score/history": {
+            "get": {
+                "description": "Returns the health scores recorded for a pod at each history interval with its trend: the least squares slope per hour and, when the score has fallen noticeably, when the decline started. History is kept after the pod is gone until it ages out of retention",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod health score history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod health score history",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodHealthHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No history recorded for the pod",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Health score history is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/resources": {
+            "get": {
+                "description": "Returns detailed resource requirements (CPU, memory) for all containers in the pod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod resource information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod resource information",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodResources"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/scheduling": {
+            "get": {
+                "description": "Returns comprehensive scheduling analysis including placement decisions, failure reasons, and constraints",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod scheduling information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod scheduling information",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodScheduling"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/scheduling/explain": {
+            "get": {
+                "description": "Returns Elasticsearch-style detailed explanations for pod scheduling decisions with per-node analysis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get detailed pod scheduling explanation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod scheduling explanation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_SchedulingExplanation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/security": {
+            "get": {
+                "description": "Evaluates the pod against the baseline and restricted Pod Security Standards and the namespace's pod-security.kubernetes.io labels",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod security posture audit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod security audit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodSecurityAudit"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/startup": {
+            "get": {
+                "description": "Breaks the time from creation to Ready into scheduling, image pull, init container and app readiness phases using pod conditions and Scheduled/Pulling/Pulled events",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod startup latency",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod startup latency",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodStartupLatency"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{namespace}/{podName}/timeline": {
+            "get": {
+                "description": "Merges pod creation, condition transitions, container start/termination times, image pulls, probe failures and kills into one chronological list with the gap between steps",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pods"
+                ],
+                "summary": "Get pod lifecycle timeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace name",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod name",
+                        "name": "podName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod timeline",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodTimeline"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pod not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Returns the readiness status of the K8s Cluster Agent service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "Service is ready",
+                        "schema": {
+                            "$ref": "#/definitions/internal_transport_http_handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AffinityExplanation": {
+            "type": "object",
+            "properties": {
+                "nodeAffinity": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeAffinityDetail"
+                },
+                "nodeSelector": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SelectorExplanation"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AggregateHealthScore": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "namespaces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceHealthItem"
+                    }
+                },
+                "overallScore": {
+                    "type": "integer"
+                },
+                "podsScored": {
+                    "type": "integer"
+                },
+                "scoringPolicy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ScoringPolicyRef"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "workloadCount": {
+                    "type": "integer"
+                },
+                "worstPods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthItem"
+                    }
+                },
+                "worstWorkloads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthScore"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationCost": {
+            "type": "object",
+            "properties": {
+                "hourly": {
+                    "type": "number"
+                },
+                "idleHourly": {
+                    "type": "number"
+                },
+                "idleMonthly": {
+                    "type": "number"
+                },
+                "monthly": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationGroup": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationCost"
+                },
+                "idle": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationResources"
+                },
+                "limits": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationResources"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pods": {
+                    "type": "integer"
+                },
+                "requests": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationResources"
+                },
+                "usage": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationResources"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationPricing": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourcePricing"
+                },
+                "nodePools": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourcePricing"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationReport": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "groupBy": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationGroup"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pricing": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationPricing"
+                },
+                "total": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationGroup"
+                },
+                "usageAvailable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationResources": {
+            "type": "object",
+            "properties": {
+                "cpuCores": {
+                    "type": "number"
+                },
+                "gpus": {
+                    "type": "number"
+                },
+                "memoryGiB": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.AnalysisPolicy": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ignoreIssueTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "overrides": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pendingGracePeriod": {
+                    "type": "string"
+                },
+                "restartThreshold": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.CapacityReport": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "eligibleNodes": {
+                    "type": "integer"
+                },
+                "fragmentation": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFragmentation"
+                    }
+                },
+                "largestByCpu": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFit"
+                },
+                "largestByMemory": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFit"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeCapacity"
+                    }
+                },
+                "shape": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "totalFit": {
+                    "type": "integer"
+                },
+                "totalNodes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterIssues": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "continue": {
+                    "type": "string"
+                },
+                "criticalIssues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue"
+                    }
+                },
+                "defaultPolicy": {
+                    "description": "DefaultPolicy applies to every namespace not in NamespacePolicies,\nwhich holds the namespaces with analysis annotations.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AnalysisPolicy"
+                        }
+                    ]
+                },
+                "healthyPods": {
+                    "type": "integer"
+                },
+                "issueCategories": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "issueVelocity": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueVelocity"
+                },
                 "issuesByNamespace": {
                     "type": "object",
                     "additionalProperties": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceIssues"
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceIssues"
+                    }
+                },
+                "namespacePolicies": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AnalysisPolicy"
+                    }
+                },
+                "patterns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssuePattern"
+                    }
+                },
+                "topIssues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueSummary"
+                    }
+                },
+                "totalCriticalIssues": {
+                    "description": "TotalCriticalIssues counts every critical issue; CriticalIssues holds\nthe current page.",
+                    "type": "integer"
+                },
+                "totalPods": {
+                    "type": "integer"
+                },
+                "unhealthyPods": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "containerName": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "firstSeen": {
+                    "type": "string"
+                },
+                "isRecurring": {
+                    "type": "boolean"
+                },
+                "lastSeen": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ConditionStatus": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerHealth": {
+            "type": "object",
+            "properties": {
+                "exitCode": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerInfo": {
+            "type": "object",
+            "properties": {
+                "environment": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EnvVar"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageID": {
+                    "type": "string"
+                },
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeMountInfo"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "resources": {
+                    "$ref": "#/definitions/v1.ResourceRequirements"
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "state": {
+                    "$ref": "#/definitions/v1.ContainerState"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResourcePressure": {
+            "type": "object",
+            "properties": {
+                "cpuThrottledRatio": {
+                    "type": "number"
+                },
+                "lastOOMKilledAt": {
+                    "description": "LastOOMKilledAt is when the container was last OOMKilled, if its last\ntermination was an OOM kill.",
+                    "type": "string"
+                },
+                "memoryLimitBytes": {
+                    "type": "integer"
+                },
+                "memoryLimitRatio": {
+                    "type": "number"
+                },
+                "memoryUsageBytes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResources": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "requests": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainPodResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "blockingPDBs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "daemonSet": {
+                    "type": "boolean"
+                },
+                "localStorage": {
+                    "type": "boolean"
+                },
+                "mirror": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "ownerKind": {
+                    "type": "string"
+                },
+                "ownerName": {
+                    "type": "string"
+                },
+                "reschedulable": {
+                    "description": "Reschedulable is only set for evicted pods whose eviction is not\nblocked by a PodDisruptionBudget. TargetNode is the first\nnode the pod fits on after the pods evicted before it were placed.",
+                    "type": "boolean"
+                },
+                "schedulingIssue": {
+                    "type": "string"
+                },
+                "targetNode": {
+                    "type": "string"
+                },
+                "unmanaged": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainSimulation": {
+            "type": "object",
+            "properties": {
+                "blockers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "drainable": {
+                    "type": "boolean"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "pods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainPodResult"
+                    }
+                },
+                "simulatedAt": {
+                    "type": "string"
+                },
+                "summary": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainSummary"
+                },
+                "totalPods": {
+                    "type": "integer"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainSummary": {
+            "type": "object",
+            "properties": {
+                "blockedByPDB": {
+                    "type": "integer"
+                },
+                "daemonSetPods": {
+                    "type": "integer"
+                },
+                "evicted": {
+                    "type": "integer"
+                },
+                "localStoragePods": {
+                    "type": "integer"
+                },
+                "mirrorPods": {
+                    "type": "integer"
+                },
+                "unmanagedPods": {
+                    "type": "integer"
+                },
+                "unreschedulable": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventGroup": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "firstSeen": {
+                    "type": "string"
+                },
+                "lastSeen": {
+                    "type": "string"
+                },
+                "latestMessage": {
+                    "type": "string"
+                },
+                "objectKind": {
+                    "type": "string"
+                },
+                "objects": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "sampleObjects": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventInfo": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "firstTimestamp": {
+                    "type": "string"
+                },
+                "involvedObject": {
+                    "description": "InvolvedObject is the Kind/Name of the object the event was recorded\nagainst and Relationship is how that object relates to the pod.",
+                    "type": "string"
+                },
+                "lastTimestamp": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventRelationship"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventRelationship": {
+            "type": "string",
+            "enum": [
+                "Pod",
+                "Owner",
+                "Node",
+                "PersistentVolumeClaim",
+                "ServiceAccount"
+            ],
+            "x-enum-varnames": [
+                "EventRelationshipPod",
+                "EventRelationshipOwner",
+                "EventRelationshipNode",
+                "EventRelationshipPVC",
+                "EventRelationshipServiceAccount"
+            ]
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventSummary": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "lastSeen": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventRelationship"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionCandidate": {
+            "type": "object",
+            "properties": {
+                "critical": {
+                    "description": "Critical pods (static, mirror or system-critical priority) are never\nevicted by the kubelet and are listed last.",
+                    "type": "boolean"
+                },
+                "exceedsRequest": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "qosClass": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "request": {
+                    "type": "string"
+                },
+                "usage": {
+                    "type": "string"
+                },
+                "usageAboveRequest": {
+                    "description": "UsageAboveRequest is negative when the pod is below its request.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionRanking": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "pods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionCandidate"
+                    }
+                },
+                "signal": {
+                    "type": "string"
+                },
+                "thresholdSource": {
+                    "description": "ThresholdSource is \"kubelet\" when evictionHard was read from the\nkubelet configuration and \"default\" when kubelet defaults are assumed.",
+                    "type": "string"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionThresholdStatus"
+                    }
+                },
+                "underPressure": {
+                    "type": "boolean"
+                },
+                "usageSource": {
+                    "description": "UsageSource is \"metrics-server\" or \"kubelet\" depending on where pod\nusage was read from.",
+                    "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionThresholdStatus": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "string"
+                },
+                "breached": {
+                    "type": "boolean"
+                },
+                "capacity": {
+                    "type": "string"
+                },
+                "headroom": {
+                    "type": "string"
+                },
+                "headroomPercentage": {
+                    "type": "number"
+                },
+                "signal": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureCategorySummary": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEventCategory"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "firstTimestamp": {
+                    "type": "string"
+                },
+                "involvedObject": {
+                    "description": "InvolvedObject is the Kind/Name of the object the event was recorded\nagainst and Relationship is how that object relates to the pod.",
+                    "type": "string"
+                },
+                "isRecurring": {
+                    "type": "boolean"
+                },
+                "lastTimestamp": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "possibleCauses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "recurrenceRate": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventRelationship"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "suggestedAction": {
+                    "type": "string"
+                },
+                "timeSinceFirst": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEventCategory": {
+            "type": "string",
+            "enum": [
+                "Scheduling",
+                "ImagePull",
+                "ContainerCrash",
+                "Volume",
+                "Resource",
+                "Probe",
+                "Network",
+                "Node",
+                "Controller",
+                "Other"
+            ],
+            "x-enum-varnames": [
+                "FailureEventCategoryScheduling",
+                "FailureEventCategoryImagePull",
+                "FailureEventCategoryCrash",
+                "FailureEventCategoryVolume",
+                "FailureEventCategoryResource",
+                "FailureEventCategoryProbe",
+                "FailureEventCategoryNetwork",
+                "FailureEventCategoryNode",
+                "FailureEventCategoryController",
+                "FailureEventCategoryOther"
+            ]
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthComponent": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthDetails": {
+            "type": "object",
+            "properties": {
+                "containerStatuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerHealth"
+                    }
+                },
+                "lastRestartReason": {
+                    "type": "string"
+                },
+                "lastRestartTime": {
+                    "type": "string"
+                },
+                "podConditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ConditionStatus"
+                    }
+                },
+                "recentEvents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventSummary"
+                    }
+                },
+                "resources": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourcePressure"
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "restartFrequency": {
+                    "type": "string"
+                },
+                "uptime": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthScorePoint": {
+            "type": "object",
+            "properties": {
+                "score": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthTrend": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "integer"
+                },
+                "degradingSince": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string"
+                },
+                "samples": {
+                    "type": "integer"
+                },
+                "slopePerHour": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ImagePullLatency": {
+            "type": "object",
+            "properties": {
+                "cached": {
+                    "type": "boolean"
+                },
+                "container": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "seconds": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssuePattern": {
+            "type": "object",
+            "properties": {
+                "commonLabels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "firstSeen": {
+                    "type": "string"
+                },
+                "lastSeen": {
+                    "type": "string"
+                },
+                "namespaces": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueSummary": {
+            "type": "object",
+            "properties": {
+                "affectedPods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueVelocity": {
+            "type": "object",
+            "properties": {
+                "newIssuesLast24h": {
+                    "type": "integer"
+                },
+                "newIssuesLastHour": {
+                    "type": "integer"
+                },
+                "resolvedLast24h": {
+                    "type": "integer"
+                },
+                "resolvedLastHour": {
+                    "type": "integer"
+                },
+                "trendDirection": {
+                    "type": "string"
+                },
+                "velocityPerHour": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.LimitRangeItem": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "type": "string"
+                },
+                "defaultRequest": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "string"
+                },
+                "maxLimitRequestRatio": {
+                    "type": "string"
+                },
+                "min": {
+                    "type": "string"
+                },
+                "resource": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.LimitRangeSummary": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.LimitRangeItem"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorReport": {
+            "type": "object",
+            "properties": {
+                "analysisTime": {
+                    "type": "string"
+                },
+                "continue": {
+                    "type": "string"
+                },
+                "criticalIssuesCount": {
+                    "type": "integer"
+                },
+                "excludedPods": {
+                    "type": "integer"
+                },
+                "healthyPodsCount": {
+                    "type": "integer"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "namespaceIssues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssue"
+                    }
+                },
+                "ownerKinds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "policy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AnalysisPolicy"
+                },
+                "problematicPods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ProblematicPod"
+                    }
+                },
+                "problematicPodsCount": {
+                    "type": "integer"
+                },
+                "restartThresholdUsed": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorSummary"
+                    }
+                },
+                "totalPodsAnalyzed": {
+                    "type": "integer"
+                },
+                "warningIssuesCount": {
+                    "type": "integer"
+                },
+                "workloads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadErrorSummary"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorSummary": {
+            "type": "object",
+            "properties": {
+                "affectedPods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "issueType": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceEventDigest": {
+            "type": "object",
+            "properties": {
+                "analyzedAt": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventGroup"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "totalWarnings": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceHealthItem": {
+            "type": "object",
+            "properties": {
+                "namespace": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "workloads": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceIssues": {
+            "type": "object",
+            "properties": {
+                "criticalCount": {
+                    "type": "integer"
+                },
+                "issuesCount": {
+                    "type": "integer"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "topIssues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue"
+                    }
+                },
+                "totalPods": {
+                    "type": "integer"
+                },
+                "warningCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceQuotaReport": {
+            "type": "object",
+            "properties": {
+                "analyzedAt": {
+                    "type": "string"
+                },
+                "limitRanges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.LimitRangeSummary"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "quotas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceQuotaUsage"
+                    }
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceSecurityAudit": {
+            "type": "object",
+            "properties": {
+                "auditedAt": {
+                    "type": "string"
+                },
+                "findingsByCheck": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "namespacePolicy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceSecurityPolicy"
+                },
+                "pods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodSecuritySummary"
+                    }
+                },
+                "podsBlockedIfEnforced": {
+                    "description": "PodsBlockedIfEnforced counts the pods that would be rejected if the\nnamespace enforced the given level.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "podsByLevel": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "podsViolatingEnforce": {
+                    "type": "integer"
+                },
+                "totalPods": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceSecurityPolicy": {
+            "type": "object",
+            "properties": {
+                "audit": {
+                    "type": "string"
+                },
+                "auditVersion": {
+                    "type": "string"
+                },
+                "enforce": {
+                    "type": "string"
+                },
+                "enforceVersion": {
+                    "type": "string"
+                },
+                "warn": {
+                    "type": "string"
+                },
+                "warnVersion": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceStartupStats": {
+            "type": "object",
+            "properties": {
+                "analyzedAt": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "readyPods": {
+                    "type": "integer"
+                },
+                "timeToReady": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
+                },
+                "totalPods": {
+                    "type": "integer"
+                },
+                "workloads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadStartupStats"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeAffinityDetail": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "string"
+                },
+                "failedTerms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "preferredScore": {
+                    "type": "integer"
+                },
+                "requiredMatched": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeCapacity": {
+            "type": "object",
+            "properties": {
+                "eligible": {
+                    "type": "boolean"
+                },
+                "fits": {
+                    "type": "integer"
+                },
+                "free": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "limitingResource": {
+                    "type": "string"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "stranded": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeConditionHealth": {
+            "type": "object",
+            "properties": {
+                "custom": {
+                    "type": "boolean"
+                },
+                "healthy": {
+                    "type": "boolean"
+                },
+                "lastTransitionTime": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeGroup": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "nodeCount": {
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHealth": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthComponent"
+                    }
+                },
+                "details": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHealthDetails"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "overallScore": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHealthDetails": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeConditionHealth"
+                    }
+                },
+                "containerRuntimeVersion": {
+                    "type": "string"
+                },
+                "heartbeat": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHeartbeat"
+                },
+                "kernelVersion": {
+                    "type": "string"
+                },
+                "kubeletVersion": {
+                    "type": "string"
+                },
+                "osImage": {
+                    "type": "string"
+                },
+                "problems": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recentEvents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventSummary"
+                    }
+                },
+                "unschedulable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHeartbeat": {
+            "type": "object",
+            "properties": {
+                "ageSeconds": {
+                    "type": "number"
+                },
+                "leaseDurationSeconds": {
+                    "type": "integer"
+                },
+                "renewTime": {
+                    "type": "string"
+                },
+                "stale": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeInventory": {
+            "type": "object",
+            "properties": {
+                "collectedAt": {
+                    "type": "string"
+                },
+                "controlPlaneVersion": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeInventoryItem"
+                    }
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePool"
+                    }
+                },
+                "regions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeGroup"
+                    }
+                },
+                "totalNodes": {
+                    "type": "integer"
+                },
+                "versionSkew": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeVersionSkew"
+                    }
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "zones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeGroup"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeInventoryItem": {
+            "type": "object",
+            "properties": {
+                "allocatable": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "architecture": {
+                    "type": "string"
+                },
+                "containerRuntime": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "instanceType": {
+                    "type": "string"
+                },
+                "kernelVersion": {
+                    "type": "string"
+                },
+                "kubeletVersion": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "operatingSystem": {
+                    "type": "string"
+                },
+                "osImage": {
+                    "type": "string"
+                },
+                "pool": {
+                    "type": "string"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "taints": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unschedulable": {
+                    "type": "boolean"
+                },
+                "zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePodBreakdown": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeResourceTotals"
+                },
+                "memory": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeResourceTotals"
+                },
+                "metricsAvailable": {
+                    "type": "boolean"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "podCount": {
+                    "type": "integer"
+                },
+                "pods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePodUsage"
+                    }
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePodUsage": {
+            "type": "object",
+            "properties": {
+                "cpuLimit": {
+                    "type": "string"
+                },
+                "cpuRequest": {
+                    "type": "string"
+                },
+                "cpuRequestPercentage": {
+                    "type": "number"
+                },
+                "cpuUsage": {
+                    "type": "string"
+                },
+                "cpuUsagePercentage": {
+                    "type": "number"
+                },
+                "memoryLimit": {
+                    "type": "string"
+                },
+                "memoryRequest": {
+                    "type": "string"
+                },
+                "memoryRequestPercentage": {
+                    "type": "number"
+                },
+                "memoryUsage": {
+                    "type": "string"
+                },
+                "memoryUsagePercentage": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "qosClass": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePool": {
+            "type": "object",
+            "properties": {
+                "differences": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "heterogeneous": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nodeCount": {
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeReadyExplanation": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ready": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeResourceTotals": {
+            "type": "object",
+            "properties": {
+                "allocatable": {
+                    "type": "string"
+                },
+                "limits": {
+                    "type": "string"
+                },
+                "limitsPercentage": {
+                    "type": "number"
+                },
+                "requests": {
+                    "type": "string"
+                },
+                "requestsPercentage": {
+                    "type": "number"
+                },
+                "usage": {
+                    "type": "string"
+                },
+                "usagePercentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingExplanation": {
+            "type": "object",
+            "properties": {
+                "nodeName": {
+                    "type": "string"
+                },
+                "reasons": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingReasons"
+                },
+                "recommendation": {
+                    "type": "string"
+                },
+                "schedulable": {
+                    "type": "boolean"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingReasons": {
+            "type": "object",
+            "properties": {
+                "affinity": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AffinityExplanation"
+                },
+                "nodeReady": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeReadyExplanation"
+                },
+                "podAffinity": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodAffinityExplanation"
+                },
+                "resources": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceExplanation"
+                },
+                "taints": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintExplanation"
+                },
+                "volume": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeExplanation"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilization": {
+            "type": "object",
+            "properties": {
+                "allocation": {
+                    "description": "Allocation is keyed by resource name and covers cpu, memory,\nephemeral-storage, pods and any extended resources the node advertises.\nIt is omitted when the pods on the node could not be listed.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceAllocation"
+                    }
+                },
+                "cpuAllocatable": {
+                    "type": "string"
+                },
+                "cpuCapacity": {
+                    "type": "string"
+                },
+                "cpuPercentage": {
+                    "type": "number"
+                },
+                "cpuUsage": {
+                    "type": "string"
+                },
+                "memoryAllocatable": {
+                    "type": "string"
+                },
+                "memoryCapacity": {
+                    "type": "string"
+                },
+                "memoryPercentage": {
+                    "type": "number"
+                },
+                "memoryUsage": {
+                    "type": "string"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilizationList": {
+            "type": "object",
+            "properties": {
+                "matchingNodes": {
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilization"
+                    }
+                },
+                "nodesWithoutMetrics": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "totalNodes": {
+                    "type": "integer"
+                },
+                "totals": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilizationTotals"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilizationTotals": {
+            "type": "object",
+            "properties": {
+                "cpuAllocatable": {
+                    "type": "string"
+                },
+                "cpuCapacity": {
+                    "type": "string"
+                },
+                "cpuPercentage": {
+                    "type": "number"
+                },
+                "cpuRequested": {
+                    "type": "string"
+                },
+                "cpuRequestedPercentage": {
+                    "type": "number"
+                },
+                "cpuUsage": {
+                    "type": "string"
+                },
+                "memoryAllocatable": {
+                    "type": "string"
+                },
+                "memoryCapacity": {
+                    "type": "string"
+                },
+                "memoryPercentage": {
+                    "type": "number"
+                },
+                "memoryRequested": {
+                    "type": "string"
+                },
+                "memoryRequestedPercentage": {
+                    "type": "number"
+                },
+                "memoryUsage": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeVersionSkew": {
+            "type": "object",
+            "properties": {
+                "kubeletVersion": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "minorVersions": {
+                    "type": "integer"
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "supported": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodAffinityExplanation": {
+            "type": "object",
+            "properties": {
+                "antiAffinityFailed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "conflictingPods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "details": {
+                    "type": "string"
+                },
+                "requiredNotMet": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "satisfied": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodComparison": {
+            "type": "object",
+            "properties": {
+                "differences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodDifference"
+                    }
+                },
+                "differencesByCategory": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "identical": {
+                    "type": "boolean"
+                },
+                "podA": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodReference"
+                },
+                "podB": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodReference"
+                },
+                "redactions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodDescription": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PodCondition"
+                    }
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerInfo"
+                    }
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventInfo"
+                    }
+                },
+                "initContainers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerInfo"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "node": {
+                    "type": "string"
+                },
+                "nodeSelector": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "podIP": {
+                    "type": "string"
+                },
+                "podIPs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "priorityClassName": {
+                    "type": "string"
+                },
+                "qosClass": {
+                    "type": "string"
+                },
+                "redactions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodStatusInfo"
+                },
+                "tolerations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Toleration"
+                    }
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeInfo"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodDifference": {
+            "type": "object",
+            "properties": {
+                "a": {
+                    "type": "string"
+                },
+                "b": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "container": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFailureEvents": {
+            "type": "object",
+            "properties": {
+                "criticalEvents": {
+                    "type": "integer"
+                },
+                "eventCategories": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "failureEvents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent"
+                    }
+                },
+                "mostRecentIssue": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "ongoingIssues": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "podPhase": {
+                    "type": "string"
+                },
+                "podStatus": {
+                    "type": "string"
+                },
+                "totalEvents": {
+                    "type": "integer"
+                },
+                "warningEvents": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFit": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "type": "string"
+                },
+                "memory": {
+                    "type": "string"
+                },
+                "nodeName": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthHistory": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthScorePoint"
+                    }
+                },
+                "retention": {
+                    "type": "string"
+                },
+                "trend": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthTrend"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthItem": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "worstComponent": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthScore": {
+            "type": "object",
+            "properties": {
+                "calculatedAt": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthComponent"
+                    }
+                },
+                "details": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthDetails"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "overallScore": {
+                    "type": "integer"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "policy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AnalysisPolicy"
+                },
+                "scoringPolicy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ScoringPolicyRef"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssue": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType": {
+            "type": "string",
+            "enum": [
+                "HighRestarts",
+                "Pending",
+                "Failed",
+                "CrashLoopBackOff",
+                "ImagePullError",
+                "ResourceConstraints",
+                "Unschedulable",
+                "JobFailed",
+                "QuotaNearLimit",
+                "QuotaExhausted"
+            ],
+            "x-enum-varnames": [
+                "PodIssueHighRestarts",
+                "PodIssuePending",
+                "PodIssueFailed",
+                "PodIssueCrashLoop",
+                "PodIssueImagePull",
+                "PodIssueResourceConstraints",
+                "PodIssueUnschedulable",
+                "PodIssueJobFailed",
+                "PodIssueQuotaNearLimit",
+                "PodIssueQuotaExhausted"
+            ]
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodReference": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodResources": {
+            "type": "object",
+            "properties": {
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResources"
+                    }
+                },
+                "total": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceSummary"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodScheduling": {
+            "type": "object",
+            "properties": {
+                "affinity": {
+                    "$ref": "#/definitions/v1.Affinity"
+                },
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PodCondition"
+                    }
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent"
+                    }
+                },
+                "failureCategories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory"
+                    }
+                },
+                "failureSummary": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureCategorySummary"
+                    }
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "nodeSelector": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "priorityClassName": {
+                    "type": "string"
+                },
+                "schedulerName": {
+                    "type": "string"
+                },
+                "schedulingDecisions": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingDecisions"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tolerations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Toleration"
+                    }
+                },
+                "unschedulableNodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.UnschedulableNode"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodSecurityAudit": {
+            "type": "object",
+            "properties": {
+                "auditedAt": {
+                    "type": "string"
+                },
+                "baselineCompliant": {
+                    "type": "boolean"
+                },
+                "criticalCount": {
+                    "type": "integer"
+                },
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SecurityFinding"
+                    }
+                },
+                "infoCount": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "namespacePolicy": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceSecurityPolicy"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "restrictedCompliant": {
+                    "type": "boolean"
+                },
+                "violatesAudit": {
+                    "type": "boolean"
+                },
+                "violatesEnforce": {
+                    "type": "boolean"
+                },
+                "violatesWarn": {
+                    "type": "boolean"
+                },
+                "warningCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodSecuritySummary": {
+            "type": "object",
+            "properties": {
+                "criticalCount": {
+                    "type": "integer"
+                },
+                "findingsCount": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "violatesEnforce": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodStartupLatency": {
+            "type": "object",
+            "properties": {
+                "appReadinessSeconds": {
+                    "type": "number"
+                },
+                "bottleneck": {
+                    "description": "Bottleneck is the phase that took the largest share of startup time.",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "imagePullSeconds": {
+                    "type": "number"
+                },
+                "imagePulls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ImagePullLatency"
+                    }
+                },
+                "initContainersSeconds": {
+                    "type": "number"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "readinessProbeFailures": {
+                    "description": "ReadinessProbeFailures counts failed readiness probes. The Ready\ncondition only records its latest transition, so when the probe has\nfailed the pod may have been Ready before TimeToReadySeconds.",
+                    "type": "integer"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "schedulingSeconds": {
+                    "type": "number"
+                },
+                "timeToReadySeconds": {
+                    "type": "number"
+                },
+                "workloadKind": {
+                    "type": "string"
+                },
+                "workloadName": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodStatusInfo": {
+            "type": "object",
+            "properties": {
+                "hostIP": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "nominatedNodeName": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "podIP": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodTimeline": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TimelineEntry"
+                    }
+                },
+                "imagePullSeconds": {
+                    "type": "number"
+                },
+                "longestGap": {
+                    "description": "LongestGap points at the entry that followed the largest gap, which is\nusually where startup time went.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TimelineEntry"
+                        }
+                    ]
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "podName": {
+                    "type": "string"
+                },
+                "startupDuration": {
+                    "description": "StartupDuration is the time from creation to the latest Ready=True\ntransition; empty while the pod has never become ready.",
+                    "type": "string"
+                },
+                "startupDurationSeconds": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ProblematicPod": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "string"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssue"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "ownerKind": {
+                    "type": "string"
+                },
+                "ownerName": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "recentEvents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventInfo"
+                    }
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.QuotaConsumer": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "largestShare": {
+                    "description": "LargestShare is the highest percentage of any hard limit this\nworkload accounts for, in LargestShareResource.",
+                    "type": "number"
+                },
+                "largestShareResource": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pods": {
+                    "type": "integer"
+                },
+                "usage": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.QuotaResourceUsage": {
+            "type": "object",
+            "properties": {
+                "exhausted": {
+                    "type": "boolean"
+                },
+                "hard": {
+                    "type": "string"
+                },
+                "nearLimit": {
+                    "type": "boolean"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "resource": {
+                    "type": "string"
+                },
+                "used": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceAllocation": {
+            "type": "object",
+            "properties": {
+                "allocatable": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "string"
+                },
+                "limits": {
+                    "type": "string"
+                },
+                "limitsPercentage": {
+                    "type": "number"
+                },
+                "requested": {
+                    "type": "string"
+                },
+                "requestedPercentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceDetail": {
+            "type": "object",
+            "properties": {
+                "nodeAllocatable": {
+                    "type": "string"
+                },
+                "nodeAllocated": {
+                    "type": "string"
+                },
+                "nodeAvailable": {
+                    "type": "string"
+                },
+                "nodeCapacity": {
+                    "type": "string"
+                },
+                "percentUsed": {
+                    "type": "number"
+                },
+                "podRequests": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "type": "string"
+                },
+                "shortage": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceExplanation": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceDetail"
+                    }
+                },
+                "fits": {
+                    "type": "boolean"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFitDetails": {
+            "type": "object",
+            "properties": {
+                "fits": {
+                    "type": "boolean"
+                },
+                "nodeAllocatable": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                },
+                "nodeCapacity": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                },
+                "nodeRequested": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                },
+                "podRequests": {
+                    "$ref": "#/definitions/v1.ResourceList"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFragmentation": {
+            "type": "object",
+            "properties": {
+                "free": {
+                    "type": "string"
+                },
+                "stranded": {
+                    "type": "string"
+                },
+                "strandedPercentage": {
+                    "type": "number"
+                },
+                "usable": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourcePressure": {
+            "type": "object",
+            "properties": {
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResourcePressure"
                     }
                 },
-                "patterns": {
+                "overallScoreCapped": {
+                    "description": "OverallScoreCapped is set when resource pressure capped the overall\nscore.",
+                    "type": "boolean"
+                },
+                "throttlingAvailable": {
+                    "type": "boolean"
+                },
+                "usageAvailable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourcePricing": {
+            "type": "object",
+            "properties": {
+                "cpuCoreHour": {
+                    "type": "number"
+                },
+                "gpuHour": {
+                    "type": "number"
+                },
+                "memoryGiBHour": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceQuotaUsage": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "nearLimit": {
+                    "type": "boolean"
+                },
+                "resources": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssuePattern"
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.QuotaResourceUsage"
                     }
                 },
-                "topIssues": {
+                "scopeSelector": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueSummary"
+                        "type": "string"
                     }
                 },
-                "totalPods": {
-                    "type": "integer"
+                "scopes": {
+                    "description": "Scopes and ScopeSelector restrict which pods the quota counts.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "unhealthyPods": {
-                    "type": "integer"
+                "topConsumers": {
+                    "description": "TopConsumers ranks the workloads whose pods use the largest share of\nany pod-level resource in the quota.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.QuotaConsumer"
+                    }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceSummary": {
             "type": "object",
             "properties": {
-                "category": {
+                "cpuLimit": {
                     "type": "string"
                 },
-                "containerName": {
+                "cpuRequest": {
                     "type": "string"
                 },
-                "count": {
-                    "type": "integer"
+                "memoryLimit": {
+                    "type": "string"
                 },
-                "firstSeen": {
+                "memoryRequest": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingDecisions": {
+            "type": "object",
+            "properties": {
+                "matchedAffinity": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "isRecurring": {
-                    "type": "boolean"
+                "matchedNodeSelector": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "lastSeen": {
-                    "type": "string"
+                "nodeScore": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string"
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "namespace": {
-                    "type": "string"
+                "resourcesFit": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFitDetails"
                 },
-                "nodeName": {
+                "selectedNode": {
                     "type": "string"
                 },
-                "podName": {
+                "toleratedTaints": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
                 },
                 "reason": {
                     "type": "string"
                 },
-                "severity": {
+                "timestamp": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ConditionStatus": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingExplanation": {
             "type": "object",
             "properties": {
-                "message": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent"
+                    }
+                },
+                "namespace": {
                     "type": "string"
                 },
-                "reason": {
+                "nodeAnalysis": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingExplanation"
+                    }
+                },
+                "nodeName": {
                     "type": "string"
                 },
-                "status": {
+                "podName": {
                     "type": "string"
                 },
-                "type": {
+                "status": {
                     "type": "string"
+                },
+                "summary": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingSummary"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerHealth": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory": {
+            "type": "string",
+            "enum": [
+                "InsufficientCPU",
+                "InsufficientMemory",
+                "InsufficientStorage",
+                "VolumeAttachmentError",
+                "VolumeMultiAttachError",
+                "VolumeNodeAffinityConflict",
+                "NodeAffinityNotMatch",
+                "TaintTolerationMismatch",
+                "PodAffinityConflict",
+                "NodeNotReady",
+                "Miscellaneous"
+            ],
+            "x-enum-varnames": [
+                "FailureCategoryResourceCPU",
+                "FailureCategoryResourceMemory",
+                "FailureCategoryResourceStorage",
+                "FailureCategoryVolumeAttachment",
+                "FailureCategoryVolumeMultiAttach",
+                "FailureCategoryVolumeNodeAffinity",
+                "FailureCategoryNodeAffinity",
+                "FailureCategoryTaints",
+                "FailureCategoryPodAffinity",
+                "FailureCategoryNodeNotReady",
+                "FailureCategoryMiscellaneous"
+            ]
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingSummary": {
             "type": "object",
             "properties": {
-                "exitCode": {
+                "filteredByNodeAffinity": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "filteredByNodeNotReady": {
+                    "type": "integer"
                 },
-                "ready": {
-                    "type": "boolean"
+                "filteredByNodeSelector": {
+                    "type": "integer"
                 },
-                "reason": {
-                    "type": "string"
+                "filteredByPodAffinity": {
+                    "type": "integer"
                 },
-                "restartCount": {
+                "filteredByResources": {
                     "type": "integer"
                 },
-                "state": {
+                "filteredByTaints": {
+                    "type": "integer"
+                },
+                "filteredByVolume": {
+                    "type": "integer"
+                },
+                "possibleActions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recommendation": {
                     "type": "string"
+                },
+                "totalNodes": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResources": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ScoringPolicyRef": {
             "type": "object",
             "properties": {
-                "limits": {
-                    "$ref": "#/definitions/v1.ResourceList"
-                },
-                "name": {
+                "override": {
                     "type": "string"
                 },
-                "requests": {
-                    "$ref": "#/definitions/v1.ResourceList"
+                "version": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventInfo": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SecurityFinding": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "check": {
+                    "type": "string"
                 },
-                "firstTimestamp": {
+                "container": {
                     "type": "string"
                 },
-                "lastTimestamp": {
+                "level": {
                     "type": "string"
                 },
                 "message": {
                     "type": "string"
                 },
-                "reason": {
+                "remediation": {
                     "type": "string"
                 },
-                "source": {
+                "severity": {
                     "type": "string"
-                },
-                "type": {
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SelectorExplanation": {
+            "type": "object",
+            "properties": {
+                "details": {
                     "type": "string"
+                },
+                "matched": {
+                    "type": "boolean"
+                },
+                "missingLabels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "nodeLabels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventSummary": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "max": {
+                    "type": "number"
                 },
-                "lastSeen": {
-                    "type": "string"
+                "p50": {
+                    "type": "number"
                 },
-                "message": {
+                "p95": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintExplanation": {
+            "type": "object",
+            "properties": {
+                "details": {
                     "type": "string"
                 },
-                "reason": {
-                    "type": "string"
+                "nodeTaints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
+                    }
                 },
-                "type": {
-                    "type": "string"
+                "podTolerations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tolerated": {
+                    "type": "boolean"
+                },
+                "untoleratedTaints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
+                    }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureCategorySummary": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory"
-                },
-                "count": {
-                    "type": "integer"
+                "effect": {
+                    "type": "string"
                 },
-                "description": {
+                "key": {
                     "type": "string"
                 },
-                "nodes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TimelineEntry": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEventCategory"
+                "container": {
+                    "type": "string"
                 },
                 "count": {
                     "type": "integer"
                 },
-                "firstTimestamp": {
+                "duration": {
+                    "description": "Duration is set for entries that span an interval, such as image pulls.",
                     "type": "string"
                 },
-                "isRecurring": {
-                    "type": "boolean"
-                },
-                "lastTimestamp": {
-                    "type": "string"
+                "durationSeconds": {
+                    "type": "number"
                 },
                 "message": {
                     "type": "string"
                 },
-                "possibleCauses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "reason": {
                     "type": "string"
                 },
-                "recurrenceRate": {
+                "sinceCreation": {
                     "type": "string"
                 },
-                "severity": {
-                    "type": "string"
+                "sinceCreationSeconds": {
+                    "type": "number"
                 },
-                "source": {
+                "sincePrevious": {
                     "type": "string"
                 },
-                "suggestedAction": {
-                    "type": "string"
+                "sincePreviousSeconds": {
+                    "type": "number"
                 },
-                "timeSinceFirst": {
+                "timestamp": {
                     "type": "string"
                 },
                 "type": {
-                    "type": "string"
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TimelineEntryType"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEventCategory": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TimelineEntryType": {
             "type": "string",
             "enum": [
-                "Scheduling",
+                "Created",
+                "Condition",
+                "ContainerStarted",
+                "ContainerTerminated",
                 "ImagePull",
-                "ContainerCrash",
-                "Volume",
-                "Resource",
-                "Probe",
-                "Network",
-                "Other"
+                "ProbeFailure",
+                "ContainerKilled"
             ],
             "x-enum-varnames": [
-                "FailureEventCategoryScheduling",
-                "FailureEventCategoryImagePull",
-                "FailureEventCategoryCrash",
-                "FailureEventCategoryVolume",
-                "FailureEventCategoryResource",
-                "FailureEventCategoryProbe",
-                "FailureEventCategoryNetwork",
-                "FailureEventCategoryOther"
+                "TimelineEntryCreated",
+                "TimelineEntryCondition",
+                "TimelineEntryContainerStarted",
+                "TimelineEntryContainerTerminated",
+                "TimelineEntryImagePull",
+                "TimelineEntryProbeFailure",
+                "TimelineEntryContainerKilled"
             ]
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthComponent": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "score": {
-                    "type": "integer"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "weight": {
-                    "type": "number"
-                }
-            }
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthDetails": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.UnschedulableNode": {
             "type": "object",
             "properties": {
-                "containerStatuses": {
+                "insufficientResources": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerHealth"
+                        "type": "string"
                     }
                 },
-                "lastRestartReason": {
-                    "type": "string"
-                },
-                "lastRestartTime": {
+                "nodeName": {
                     "type": "string"
                 },
-                "podConditions": {
+                "podAffinityConflicts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ConditionStatus"
+                        "type": "string"
                     }
                 },
-                "recentEvents": {
+                "reasons": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventSummary"
+                        "type": "string"
                     }
                 },
-                "restartCount": {
-                    "type": "integer"
+                "unmatchedAffinity": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "restartFrequency": {
-                    "type": "string"
+                "unmatchedSelectors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "uptime": {
-                    "type": "string"
+                "untoleratedTaints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
+                    }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssuePattern": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeExplanation": {
             "type": "object",
             "properties": {
-                "commonLabels": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "count": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "firstSeen": {
-                    "type": "string"
-                },
-                "lastSeen": {
+                "details": {
                     "type": "string"
                 },
-                "namespaces": {
+                "issues": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "satisfied": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeInfo": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/v1.VolumeSource"
+                },
                 "type": {
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueSummary": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeMountInfo": {
             "type": "object",
             "properties": {
-                "affectedPods": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "category": {
+                "mountPath": {
                     "type": "string"
                 },
-                "count": {
-                    "type": "integer"
-                },
-                "description": {
+                "name": {
                     "type": "string"
                 },
-                "severity": {
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "subPath": {
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.IssueVelocity": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadErrorSummary": {
             "type": "object",
             "properties": {
-                "newIssuesLast24h": {
+                "analyzedPods": {
                     "type": "integer"
                 },
-                "newIssuesLastHour": {
+                "availableReplicas": {
                     "type": "integer"
                 },
-                "resolvedLast24h": {
+                "desiredReplicas": {
                     "type": "integer"
                 },
-                "resolvedLastHour": {
+                "issueTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType"
+                    }
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "problematicPods": {
                     "type": "integer"
                 },
-                "trendDirection": {
+                "readyReplicas": {
+                    "type": "integer"
+                },
+                "representativePod": {
                     "type": "string"
                 },
-                "velocityPerHour": {
-                    "type": "number"
+                "severity": {
+                    "type": "string"
+                },
+                "statusKnown": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorReport": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthScore": {
             "type": "object",
             "properties": {
-                "analysisTime": {
-                    "type": "string"
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 },
-                "criticalIssuesCount": {
+                "desiredReplicas": {
                     "type": "integer"
                 },
-                "healthyPodsCount": {
-                    "type": "integer"
+                "kind": {
+                    "type": "string"
                 },
-                "namespace": {
+                "name": {
                     "type": "string"
                 },
-                "problematicPods": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ProblematicPod"
-                    }
+                "namespace": {
+                    "type": "string"
                 },
-                "problematicPodsCount": {
+                "podScore": {
                     "type": "integer"
                 },
-                "restartThresholdUsed": {
+                "pods": {
                     "type": "integer"
                 },
-                "summary": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorSummary"
-                    }
+                "priority": {
+                    "type": "integer"
                 },
-                "totalPodsAnalyzed": {
+                "readyReplicas": {
                     "type": "integer"
                 },
-                "warningIssuesCount": {
+                "replicaPenalty": {
                     "type": "integer"
-                }
-            }
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorSummary": {
-            "type": "object",
-            "properties": {
-                "affectedPods": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
-                "count": {
+                "score": {
                     "type": "integer"
                 },
-                "description": {
+                "status": {
                     "type": "string"
                 },
-                "issueType": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType"
+                "statusKnown": {
+                    "type": "boolean"
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "worstPod": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceIssues": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthTrend": {
             "type": "object",
             "properties": {
-                "criticalCount": {
-                    "type": "integer"
+                "kind": {
+                    "type": "string"
                 },
-                "issuesCount": {
+                "lastRecorded": {
+                    "type": "string"
+                },
+                "latestScore": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
                 "namespace": {
                     "type": "string"
                 },
-                "topIssues": {
+                "points": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterPodIssue"
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthScorePoint"
                     }
                 },
-                "totalPods": {
-                    "type": "integer"
-                },
-                "warningCount": {
-                    "type": "integer"
+                "trend": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthTrend"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeAffinityDetail": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthTrends": {
             "type": "object",
             "properties": {
-                "details": {
+                "calculatedAt": {
                     "type": "string"
                 },
-                "failedTerms": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "interval": {
+                    "type": "string"
                 },
-                "preferredScore": {
-                    "type": "integer"
+                "namespace": {
+                    "type": "string"
                 },
-                "requiredMatched": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeReadyExplanation": {
-            "type": "object",
-            "properties": {
-                "conditions": {
+                "retention": {
+                    "type": "string"
+                },
+                "workloads": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthTrend"
                     }
-                },
-                "ready": {
-                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingExplanation": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadStartupStats": {
             "type": "object",
             "properties": {
-                "nodeName": {
-                    "type": "string"
-                },
-                "reasons": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingReasons"
+                "appReadiness": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
                 },
-                "recommendation": {
+                "bottleneck": {
                     "type": "string"
                 },
-                "schedulable": {
-                    "type": "boolean"
+                "imagePull": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
                 },
-                "score": {
+                "initContainers": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
+                },
+                "readyPods": {
                     "type": "integer"
-                }
-            }
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingReasons": {
-            "type": "object",
-            "properties": {
-                "affinity": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AffinityExplanation"
                 },
-                "nodeReady": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeReadyExplanation"
+                "scheduling": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
                 },
-                "podAffinity": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodAffinityExplanation"
+                "slowestPod": {
+                    "type": "string"
                 },
-                "resources": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceExplanation"
+                "timeToReady": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.StartupPercentiles"
                 },
-                "taints": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintExplanation"
+                "workloadKind": {
+                    "type": "string"
                 },
-                "volume": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeExplanation"
+                "workloadName": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilization": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorDetail": {
             "type": "object",
             "properties": {
-                "cpuCapacity": {
-                    "type": "string"
-                },
-                "cpuPercentage": {
-                    "type": "number"
-                },
-                "cpuUsage": {
+                "code": {
                     "type": "string"
                 },
-                "memoryCapacity": {
+                "details": {
                     "type": "string"
                 },
-                "memoryPercentage": {
-                    "type": "number"
-                },
-                "memoryUsage": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorDetail"
                 },
-                "nodeName": {
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata": {
+            "type": "object",
+            "properties": {
+                "requestId": {
                     "type": "string"
                 },
                 "timestamp": {
@@ -1339,760 +5547,1119 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodAffinityExplanation": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AggregateHealthScore": {
             "type": "object",
             "properties": {
-                "antiAffinityFailed": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AggregateHealthScore"
                 },
-                "conflictingPods": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_AllocationReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.AllocationReport"
                 },
-                "details": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_CapacityReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.CapacityReport"
                 },
-                "requiredNotMet": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_ClusterIssues": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ClusterIssues"
                 },
-                "satisfied": {
-                    "type": "boolean"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFailureEvents": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_DrainSimulation": {
             "type": "object",
             "properties": {
-                "criticalEvents": {
-                    "type": "integer"
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.DrainSimulation"
                 },
-                "eventCategories": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_EvictionRanking": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EvictionRanking"
                 },
-                "failureEvents": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceErrorReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceErrorReport"
                 },
-                "mostRecentIssue": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureEvent"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceEventDigest": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceEventDigest"
                 },
-                "namespace": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceQuotaReport": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceQuotaReport"
                 },
-                "ongoingIssues": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceSecurityAudit": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceSecurityAudit"
                 },
-                "podName": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NamespaceStartupStats": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NamespaceStartupStats"
                 },
-                "podPhase": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeHealth": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeHealth"
                 },
-                "podStatus": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeInventory": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeInventory"
                 },
-                "totalEvents": {
-                    "type": "integer"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodePodBreakdown": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodePodBreakdown"
                 },
-                "warningEvents": {
-                    "type": "integer"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeUtilization": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilization"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthScore": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_NodeUtilizationList": {
             "type": "object",
             "properties": {
-                "calculatedAt": {
-                    "type": "string"
-                },
-                "components": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthComponent"
-                    }
-                },
-                "details": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.HealthDetails"
-                },
-                "namespace": {
-                    "type": "string"
-                },
-                "overallScore": {
-                    "type": "integer"
-                },
-                "podName": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeUtilizationList"
                 },
-                "status": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssue": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodComparison": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "details": {
-                    "type": "string"
-                },
-                "severity": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodComparison"
                 },
-                "type": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssueType": {
-            "type": "string",
-            "enum": [
-                "HighRestarts",
-                "Pending",
-                "Failed",
-                "CrashLoopBackOff",
-                "ImagePullError",
-                "ResourceConstraints",
-                "Unschedulable"
-            ],
-            "x-enum-varnames": [
-                "PodIssueHighRestarts",
-                "PodIssuePending",
-                "PodIssueFailed",
-                "PodIssueCrashLoop",
-                "PodIssueImagePull",
-                "PodIssueResourceConstraints",
-                "PodIssueUnschedulable"
-            ]
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodResources": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodDescription": {
             "type": "object",
             "properties": {
-                "containers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ContainerResources"
-                    }
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodDescription"
                 },
-                "total": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceSummary"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodScheduling": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodFailureEvents": {
             "type": "object",
             "properties": {
-                "affinity": {
-                    "$ref": "#/definitions/v1.Affinity"
-                },
-                "conditions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.PodCondition"
-                    }
-                },
-                "events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent"
-                    }
-                },
-                "failureCategories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory"
-                    }
-                },
-                "failureSummary": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.FailureCategorySummary"
-                    }
-                },
-                "nodeName": {
-                    "type": "string"
-                },
-                "nodeSelector": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "priority": {
-                    "type": "integer"
-                },
-                "priorityClassName": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodFailureEvents"
                 },
-                "schedulerName": {
-                    "type": "string"
-                },
-                "schedulingDecisions": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingDecisions"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "tolerations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.Toleration"
-                    }
-                },
-                "unschedulableNodes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.UnschedulableNode"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ProblematicPod": {
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodHealthHistory": {
             "type": "object",
             "properties": {
-                "age": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthHistory"
                 },
-                "issues": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodIssue"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodHealthScore": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodHealthScore"
                 },
-                "name": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodResources": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodResources"
                 },
-                "namespace": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodScheduling": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodScheduling"
                 },
-                "ownerKind": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodSecurityAudit": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodSecurityAudit"
                 },
-                "ownerName": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodStartupLatency": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodStartupLatency"
                 },
-                "phase": {
-                    "type": "string"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_PodTimeline": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.PodTimeline"
                 },
-                "recentEvents": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.EventInfo"
-                    }
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_SchedulingExplanation": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingExplanation"
                 },
-                "restartCount": {
-                    "type": "integer"
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse-github_com_sumandas0_k8s-cluster-agent_internal_core_models_WorkloadHealthTrends": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.WorkloadHealthTrends"
                 },
+                "metadata": {
+                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                }
+            }
+        },
+        "internal_transport_http_handlers.HealthResponse": {
+            "type": "object",
+            "properties": {
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceDetail": {
+        "k8s_io_api_core_v1.ConditionStatus": {
+            "type": "string",
+            "enum": [
+                "True",
+                "False",
+                "Unknown"
+            ],
+            "x-enum-varnames": [
+                "ConditionTrue",
+                "ConditionFalse",
+                "ConditionUnknown"
+            ]
+        },
+        "resource.Quantity": {
+            "type": "object",
+            "properties": {
+                "Format": {
+                    "type": "string",
+                    "enum": [
+                        "DecimalExponent",
+                        "BinarySI",
+                        "DecimalSI"
+                    ],
+                    "x-enum-comments": {
+                        "BinarySI": "e.g., 12Mi (12 * 2^20)",
+                        "DecimalExponent": "e.g., 12e6",
+                        "DecimalSI": "e.g., 12M  (12 * 10^6)"
+                    },
+                    "x-enum-varnames": [
+                        "DecimalExponent",
+                        "BinarySI",
+                        "DecimalSI"
+                    ]
+                }
+            }
+        },
+        "v1.AWSElasticBlockStoreVolumeSource": {
             "type": "object",
             "properties": {
-                "nodeAllocatable": {
-                    "type": "string"
-                },
-                "nodeAllocated": {
+                "fsType": {
+                    "description": "fsType is the filesystem type of the volume that you want to mount.\nTip: Ensure that the filesystem type is supported by the host operating system.\nExamples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore\nTODO: how do we prevent errors in the filesystem from compromising the machine\n+optional",
                     "type": "string"
                 },
-                "nodeAvailable": {
-                    "type": "string"
-                },
-                "nodeCapacity": {
-                    "type": "string"
-                },
-                "percentUsed": {
-                    "type": "number"
-                },
-                "podRequests": {
-                    "type": "string"
+                "partition": {
+                    "description": "partition is the partition in the volume that you want to mount.\nIf omitted, the default is to mount by volume name.\nExamples: For volume /dev/sda1, you specify the partition as \"1\".\nSimilarly, the volume partition for /dev/sda is \"0\" (or you can leave the property empty).\n+optional",
+                    "type": "integer"
                 },
-                "recommendation": {
-                    "type": "string"
+                "readOnly": {
+                    "description": "readOnly value true will force the readOnly setting in VolumeMounts.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore\n+optional",
+                    "type": "boolean"
                 },
-                "shortage": {
+                "volumeID": {
+                    "description": "volumeID is unique ID of the persistent disk resource in AWS (Amazon EBS volume).\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceExplanation": {
+        "v1.Affinity": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceDetail"
-                    }
+                "nodeAffinity": {
+                    "description": "Describes node affinity scheduling rules for the pod.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.NodeAffinity"
+                        }
+                    ]
                 },
-                "fits": {
-                    "type": "boolean"
+                "podAffinity": {
+                    "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodAffinity"
+                        }
+                    ]
                 },
-                "summary": {
-                    "type": "string"
+                "podAntiAffinity": {
+                    "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodAntiAffinity"
+                        }
+                    ]
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFitDetails": {
+        "v1.AzureDataDiskCachingMode": {
+            "type": "string",
+            "enum": [
+                "None",
+                "ReadOnly",
+                "ReadWrite"
+            ],
+            "x-enum-varnames": [
+                "AzureDataDiskCachingNone",
+                "AzureDataDiskCachingReadOnly",
+                "AzureDataDiskCachingReadWrite"
+            ]
+        },
+        "v1.AzureDataDiskKind": {
+            "type": "string",
+            "enum": [
+                "Shared",
+                "Dedicated",
+                "Managed"
+            ],
+            "x-enum-varnames": [
+                "AzureSharedBlobDisk",
+                "AzureDedicatedBlobDisk",
+                "AzureManagedDisk"
+            ]
+        },
+        "v1.AzureDiskVolumeSource": {
             "type": "object",
             "properties": {
-                "fits": {
-                    "type": "boolean"
+                "cachingMode": {
+                    "description": "cachingMode is the Host Caching mode: None, Read Only, Read Write.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AzureDataDiskCachingMode"
+                        }
+                    ]
                 },
-                "nodeAllocatable": {
-                    "$ref": "#/definitions/v1.ResourceList"
+                "diskName": {
+                    "description": "diskName is the Name of the data disk in the blob storage",
+                    "type": "string"
                 },
-                "nodeCapacity": {
-                    "$ref": "#/definitions/v1.ResourceList"
+                "diskURI": {
+                    "description": "diskURI is the URI of data disk in the blob storage",
+                    "type": "string"
                 },
-                "nodeRequested": {
-                    "$ref": "#/definitions/v1.ResourceList"
+                "fsType": {
+                    "description": "fsType is Filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\n+optional",
+                    "type": "string"
                 },
-                "podRequests": {
-                    "$ref": "#/definitions/v1.ResourceList"
+                "kind": {
+                    "description": "kind expected values are Shared: multiple blob disks per storage account  Dedicated: single blob disk per storage account  Managed: azure managed data disk (only in managed availability set). defaults to shared",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AzureDataDiskKind"
+                        }
+                    ]
+                },
+                "readOnly": {
+                    "description": "readOnly Defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceSummary": {
+        "v1.AzureFileVolumeSource": {
             "type": "object",
             "properties": {
-                "cpuLimit": {
-                    "type": "string"
-                },
-                "cpuRequest": {
-                    "type": "string"
+                "readOnly": {
+                    "description": "readOnly defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
                 },
-                "memoryLimit": {
+                "secretName": {
+                    "description": "secretName is the  name of secret that contains Azure Storage Account Name and Key",
                     "type": "string"
                 },
-                "memoryRequest": {
+                "shareName": {
+                    "description": "shareName is the azure share Name",
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingDecisions": {
+        "v1.CSIVolumeSource": {
             "type": "object",
             "properties": {
-                "matchedAffinity": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "driver": {
+                    "description": "driver is the name of the CSI driver that handles this volume.\nConsult with your admin for the correct name as registered in the cluster.",
+                    "type": "string"
                 },
-                "matchedNodeSelector": {
+                "fsType": {
+                    "description": "fsType to mount. Ex. \"ext4\", \"xfs\", \"ntfs\".\nIf not provided, the empty value is passed to the associated CSI driver\nwhich will determine the default filesystem to apply.\n+optional",
+                    "type": "string"
+                },
+                "nodePublishSecretRef": {
+                    "description": "nodePublishSecretRef is a reference to the secret object containing\nsensitive information to pass to the CSI driver to complete the CSI\nNodePublishVolume and NodeUnpublishVolume calls.\nThis field is optional, and  may be empty if no secret is required. If the\nsecret object contains more than one secret, all secret references are passed.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
+                },
+                "readOnly": {
+                    "description": "readOnly specifies a read-only configuration for the volume.\nDefaults to false (read/write).\n+optional",
+                    "type": "boolean"
+                },
+                "volumeAttributes": {
+                    "description": "volumeAttributes stores driver-specific properties that are passed to the CSI\ndriver. Consult your driver's documentation for supported values.\n+optional",
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
-                },
-                "nodeScore": {
-                    "type": "integer"
-                },
-                "reasons": {
+                }
+            }
+        },
+        "v1.CephFSVolumeSource": {
+            "type": "object",
+            "properties": {
+                "monitors": {
+                    "description": "monitors is Required: Monitors is a collection of Ceph monitors\nMore info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "resourcesFit": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.ResourceFitDetails"
+                "path": {
+                    "description": "path is Optional: Used as the mounted root, rather than the full Ceph tree, default is /\n+optional",
+                    "type": "string"
                 },
-                "selectedNode": {
+                "readOnly": {
+                    "description": "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\nMore info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it\n+optional",
+                    "type": "boolean"
+                },
+                "secretFile": {
+                    "description": "secretFile is Optional: SecretFile is the path to key ring for User, default is /etc/ceph/user.secret\nMore info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it\n+optional",
                     "type": "string"
                 },
-                "toleratedTaints": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "secretRef": {
+                    "description": "secretRef is Optional: SecretRef is reference to the authentication secret for User, default is empty.\nMore info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "user is optional: User is the rados user name, default is admin\nMore info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it\n+optional",
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent": {
+        "v1.CinderVolumeSource": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "message": {
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nExamples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nMore info: https://examples.k8s.io/mysql-cinder-pd/README.md\n+optional",
                     "type": "string"
                 },
-                "reason": {
-                    "type": "string"
+                "readOnly": {
+                    "description": "readOnly defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\nMore info: https://examples.k8s.io/mysql-cinder-pd/README.md\n+optional",
+                    "type": "boolean"
                 },
-                "timestamp": {
+                "secretRef": {
+                    "description": "secretRef is optional: points to a secret object containing parameters used to connect\nto OpenStack.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
+                },
+                "volumeID": {
+                    "description": "volumeID used to identify the volume in cinder.\nMore info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigMapKeySelector": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "The key to select.",
                     "type": "string"
                 },
-                "type": {
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
                     "type": "string"
+                },
+                "optional": {
+                    "description": "Specify whether the ConfigMap or its key must be defined\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingExplanation": {
+        "v1.ConfigMapProjection": {
             "type": "object",
             "properties": {
-                "events": {
+                "items": {
+                    "description": "items if unspecified, each key-value pair in the Data field of the referenced\nConfigMap will be projected into the volume as a file whose name is the\nkey and content is the value. If specified, the listed keys will be\nprojected into the specified paths, and unlisted keys will not be\npresent. If a key is specified which is not present in the ConfigMap,\nthe volume setup will error unless it is marked optional. Paths must be\nrelative and may not contain the '..' path or start with '..'.\n+optional",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingEvent"
+                        "$ref": "#/definitions/v1.KeyToPath"
                     }
                 },
-                "namespace": {
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
                     "type": "string"
                 },
-                "nodeAnalysis": {
+                "optional": {
+                    "description": "optional specify whether the ConfigMap or its keys must be defined\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.ConfigMapVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "description": "defaultMode is optional: mode bits used to set permissions on created files by default.\nMust be an octal value between 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values for mode bits.\nDefaults to 0644.\nDirectories within the path are not affected by this setting.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
+                },
+                "items": {
+                    "description": "items if unspecified, each key-value pair in the Data field of the referenced\nConfigMap will be projected into the volume as a file whose name is the\nkey and content is the value. If specified, the listed keys will be\nprojected into the specified paths, and unlisted keys will not be\npresent. If a key is specified which is not present in the ConfigMap,\nthe volume setup will error unless it is marked optional. Paths must be\nrelative and may not contain the '..' path or start with '..'.\n+optional",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.NodeSchedulingExplanation"
+                        "$ref": "#/definitions/v1.KeyToPath"
                     }
                 },
-                "nodeName": {
-                    "type": "string"
-                },
-                "podName": {
-                    "type": "string"
-                },
-                "status": {
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
                     "type": "string"
                 },
-                "summary": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingSummary"
+                "optional": {
+                    "description": "optional specify whether the ConfigMap or its keys must be defined\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingFailureCategory": {
-            "type": "string",
-            "enum": [
-                "InsufficientCPU",
-                "InsufficientMemory",
-                "InsufficientStorage",
-                "VolumeAttachmentError",
-                "VolumeMultiAttachError",
-                "VolumeNodeAffinityConflict",
-                "NodeAffinityNotMatch",
-                "TaintTolerationMismatch",
-                "PodAffinityConflict",
-                "NodeNotReady",
-                "Miscellaneous"
-            ],
-            "x-enum-varnames": [
-                "FailureCategoryResourceCPU",
-                "FailureCategoryResourceMemory",
-                "FailureCategoryResourceStorage",
-                "FailureCategoryVolumeAttachment",
-                "FailureCategoryVolumeMultiAttach",
-                "FailureCategoryVolumeNodeAffinity",
-                "FailureCategoryNodeAffinity",
-                "FailureCategoryTaints",
-                "FailureCategoryPodAffinity",
-                "FailureCategoryNodeNotReady",
-                "FailureCategoryMiscellaneous"
-            ]
-        },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SchedulingSummary": {
+        "v1.ContainerState": {
             "type": "object",
             "properties": {
-                "filteredByNodeAffinity": {
-                    "type": "integer"
-                },
-                "filteredByNodeNotReady": {
-                    "type": "integer"
-                },
-                "filteredByNodeSelector": {
-                    "type": "integer"
+                "running": {
+                    "description": "Details about a running container\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ContainerStateRunning"
+                        }
+                    ]
                 },
-                "filteredByPodAffinity": {
-                    "type": "integer"
+                "terminated": {
+                    "description": "Details about a terminated container\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ContainerStateTerminated"
+                        }
+                    ]
                 },
-                "filteredByResources": {
-                    "type": "integer"
+                "waiting": {
+                    "description": "Details about a waiting container\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ContainerStateWaiting"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.ContainerStateRunning": {
+            "type": "object",
+            "properties": {
+                "startedAt": {
+                    "description": "Time at which the container was last (re-)started\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ContainerStateTerminated": {
+            "type": "object",
+            "properties": {
+                "containerID": {
+                    "description": "Container's ID in the format '\u003ctype\u003e://\u003ccontainer_id\u003e'\n+optional",
+                    "type": "string"
                 },
-                "filteredByTaints": {
+                "exitCode": {
+                    "description": "Exit status from the last termination of the container",
                     "type": "integer"
                 },
-                "filteredByVolume": {
-                    "type": "integer"
+                "finishedAt": {
+                    "description": "Time at which the container last terminated\n+optional",
+                    "type": "string"
                 },
-                "possibleActions": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "message": {
+                    "description": "Message regarding the last termination of the container\n+optional",
+                    "type": "string"
                 },
-                "recommendation": {
+                "reason": {
+                    "description": "(brief) reason from the last termination of the container\n+optional",
                     "type": "string"
                 },
-                "totalNodes": {
+                "signal": {
+                    "description": "Signal from the last termination of the container\n+optional",
                     "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time at which previous execution of the container started\n+optional",
+                    "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.SelectorExplanation": {
+        "v1.ContainerStateWaiting": {
             "type": "object",
             "properties": {
-                "details": {
+                "message": {
+                    "description": "Message regarding why the container is not yet running.\n+optional",
                     "type": "string"
                 },
-                "matched": {
-                    "type": "boolean"
-                },
-                "missingLabels": {
+                "reason": {
+                    "description": "(brief) reason the container is not yet running.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DownwardAPIProjection": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Items is a list of DownwardAPIVolume file\n+optional",
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    }
-                },
-                "nodeLabels": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "required": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                        "$ref": "#/definitions/v1.DownwardAPIVolumeFile"
                     }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintExplanation": {
+        "v1.DownwardAPIVolumeFile": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "string"
+                "fieldRef": {
+                    "description": "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ObjectFieldSelector"
+                        }
+                    ]
                 },
-                "nodeTaints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
-                    }
+                "mode": {
+                    "description": "Optional: mode bits used to set permissions on this file, must be an octal value\nbetween 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values for mode bits.\nIf not specified, the volume defaultMode will be used.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
                 },
-                "podTolerations": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "path": {
+                    "description": "Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'",
+                    "type": "string"
                 },
-                "tolerated": {
-                    "type": "boolean"
+                "resourceFieldRef": {
+                    "description": "Selects a resource of the container: only resources limits and requests\n(limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ResourceFieldSelector"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.DownwardAPIVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "description": "Optional: mode bits to use on created files by default. Must be a\nOptional: mode bits used to set permissions on created files by default.\nMust be an octal value between 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values for mode bits.\nDefaults to 0644.\nDirectories within the path are not affected by this setting.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
                 },
-                "untoleratedTaints": {
+                "items": {
+                    "description": "Items is a list of downward API volume file\n+optional",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
+                        "$ref": "#/definitions/v1.DownwardAPIVolumeFile"
                     }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo": {
+        "v1.EmptyDirVolumeSource": {
             "type": "object",
             "properties": {
-                "effect": {
-                    "type": "string"
+                "medium": {
+                    "description": "medium represents what type of storage medium should back this directory.\nThe default is \"\" which means to use the node's default medium.\nMust be an empty string (default) or Memory.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.StorageMedium"
+                        }
+                    ]
                 },
-                "key": {
+                "sizeLimit": {
+                    "description": "sizeLimit is the total amount of local storage required for this EmptyDir volume.\nThe size limit is also applicable for memory medium.\nThe maximum usage on memory medium EmptyDir would be the minimum value between\nthe SizeLimit specified here and the sum of memory limits of all containers in a pod.\nThe default is nil which means that the limit is undefined.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/resource.Quantity"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.EnvVar": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name of the environment variable. Must be a C_IDENTIFIER.",
                     "type": "string"
                 },
                 "value": {
+                    "description": "Variable references $(VAR_NAME) are expanded\nusing the previously defined environment variables in the container and\nany service environment variables. If a variable cannot be resolved,\nthe reference in the input string will be unchanged. Double $$ are reduced\nto a single $, which allows for escaping the $(VAR_NAME) syntax: i.e.\n\"$$(VAR_NAME)\" will produce the string literal \"$(VAR_NAME)\".\nEscaped references will never be expanded, regardless of whether the variable\nexists or not.\nDefaults to \"\".\n+optional",
                     "type": "string"
+                },
+                "valueFrom": {
+                    "description": "Source for the environment variable's value. Cannot be used if value is not empty.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.EnvVarSource"
+                        }
+                    ]
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.UnschedulableNode": {
+        "v1.EnvVarSource": {
             "type": "object",
             "properties": {
-                "insufficientResources": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "configMapKeyRef": {
+                    "description": "Selects a key of a ConfigMap.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ConfigMapKeySelector"
+                        }
+                    ]
                 },
-                "nodeName": {
+                "fieldRef": {
+                    "description": "Selects a field of the pod: supports metadata.name, metadata.namespace, ` + "`" + `metadata.labels['\u003cKEY\u003e']` + "`" + `, ` + "`" + `metadata.annotations['\u003cKEY\u003e']` + "`" + `,\nspec.nodeName, spec.serviceAccountName, status.hostIP, status.podIP, status.podIPs.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ObjectFieldSelector"
+                        }
+                    ]
+                },
+                "resourceFieldRef": {
+                    "description": "Selects a resource of the container: only resources limits and requests\n(limits.cpu, limits.memory, limits.ephemeral-storage, requests.cpu, requests.memory and requests.ephemeral-storage) are currently supported.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ResourceFieldSelector"
+                        }
+                    ]
+                },
+                "secretKeyRef": {
+                    "description": "Selects a key of a secret in the pod's namespace\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.SecretKeySelector"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.EphemeralVolumeSource": {
+            "type": "object",
+            "properties": {
+                "volumeClaimTemplate": {
+                    "description": "Will be used to create a stand-alone PVC to provision the volume.\nThe pod in which this EphemeralVolumeSource is embedded will be the\nowner of the PVC, i.e. the PVC will be deleted together with the\npod.  The name of the PVC will be ` + "`" + `\u003cpod name\u003e-\u003cvolume name\u003e` + "`" + ` where\n` + "`" + `\u003cvolume name\u003e` + "`" + ` is the name from the ` + "`" + `PodSpec.Volumes` + "`" + ` array\nentry. Pod validation will reject the pod if the concatenated name\nis not valid for a PVC (for example, too long).\n\nAn existing PVC with that name that is not owned by the pod\nwill *not* be used for the pod to avoid using an unrelated\nvolume by mistake. Starting the pod is then blocked until\nthe unrelated PVC is removed. If such a pre-created PVC is\nmeant to be used by the pod, the PVC has to updated with an\nowner reference to the pod once the pod exists. Normally\nthis should not be necessary, but it may be useful when\nmanually reconstructing a broken cluster.\n\nThis field is read-only and no changes will be made by Kubernetes\nto the PVC after it has been created.\n\nRequired, must not be nil.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeClaimTemplate"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.FCVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nTODO: how do we prevent errors in the filesystem from compromising the machine\n+optional",
                     "type": "string"
                 },
-                "podAffinityConflicts": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "lun": {
+                    "description": "lun is Optional: FC target lun number\n+optional",
+                    "type": "integer"
                 },
-                "reasons": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "readOnly": {
+                    "description": "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
                 },
-                "unmatchedAffinity": {
+                "targetWWNs": {
+                    "description": "targetWWNs is Optional: FC target worldwide names (WWNs)\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "unmatchedSelectors": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "untoleratedTaints": {
+                "wwids": {
+                    "description": "wwids Optional: FC volume world wide identifiers (wwids)\nEither wwids or combination of targetWWNs and lun must be set, but not both simultaneously.\n+optional",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_core_models.TaintInfo"
+                        "type": "string"
                     }
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_core_models.VolumeExplanation": {
+        "v1.FieldsV1": {
+            "type": "object"
+        },
+        "v1.FlexVolumeSource": {
             "type": "object",
             "properties": {
-                "details": {
+                "driver": {
+                    "description": "driver is the name of the driver to use for this volume.",
                     "type": "string"
                 },
-                "issues": {
-                    "type": "array",
-                    "items": {
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". The default filesystem depends on FlexVolume script.\n+optional",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "options is Optional: this field holds extra command options if any.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "satisfied": {
+                "readOnly": {
+                    "description": "readOnly is Optional: defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
                     "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "secretRef is Optional: secretRef is reference to the secret object containing\nsensitive information to pass to the plugin scripts. This may be\nempty if no secret object is specified. If the secret object\ncontains more than one secret, all secrets are passed to the plugin\nscripts.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorDetail": {
+        "v1.FlockerVolumeSource": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "details": {
+                "datasetName": {
+                    "description": "datasetName is Name of the dataset stored as metadata -\u003e name on the dataset for Flocker\nshould be considered as deprecated\n+optional",
                     "type": "string"
                 },
-                "message": {
+                "datasetUUID": {
+                    "description": "datasetUUID is the UUID of the dataset. This is unique identifier of a Flocker dataset\n+optional",
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorResponse": {
+        "v1.GCEPersistentDiskVolumeSource": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.ErrorDetail"
+                "fsType": {
+                    "description": "fsType is filesystem type of the volume that you want to mount.\nTip: Ensure that the filesystem type is supported by the host operating system.\nExamples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk\nTODO: how do we prevent errors in the filesystem from compromising the machine\n+optional",
+                    "type": "string"
                 },
-                "metadata": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
+                "partition": {
+                    "description": "partition is the partition in the volume that you want to mount.\nIf omitted, the default is to mount by volume name.\nExamples: For volume /dev/sda1, you specify the partition as \"1\".\nSimilarly, the volume partition for /dev/sda is \"0\" (or you can leave the property empty).\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk\n+optional",
+                    "type": "integer"
+                },
+                "pdName": {
+                    "description": "pdName is unique name of the PD resource in GCE. Used to identify the disk in GCE.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the ReadOnly setting in VolumeMounts.\nDefaults to false.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata": {
+        "v1.GitRepoVolumeSource": {
             "type": "object",
             "properties": {
-                "requestId": {
+                "directory": {
+                    "description": "directory is the target directory name.\nMust not contain or start with '..'.  If '.' is supplied, the volume directory will be the\ngit repository.  Otherwise, if specified, the volume will contain the git repository in\nthe subdirectory with the given name.\n+optional",
                     "type": "string"
                 },
-                "timestamp": {
+                "repository": {
+                    "description": "repository is the URL",
+                    "type": "string"
+                },
+                "revision": {
+                    "description": "revision is the commit hash for the specified revision.\n+optional",
                     "type": "string"
                 }
             }
         },
-        "github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.SuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "metadata": {
-                    "$ref": "#/definitions/github_com_sumandas0_k8s-cluster-agent_internal_transport_http_responses.Metadata"
-                }
-            }
-        },
-        "internal_transport_http_handlers.HealthResponse": {
+        "v1.GlusterfsVolumeSource": {
             "type": "object",
             "properties": {
-                "status": {
+                "endpoints": {
+                    "description": "endpoints is the endpoint name that details Glusterfs topology.\nMore info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "path is the Glusterfs volume path.\nMore info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
                     "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the Glusterfs volume to be mounted with read-only permissions.\nDefaults to false.\nMore info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "k8s_io_api_core_v1.ConditionStatus": {
+        "v1.HostPathType": {
             "type": "string",
             "enum": [
-                "True",
-                "False",
-                "Unknown"
+                "",
+                "DirectoryOrCreate",
+                "Directory",
+                "FileOrCreate",
+                "File",
+                "Socket",
+                "CharDevice",
+                "BlockDevice"
             ],
             "x-enum-varnames": [
-                "ConditionTrue",
-                "ConditionFalse",
-                "ConditionUnknown"
+                "HostPathUnset",
+                "HostPathDirectoryOrCreate",
+                "HostPathDirectory",
+                "HostPathFileOrCreate",
+                "HostPathFile",
+                "HostPathSocket",
+                "HostPathCharDev",
+                "HostPathBlockDev"
             ]
         },
-        "resource.Quantity": {
-            "type": "object",
-            "properties": {
-                "Format": {
-                    "type": "string",
-                    "enum": [
-                        "DecimalExponent",
-                        "BinarySI",
-                        "DecimalSI"
-                    ],
-                    "x-enum-comments": {
-                        "BinarySI": "e.g., 12Mi (12 * 2^20)",
-                        "DecimalExponent": "e.g., 12e6",
-                        "DecimalSI": "e.g., 12M  (12 * 10^6)"
-                    },
-                    "x-enum-varnames": [
-                        "DecimalExponent",
-                        "BinarySI",
-                        "DecimalSI"
-                    ]
-                }
-            }
-        },
-        "v1.Affinity": {
+        "v1.HostPathVolumeSource": {
             "type": "object",
             "properties": {
-                "nodeAffinity": {
-                    "description": "Describes node affinity scheduling rules for the pod.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/v1.NodeAffinity"
-                        }
-                    ]
+                "path": {
+                    "description": "path of the directory on the host.\nIf the path is a symlink, it will follow the link to the real path.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+                    "type": "string"
                 },
-                "podAffinity": {
-                    "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).\n+optional",
+                "type": {
+                    "description": "type for HostPath Volume\nDefaults to \"\"\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath\n+optional",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.PodAffinity"
+                            "$ref": "#/definitions/v1.HostPathType"
                         }
                     ]
+                }
+            }
+        },
+        "v1.ISCSIVolumeSource": {
+            "type": "object",
+            "properties": {
+                "chapAuthDiscovery": {
+                    "description": "chapAuthDiscovery defines whether support iSCSI Discovery CHAP authentication\n+optional",
+                    "type": "boolean"
                 },
-                "podAntiAffinity": {
-                    "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).\n+optional",
+                "chapAuthSession": {
+                    "description": "chapAuthSession defines whether support iSCSI Session CHAP authentication\n+optional",
+                    "type": "boolean"
+                },
+                "fsType": {
+                    "description": "fsType is the filesystem type of the volume that you want to mount.\nTip: Ensure that the filesystem type is supported by the host operating system.\nExamples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi\nTODO: how do we prevent errors in the filesystem from compromising the machine\n+optional",
+                    "type": "string"
+                },
+                "initiatorName": {
+                    "description": "initiatorName is the custom iSCSI Initiator Name.\nIf initiatorName is specified with iscsiInterface simultaneously, new iSCSI interface\n\u003ctarget portal\u003e:\u003cvolume name\u003e will be created for the connection.\n+optional",
+                    "type": "string"
+                },
+                "iqn": {
+                    "description": "iqn is the target iSCSI Qualified Name.",
+                    "type": "string"
+                },
+                "iscsiInterface": {
+                    "description": "iscsiInterface is the interface Name that uses an iSCSI transport.\nDefaults to 'default' (tcp).\n+optional",
+                    "type": "string"
+                },
+                "lun": {
+                    "description": "lun represents iSCSI Target Lun number.",
+                    "type": "integer"
+                },
+                "portals": {
+                    "description": "portals is the iSCSI Target Portal List. The portal is either an IP or ip_addr:port if the port\nis other than default (typically TCP ports 860 and 3260).\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the ReadOnly setting in VolumeMounts.\nDefaults to false.\n+optional",
+                    "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "secretRef is the CHAP Secret for iSCSI target and initiator authentication\n+optional",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.PodAntiAffinity"
+                            "$ref": "#/definitions/v1.LocalObjectReference"
                         }
                     ]
+                },
+                "targetPortal": {
+                    "description": "targetPortal is iSCSI Target Portal. The Portal is either an IP or ip_addr:port if the port\nis other than default (typically TCP ports 860 and 3260).",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KeyToPath": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "key is the key to project.",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "mode is Optional: mode bits used to set permissions on this file.\nMust be an octal value between 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values for mode bits.\nIf not specified, the volume defaultMode will be used.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
+                },
+                "path": {
+                    "description": "path is the relative path of the file to map the key to.\nMay not be an absolute path.\nMay not contain the path element '..'.\nMay not start with the string '..'.",
+                    "type": "string"
                 }
             }
         },
@@ -2154,6 +6721,84 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.LocalObjectReference": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ManagedFieldsEntry": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "description": "APIVersion defines the version of this resource that this field set\napplies to. The format is \"group/version\" just like the top-level\nAPIVersion field. It is necessary to track the version of a field\nset because it cannot be automatically converted.",
+                    "type": "string"
+                },
+                "fieldsType": {
+                    "description": "FieldsType is the discriminator for the different fields format and version.\nThere is currently only one possible value: \"FieldsV1\"",
+                    "type": "string"
+                },
+                "fieldsV1": {
+                    "description": "FieldsV1 holds the first JSON version format as described in the \"FieldsV1\" type.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.FieldsV1"
+                        }
+                    ]
+                },
+                "manager": {
+                    "description": "Manager is an identifier of the workflow managing these fields.",
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "Operation is the type of operation which lead to this ManagedFieldsEntry being created.\nThe only valid values for this field are 'Apply' and 'Update'.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ManagedFieldsOperationType"
+                        }
+                    ]
+                },
+                "subresource": {
+                    "description": "Subresource is the name of the subresource used to update that object, or\nempty string if the object was updated through the main resource. The\nvalue of this field is used to distinguish between managers, even if they\nshare the same name. For example, a status update will be distinct from a\nregular update using the same manager name.\nNote that the APIVersion field is not related to the Subresource field and\nit always corresponds to the version of the main resource.",
+                    "type": "string"
+                },
+                "time": {
+                    "description": "Time is the timestamp of when the ManagedFields entry was added. The\ntimestamp will also be updated if a field is added, the manager\nchanges any of the owned fields value or removes a field. The\ntimestamp does not update when a field is removed from the entry\nbecause another manager took it over.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ManagedFieldsOperationType": {
+            "type": "string",
+            "enum": [
+                "Apply",
+                "Update"
+            ],
+            "x-enum-varnames": [
+                "ManagedFieldsOperationApply",
+                "ManagedFieldsOperationUpdate"
+            ]
+        },
+        "v1.NFSVolumeSource": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "description": "path that is exported by the NFS server.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the NFS export to be mounted with read-only permissions.\nDefaults to false.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#nfs\n+optional",
+                    "type": "boolean"
+                },
+                "server": {
+                    "description": "server is the hostname or IP address of the NFS server.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+                    "type": "string"
+                }
+            }
+        },
         "v1.NodeAffinity": {
             "type": "object",
             "properties": {
@@ -2212,39 +6857,294 @@ const docTemplate = `{
                     "description": "The label key that the selector applies to.",
                     "type": "string"
                 },
-                "operator": {
-                    "description": "Represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+                "operator": {
+                    "description": "Represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.NodeSelectorOperator"
+                        }
+                    ]
+                },
+                "values": {
+                    "description": "An array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. If the operator is Gt or Lt, the values\narray must have a single element, which will be interpreted as an integer.\nThis array is replaced during a strategic merge patch.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.NodeSelectorTerm": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "description": "A list of node selector requirements by node's labels.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.NodeSelectorRequirement"
+                    }
+                },
+                "matchFields": {
+                    "description": "A list of node selector requirements by node's fields.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.NodeSelectorRequirement"
+                    }
+                }
+            }
+        },
+        "v1.ObjectFieldSelector": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "description": "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".\n+optional",
+                    "type": "string"
+                },
+                "fieldPath": {
+                    "description": "Path of the field to select in the specified API version.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ObjectMeta": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "description": "Annotations is an unstructured key value map stored with a resource that may be\nset by external tools to store and retrieve arbitrary metadata. They are not\nqueryable and should be preserved when modifying objects.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "creationTimestamp": {
+                    "description": "CreationTimestamp is a timestamp representing the server time when this object was\ncreated. It is not guaranteed to be set in happens-before order across separate operations.\nClients may not set this value. It is represented in RFC3339 form and is in UTC.\n\nPopulated by the system.\nRead-only.\nNull for lists.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional",
+                    "type": "string"
+                },
+                "deletionGracePeriodSeconds": {
+                    "description": "Number of seconds allowed for this object to gracefully terminate before\nit will be removed from the system. Only set when deletionTimestamp is also set.\nMay only be shortened.\nRead-only.\n+optional",
+                    "type": "integer"
+                },
+                "deletionTimestamp": {
+                    "description": "DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This\nfield is set by the server when a graceful deletion is requested by the user, and is not\ndirectly settable by a client. The resource is expected to be deleted (no longer visible\nfrom resource lists, and not reachable by name) after the time in this field, once the\nfinalizers list is empty. As long as the finalizers list contains items, deletion is blocked.\nOnce the deletionTimestamp is set, this value may not be unset or be set further into the\nfuture, although it may be shortened or the resource may be deleted prior to this time.\nFor example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react\nby sending a graceful termination signal to the containers in the pod. After that 30 seconds,\nthe Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup,\nremove the pod from the API. In the presence of network partitions, this object may still\nexist after this timestamp, until an administrator or automated process can determine the\nresource is fully terminated.\nIf not set, graceful deletion of the object has not been requested.\n\nPopulated by the system when a graceful deletion is requested.\nRead-only.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional",
+                    "type": "string"
+                },
+                "finalizers": {
+                    "description": "Must be empty before the object is deleted from the registry. Each entry\nis an identifier for the responsible component that will remove the entry\nfrom the list. If the deletionTimestamp of the object is non-nil, entries\nin this list can only be removed.\nFinalizers may be processed and removed in any order.  Order is NOT enforced\nbecause it introduces significant risk of stuck finalizers.\nfinalizers is a shared field, any actor with permission can reorder it.\nIf the finalizer list is processed in order, then this can lead to a situation\nin which the component responsible for the first finalizer in the list is\nwaiting for a signal (field value, external system, or other) produced by a\ncomponent responsible for a finalizer later in the list, resulting in a deadlock.\nWithout enforced ordering finalizers are free to order amongst themselves and\nare not vulnerable to ordering changes in the list.\n+optional\n+patchStrategy=merge",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generateName": {
+                    "description": "GenerateName is an optional prefix, used by the server, to generate a unique\nname ONLY IF the Name field has not been provided.\nIf this field is used, the name returned to the client will be different\nthan the name passed. This value will also be combined with a unique suffix.\nThe provided value has the same validation rules as the Name field,\nand may be truncated by the length of the suffix required to make the value\nunique on the server.\n\nIf this field is specified and the generated name exists, the server will return a 409.\n\nApplied only if Name is not specified.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency\n+optional",
+                    "type": "string"
+                },
+                "generation": {
+                    "description": "A sequence number representing a specific generation of the desired state.\nPopulated by the system. Read-only.\n+optional",
+                    "type": "integer"
+                },
+                "labels": {
+                    "description": "Map of string keys and values that can be used to organize and categorize\n(scope and select) objects. May match selectors of replication controllers\nand services.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "managedFields": {
+                    "description": "ManagedFields maps workflow-id and version to the set of fields\nthat are managed by that workflow. This is mostly for internal\nhousekeeping, and users typically shouldn't need to set or\nunderstand this field. A workflow can be the user's name, a\ncontroller's name, or the name of a specific apply path like\n\"ci-cd\". The set of fields is always in the version that the\nworkflow used when modifying the object.\n\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ManagedFieldsEntry"
+                    }
+                },
+                "name": {
+                    "description": "Name must be unique within a namespace. Is required when creating resources, although\nsome resources may allow a client to request the generation of an appropriate name\nautomatically. Name is primarily intended for creation idempotence and configuration\ndefinition.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names\n+optional",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace defines the space within which each name must be unique. An empty namespace is\nequivalent to the \"default\" namespace, but \"default\" is the canonical representation.\nNot all objects are required to be scoped to a namespace - the value of this field for\nthose objects will be empty.\n\nMust be a DNS_LABEL.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces\n+optional",
+                    "type": "string"
+                },
+                "ownerReferences": {
+                    "description": "List of objects depended by this object. If ALL objects in the list have\nbeen deleted, this object will be garbage collected. If this object is managed by a controller,\nthen an entry in this list will point to this controller, with the controller field set to true.\nThere cannot be more than one managing controller.\n+optional\n+patchMergeKey=uid\n+patchStrategy=merge",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.OwnerReference"
+                    }
+                },
+                "resourceVersion": {
+                    "description": "An opaque value that represents the internal version of this object that can\nbe used by clients to determine when objects have changed. May be used for optimistic\nconcurrency, change detection, and the watch operation on a resource or set of resources.\nClients must treat these values as opaque and passed unmodified back to the server.\nThey may only be valid for a particular resource or set of resources.\n\nPopulated by the system.\nRead-only.\nValue must be treated as opaque by clients and .\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency\n+optional",
+                    "type": "string"
+                },
+                "selfLink": {
+                    "description": "Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.\n+optional",
+                    "type": "string"
+                },
+                "uid": {
+                    "description": "UID is the unique in time and space value for this object. It is typically generated by\nthe server on successful creation of a resource and is not allowed to change on PUT\noperations.\n\nPopulated by the system.\nRead-only.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OwnerReference": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "description": "API version of the referent.",
+                    "type": "string"
+                },
+                "blockOwnerDeletion": {
+                    "description": "If true, AND if the owner has the \"foregroundDeletion\" finalizer, then\nthe owner cannot be deleted from the key-value store until this\nreference is removed.\nSee https://kubernetes.io/docs/concepts/architecture/garbage-collection/#foreground-deletion\nfor how the garbage collector interacts with this field and enforces the foreground deletion.\nDefaults to false.\nTo set this field, a user needs \"delete\" permission of the owner,\notherwise 422 (Unprocessable Entity) will be returned.\n+optional",
+                    "type": "boolean"
+                },
+                "controller": {
+                    "description": "If true, this reference points to the managing controller.\n+optional",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "description": "Kind of the referent.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names",
+                    "type": "string"
+                },
+                "uid": {
+                    "description": "UID of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PersistentVolumeAccessMode": {
+            "type": "string",
+            "enum": [
+                "ReadWriteOnce",
+                "ReadOnlyMany",
+                "ReadWriteMany",
+                "ReadWriteOncePod"
+            ],
+            "x-enum-varnames": [
+                "ReadWriteOnce",
+                "ReadOnlyMany",
+                "ReadWriteMany",
+                "ReadWriteOncePod"
+            ]
+        },
+        "v1.PersistentVolumeClaimSpec": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
+                    "description": "accessModes contains the desired access modes the volume should have.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
+                },
+                "dataSource": {
+                    "description": "dataSource field can be used to specify either:\n* An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)\n* An existing PVC (PersistentVolumeClaim)\nIf the provisioner or an external controller can support the specified data source,\nit will create a new volume based on the contents of the specified data source.\nWhen the AnyVolumeDataSource feature gate is enabled, dataSource contents will be copied to dataSourceRef,\nand dataSourceRef contents will be copied to dataSource when dataSourceRef.namespace is not specified.\nIf the namespace is specified, then dataSourceRef will not be copied to dataSource.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.TypedLocalObjectReference"
+                        }
+                    ]
+                },
+                "dataSourceRef": {
+                    "description": "dataSourceRef specifies the object from which to populate the volume with data, if a non-empty\nvolume is desired. This may be any object from a non-empty API group (non\ncore object) or a PersistentVolumeClaim object.\nWhen this field is specified, volume binding will only succeed if the type of\nthe specified object matches some installed volume populator or dynamic\nprovisioner.\nThis field will replace the functionality of the dataSource field and as such\nif both fields are non-empty, they must have the same value. For backwards\ncompatibility, when namespace isn't specified in dataSourceRef,\nboth fields (dataSource and dataSourceRef) will be set to the same\nvalue automatically if one of them is empty and the other is non-empty.\nWhen namespace is specified in dataSourceRef,\ndataSource isn't set to the same value and must be empty.\nThere are three important differences between dataSource and dataSourceRef:\n* While dataSource only allows two specific types of objects, dataSourceRef\n  allows any non-core object, as well as PersistentVolumeClaim objects.\n* While dataSource ignores disallowed values (dropping them), dataSourceRef\n  preserves all values, and generates an error if a disallowed value is\n  specified.\n* While dataSource only allows local objects, dataSourceRef allows objects\n  in any namespaces.\n(Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.\n(Alpha) Using the namespace field of dataSourceRef requires the CrossNamespaceVolumeDataSource feature gate to be enabled.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.TypedObjectReference"
+                        }
+                    ]
+                },
+                "resources": {
+                    "description": "resources represents the minimum resources the volume should have.\nIf RecoverVolumeExpansionFailure feature is enabled users are allowed to specify resource requirements\nthat are lower than previous value but must still be higher than capacity recorded in the\nstatus field of the claim.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ResourceRequirements"
+                        }
+                    ]
+                },
+                "selector": {
+                    "description": "selector is a label query over volumes to consider for binding.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LabelSelector"
+                        }
+                    ]
+                },
+                "storageClassName": {
+                    "description": "storageClassName is the name of the StorageClass required by the claim.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1\n+optional",
+                    "type": "string"
+                },
+                "volumeMode": {
+                    "description": "volumeMode defines what type of volume is required by the claim.\nValue of Filesystem is implied when not included in claim spec.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeMode"
+                        }
+                    ]
+                },
+                "volumeName": {
+                    "description": "volumeName is the binding reference to the PersistentVolume backing this claim.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PersistentVolumeClaimTemplate": {
+            "type": "object",
+            "properties": {
+                "metadata": {
+                    "description": "May contain labels and annotations that will be copied into the PVC\nwhen creating it. No other fields are allowed and will be rejected during\nvalidation.\n\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ObjectMeta"
+                        }
+                    ]
+                },
+                "spec": {
+                    "description": "The specification for the PersistentVolumeClaim. The entire content is\ncopied unchanged into the PVC that gets created from this\ntemplate. The same fields as in a PersistentVolumeClaim\nare also valid here.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.NodeSelectorOperator"
+                            "$ref": "#/definitions/v1.PersistentVolumeClaimSpec"
                         }
                     ]
+                }
+            }
+        },
+        "v1.PersistentVolumeClaimVolumeSource": {
+            "type": "object",
+            "properties": {
+                "claimName": {
+                    "description": "claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims",
+                    "type": "string"
                 },
-                "values": {
-                    "description": "An array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. If the operator is Gt or Lt, the values\narray must have a single element, which will be interpreted as an integer.\nThis array is replaced during a strategic merge patch.\n+optional",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "readOnly": {
+                    "description": "readOnly Will force the ReadOnly setting in VolumeMounts.\nDefault false.\n+optional",
+                    "type": "boolean"
                 }
             }
         },
-        "v1.NodeSelectorTerm": {
+        "v1.PersistentVolumeMode": {
+            "type": "string",
+            "enum": [
+                "Block",
+                "Filesystem"
+            ],
+            "x-enum-varnames": [
+                "PersistentVolumeBlock",
+                "PersistentVolumeFilesystem"
+            ]
+        },
+        "v1.PhotonPersistentDiskVolumeSource": {
             "type": "object",
             "properties": {
-                "matchExpressions": {
-                    "description": "A list of node selector requirements by node's labels.\n+optional",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.NodeSelectorRequirement"
-                    }
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.",
+                    "type": "string"
                 },
-                "matchFields": {
-                    "description": "A list of node selector requirements by node's fields.\n+optional",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.NodeSelectorRequirement"
-                    }
+                "pdID": {
+                    "description": "pdID is the ID that identifies Photon Controller persistent disk",
+                    "type": "string"
                 }
             }
         },
@@ -2345,56 +7245,397 @@ const docTemplate = `{
                         }
                     ]
                 },
-                "type": {
-                    "description": "Type is the type of the condition.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-conditions",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/v1.PodConditionType"
-                        }
-                    ]
+                "type": {
+                    "description": "Type is the type of the condition.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-conditions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodConditionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.PodConditionType": {
+            "type": "string",
+            "enum": [
+                "ContainersReady",
+                "Initialized",
+                "Ready",
+                "PodScheduled",
+                "DisruptionTarget"
+            ],
+            "x-enum-varnames": [
+                "ContainersReady",
+                "PodInitialized",
+                "PodReady",
+                "PodScheduled",
+                "DisruptionTarget"
+            ]
+        },
+        "v1.PortworxVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "fSType represents the filesystem type to mount\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\". Implicitly inferred to be \"ext4\" if unspecified.",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
+                },
+                "volumeID": {
+                    "description": "volumeID uniquely identifies a Portworx volume",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PreferredSchedulingTerm": {
+            "type": "object",
+            "properties": {
+                "preference": {
+                    "description": "A node selector term, associated with the corresponding weight.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.NodeSelectorTerm"
+                        }
+                    ]
+                },
+                "weight": {
+                    "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ProjectedVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "description": "defaultMode are the mode bits used to set permissions on created files by default.\nMust be an octal value between 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values for mode bits.\nDirectories within the path are not affected by this setting.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
+                },
+                "sources": {
+                    "description": "sources is the list of volume projections\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.VolumeProjection"
+                    }
+                }
+            }
+        },
+        "v1.QuobyteVolumeSource": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "group to map volume access to\nDefault is no group\n+optional",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the Quobyte volume to be mounted with read-only permissions.\nDefaults to false.\n+optional",
+                    "type": "boolean"
+                },
+                "registry": {
+                    "description": "registry represents a single or multiple Quobyte Registry services\nspecified as a string as host:port pair (multiple entries are separated with commas)\nwhich acts as the central registry for volumes",
+                    "type": "string"
+                },
+                "tenant": {
+                    "description": "tenant owning the given Quobyte volume in the Backend\nUsed with dynamically provisioned Quobyte volumes, value is set by the plugin\n+optional",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "user to map volume access to\nDefaults to serivceaccount user\n+optional",
+                    "type": "string"
+                },
+                "volume": {
+                    "description": "volume is a string that references an already created Quobyte volume by name.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RBDVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "fsType is the filesystem type of the volume that you want to mount.\nTip: Ensure that the filesystem type is supported by the host operating system.\nExamples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#rbd\nTODO: how do we prevent errors in the filesystem from compromising the machine\n+optional",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "image is the rados image name.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+                    "type": "string"
+                },
+                "keyring": {
+                    "description": "keyring is the path to key ring for RBDUser.\nDefault is /etc/ceph/keyring.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it\n+optional",
+                    "type": "string"
+                },
+                "monitors": {
+                    "description": "monitors is a collection of Ceph monitors.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pool": {
+                    "description": "pool is the rados pool name.\nDefault is rbd.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it\n+optional",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly here will force the ReadOnly setting in VolumeMounts.\nDefaults to false.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it\n+optional",
+                    "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "secretRef is name of the authentication secret for RBDUser. If provided\noverrides keyring.\nDefault is nil.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "user is the rados user name.\nDefault is admin.\nMore info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResourceClaim": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name must match the name of one entry in pod.spec.resourceClaims of\nthe Pod where this field is used. It makes that resource available\ninside a container.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResourceFieldSelector": {
+            "type": "object",
+            "properties": {
+                "containerName": {
+                    "description": "Container name: required for volumes, optional for env vars\n+optional",
+                    "type": "string"
+                },
+                "divisor": {
+                    "description": "Specifies the output format of the exposed resources, defaults to \"1\"\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/resource.Quantity"
+                        }
+                    ]
+                },
+                "resource": {
+                    "description": "Required: resource to select",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResourceList": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/resource.Quantity"
+            }
+        },
+        "v1.ResourceRequirements": {
+            "type": "object",
+            "properties": {
+                "claims": {
+                    "description": "Claims lists the names of resources, defined in spec.resourceClaims,\nthat are used by this container.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate.\n\nThis field is immutable. It can only be set for containers.\n\n+listType=map\n+listMapKey=name\n+featureGate=DynamicResourceAllocation\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ResourceClaim"
+                    }
+                },
+                "limits": {
+                    "description": "Limits describes the maximum amount of compute resources allowed.\nMore info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ResourceList"
+                        }
+                    ]
+                },
+                "requests": {
+                    "description": "Requests describes the minimum amount of compute resources required.\nIf Requests is omitted for a container, it defaults to Limits if that is explicitly specified,\notherwise to an implementation-defined value. Requests cannot exceed Limits.\nMore info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ResourceList"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.ScaleIOVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\".\nDefault is \"xfs\".\n+optional",
+                    "type": "string"
+                },
+                "gateway": {
+                    "description": "gateway is the host address of the ScaleIO API Gateway.",
+                    "type": "string"
+                },
+                "protectionDomain": {
+                    "description": "protectionDomain is the name of the ScaleIO Protection Domain for the configured storage.\n+optional",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly Defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "secretRef references to the secret for ScaleIO user and other\nsensitive information. If this is not provided, Login operation will fail.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LocalObjectReference"
+                        }
+                    ]
+                },
+                "sslEnabled": {
+                    "description": "sslEnabled Flag enable/disable SSL communication with Gateway, default false\n+optional",
+                    "type": "boolean"
+                },
+                "storageMode": {
+                    "description": "storageMode indicates whether the storage for a volume should be ThickProvisioned or ThinProvisioned.\nDefault is ThinProvisioned.\n+optional",
+                    "type": "string"
+                },
+                "storagePool": {
+                    "description": "storagePool is the ScaleIO Storage Pool associated with the protection domain.\n+optional",
+                    "type": "string"
+                },
+                "system": {
+                    "description": "system is the name of the storage system as configured in ScaleIO.",
+                    "type": "string"
+                },
+                "volumeName": {
+                    "description": "volumeName is the name of a volume already created in the ScaleIO system\nthat is associated with this volume source.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SecretKeySelector": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "The key of the secret to select from.  Must be a valid secret key.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
+                    "type": "string"
+                },
+                "optional": {
+                    "description": "Specify whether the Secret or its key must be defined\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.SecretProjection": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "items if unspecified, each key-value pair in the Data field of the referenced\nSecret will be projected into the volume as a file whose name is the\nkey and content is the value. If specified, the listed keys will be\nprojected into the specified paths, and unlisted keys will not be\npresent. If a key is specified which is not present in the Secret,\nthe volume setup will error unless it is marked optional. Paths must be\nrelative and may not contain the '..' path or start with '..'.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.KeyToPath"
+                    }
+                },
+                "name": {
+                    "description": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional",
+                    "type": "string"
+                },
+                "optional": {
+                    "description": "optional field specify whether the Secret or its key must be defined\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.SecretVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "description": "defaultMode is Optional: mode bits used to set permissions on created files by default.\nMust be an octal value between 0000 and 0777 or a decimal value between 0 and 511.\nYAML accepts both octal and decimal values, JSON requires decimal values\nfor mode bits. Defaults to 0644.\nDirectories within the path are not affected by this setting.\nThis might be in conflict with other options that affect the file\nmode, like fsGroup, and the result can be other mode bits set.\n+optional",
+                    "type": "integer"
+                },
+                "items": {
+                    "description": "items If unspecified, each key-value pair in the Data field of the referenced\nSecret will be projected into the volume as a file whose name is the\nkey and content is the value. If specified, the listed keys will be\nprojected into the specified paths, and unlisted keys will not be\npresent. If a key is specified which is not present in the Secret,\nthe volume setup will error unless it is marked optional. Paths must be\nrelative and may not contain the '..' path or start with '..'.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.KeyToPath"
+                    }
+                },
+                "optional": {
+                    "description": "optional field specify whether the Secret or its keys must be defined\n+optional",
+                    "type": "boolean"
+                },
+                "secretName": {
+                    "description": "secretName is the name of the secret in the pod's namespace to use.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#secret\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ServiceAccountTokenProjection": {
+            "type": "object",
+            "properties": {
+                "audience": {
+                    "description": "audience is the intended audience of the token. A recipient of a token\nmust identify itself with an identifier specified in the audience of the\ntoken, and otherwise should reject the token. The audience defaults to the\nidentifier of the apiserver.\n+optional",
+                    "type": "string"
+                },
+                "expirationSeconds": {
+                    "description": "expirationSeconds is the requested duration of validity of the service\naccount token. As the token approaches expiration, the kubelet volume\nplugin will proactively rotate the service account token. The kubelet will\nstart trying to rotate the token if the token is older than 80 percent of\nits time to live or if the token is older than 24 hours.Defaults to 1 hour\nand must be at least 10 minutes.\n+optional",
+                    "type": "integer"
+                },
+                "path": {
+                    "description": "path is the path relative to the mount point of the file to project the\ntoken into.",
+                    "type": "string"
                 }
             }
         },
-        "v1.PodConditionType": {
+        "v1.StorageMedium": {
             "type": "string",
             "enum": [
-                "ContainersReady",
-                "Initialized",
-                "Ready",
-                "PodScheduled",
-                "DisruptionTarget"
+                "",
+                "Memory",
+                "HugePages",
+                "HugePages-"
             ],
+            "x-enum-comments": {
+                "StorageMediumDefault": "use whatever the default is for the node, assume anything we don't explicitly handle is this",
+                "StorageMediumHugePages": "use hugepages",
+                "StorageMediumHugePagesPrefix": "prefix for full medium notation HugePages-\u003csize\u003e",
+                "StorageMediumMemory": "use memory (e.g. tmpfs on linux)"
+            },
             "x-enum-varnames": [
-                "ContainersReady",
-                "PodInitialized",
-                "PodReady",
-                "PodScheduled",
-                "DisruptionTarget"
+                "StorageMediumDefault",
+                "StorageMediumMemory",
+                "StorageMediumHugePages",
+                "StorageMediumHugePagesPrefix"
             ]
         },
-        "v1.PreferredSchedulingTerm": {
+        "v1.StorageOSVolumeSource": {
             "type": "object",
             "properties": {
-                "preference": {
-                    "description": "A node selector term, associated with the corresponding weight.",
+                "fsType": {
+                    "description": "fsType is the filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\n+optional",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "readOnly defaults to false (read/write). ReadOnly here will force\nthe ReadOnly setting in VolumeMounts.\n+optional",
+                    "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "secretRef specifies the secret to use for obtaining the StorageOS API\ncredentials.  If not specified, default values will be attempted.\n+optional",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.NodeSelectorTerm"
+                            "$ref": "#/definitions/v1.LocalObjectReference"
                         }
                     ]
                 },
-                "weight": {
-                    "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
-                    "type": "integer"
+                "volumeName": {
+                    "description": "volumeName is the human-readable name of the StorageOS volume.  Volume\nnames are only unique within a namespace.",
+                    "type": "string"
+                },
+                "volumeNamespace": {
+                    "description": "volumeNamespace specifies the scope of the volume within StorageOS.  If no\nnamespace is specified then the Pod's namespace will be used.  This allows the\nKubernetes name scoping to be mirrored within StorageOS for tighter integration.\nSet VolumeName to any name to override the default behaviour.\nSet to \"default\" if you are not using namespaces within StorageOS.\nNamespaces that do not pre-exist within StorageOS will be created.\n+optional",
+                    "type": "string"
                 }
             }
         },
-        "v1.ResourceList": {
-            "type": "object",
-            "additionalProperties": {
-                "$ref": "#/definitions/resource.Quantity"
-            }
-        },
         "v1.TaintEffect": {
             "type": "string",
             "enum": [
@@ -2452,6 +7693,339 @@ const docTemplate = `{
                 "TolerationOpEqual"
             ]
         },
+        "v1.TypedLocalObjectReference": {
+            "type": "object",
+            "properties": {
+                "apiGroup": {
+                    "description": "APIGroup is the group for the resource being referenced.\nIf APIGroup is not specified, the specified Kind must be in the core API group.\nFor any other third-party types, APIGroup is required.\n+optional",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Kind is the type of resource being referenced",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of resource being referenced",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TypedObjectReference": {
+            "type": "object",
+            "properties": {
+                "apiGroup": {
+                    "description": "APIGroup is the group for the resource being referenced.\nIf APIGroup is not specified, the specified Kind must be in the core API group.\nFor any other third-party types, APIGroup is required.\n+optional",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Kind is the type of resource being referenced",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of resource being referenced",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace is the namespace of resource being referenced\nNote that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.\n(Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.\n+featureGate=CrossNamespaceVolumeDataSource\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.VolumeProjection": {
+            "type": "object",
+            "properties": {
+                "configMap": {
+                    "description": "configMap information about the configMap data to project\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ConfigMapProjection"
+                        }
+                    ]
+                },
+                "downwardAPI": {
+                    "description": "downwardAPI information about the downwardAPI data to project\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.DownwardAPIProjection"
+                        }
+                    ]
+                },
+                "secret": {
+                    "description": "secret information about the secret data to project\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.SecretProjection"
+                        }
+                    ]
+                },
+                "serviceAccountToken": {
+                    "description": "serviceAccountToken is information about the serviceAccountToken data to project\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ServiceAccountTokenProjection"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.VolumeSource": {
+            "type": "object",
+            "properties": {
+                "awsElasticBlockStore": {
+                    "description": "awsElasticBlockStore represents an AWS Disk resource that is attached to a\nkubelet's host machine and then exposed to the pod.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AWSElasticBlockStoreVolumeSource"
+                        }
+                    ]
+                },
+                "azureDisk": {
+                    "description": "azureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AzureDiskVolumeSource"
+                        }
+                    ]
+                },
+                "azureFile": {
+                    "description": "azureFile represents an Azure File Service mount on the host and bind mount to the pod.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AzureFileVolumeSource"
+                        }
+                    ]
+                },
+                "cephfs": {
+                    "description": "cephFS represents a Ceph FS mount on the host that shares a pod's lifetime\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CephFSVolumeSource"
+                        }
+                    ]
+                },
+                "cinder": {
+                    "description": "cinder represents a cinder volume attached and mounted on kubelets host machine.\nMore info: https://examples.k8s.io/mysql-cinder-pd/README.md\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CinderVolumeSource"
+                        }
+                    ]
+                },
+                "configMap": {
+                    "description": "configMap represents a configMap that should populate this volume\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ConfigMapVolumeSource"
+                        }
+                    ]
+                },
+                "csi": {
+                    "description": "csi (Container Storage Interface) represents ephemeral storage that is handled by certain external CSI drivers (Beta feature).\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CSIVolumeSource"
+                        }
+                    ]
+                },
+                "downwardAPI": {
+                    "description": "downwardAPI represents downward API about the pod that should populate this volume\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.DownwardAPIVolumeSource"
+                        }
+                    ]
+                },
+                "emptyDir": {
+                    "description": "emptyDir represents a temporary directory that shares a pod's lifetime.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.EmptyDirVolumeSource"
+                        }
+                    ]
+                },
+                "ephemeral": {
+                    "description": "ephemeral represents a volume that is handled by a cluster storage driver.\nThe volume's lifecycle is tied to the pod that defines it - it will be created before the pod starts,\nand deleted when the pod is removed.\n\nUse this if:\na) the volume is only needed while the pod runs,\nb) features of normal volumes like restoring from snapshot or capacity\n   tracking are needed,\nc) the storage driver is specified through a storage class, and\nd) the storage driver supports dynamic volume provisioning through\n   a PersistentVolumeClaim (see EphemeralVolumeSource for more\n   information on the connection between this volume type\n   and PersistentVolumeClaim).\n\nUse PersistentVolumeClaim or one of the vendor-specific\nAPIs for volumes that persist for longer than the lifecycle\nof an individual pod.\n\nUse CSI for light-weight local ephemeral volumes if the CSI driver is meant to\nbe used that way - see the documentation of the driver for\nmore information.\n\nA pod can use both types of ephemeral volumes and\npersistent volumes at the same time.\n\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.EphemeralVolumeSource"
+                        }
+                    ]
+                },
+                "fc": {
+                    "description": "fc represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.FCVolumeSource"
+                        }
+                    ]
+                },
+                "flexVolume": {
+                    "description": "flexVolume represents a generic volume resource that is\nprovisioned/attached using an exec based plugin.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.FlexVolumeSource"
+                        }
+                    ]
+                },
+                "flocker": {
+                    "description": "flocker represents a Flocker volume attached to a kubelet's host machine. This depends on the Flocker control service being running\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.FlockerVolumeSource"
+                        }
+                    ]
+                },
+                "gcePersistentDisk": {
+                    "description": "gcePersistentDisk represents a GCE Disk resource that is attached to a\nkubelet's host machine and then exposed to the pod.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.GCEPersistentDiskVolumeSource"
+                        }
+                    ]
+                },
+                "gitRepo": {
+                    "description": "gitRepo represents a git repository at a particular revision.\nDEPRECATED: GitRepo is deprecated. To provision a container with a git repo, mount an\nEmptyDir into an InitContainer that clones the repo using git, then mount the EmptyDir\ninto the Pod's container.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.GitRepoVolumeSource"
+                        }
+                    ]
+                },
+                "glusterfs": {
+                    "description": "glusterfs represents a Glusterfs mount on the host that shares a pod's lifetime.\nMore info: https://examples.k8s.io/volumes/glusterfs/README.md\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.GlusterfsVolumeSource"
+                        }
+                    ]
+                },
+                "hostPath": {
+                    "description": "hostPath represents a pre-existing file or directory on the host\nmachine that is directly exposed to the container. This is generally\nused for system agents or other privileged things that are allowed\nto see the host machine. Most containers will NOT need this.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath\n---\nTODO(jonesdl) We need to restrict who can use host directory mounts and who can/can not\nmount host directories as read/write.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.HostPathVolumeSource"
+                        }
+                    ]
+                },
+                "iscsi": {
+                    "description": "iscsi represents an ISCSI Disk resource that is attached to a\nkubelet's host machine and then exposed to the pod.\nMore info: https://examples.k8s.io/volumes/iscsi/README.md\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ISCSIVolumeSource"
+                        }
+                    ]
+                },
+                "nfs": {
+                    "description": "nfs represents an NFS mount on the host that shares a pod's lifetime\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#nfs\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.NFSVolumeSource"
+                        }
+                    ]
+                },
+                "persistentVolumeClaim": {
+                    "description": "persistentVolumeClaimVolumeSource represents a reference to a\nPersistentVolumeClaim in the same namespace.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeClaimVolumeSource"
+                        }
+                    ]
+                },
+                "photonPersistentDisk": {
+                    "description": "photonPersistentDisk represents a PhotonController persistent disk attached and mounted on kubelets host machine",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PhotonPersistentDiskVolumeSource"
+                        }
+                    ]
+                },
+                "portworxVolume": {
+                    "description": "portworxVolume represents a portworx volume attached and mounted on kubelets host machine\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PortworxVolumeSource"
+                        }
+                    ]
+                },
+                "projected": {
+                    "description": "projected items for all in one resources secrets, configmaps, and downward API",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ProjectedVolumeSource"
+                        }
+                    ]
+                },
+                "quobyte": {
+                    "description": "quobyte represents a Quobyte mount on the host that shares a pod's lifetime\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.QuobyteVolumeSource"
+                        }
+                    ]
+                },
+                "rbd": {
+                    "description": "rbd represents a Rados Block Device mount on the host that shares a pod's lifetime.\nMore info: https://examples.k8s.io/volumes/rbd/README.md\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.RBDVolumeSource"
+                        }
+                    ]
+                },
+                "scaleIO": {
+                    "description": "scaleIO represents a ScaleIO persistent volume attached and mounted on Kubernetes nodes.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ScaleIOVolumeSource"
+                        }
+                    ]
+                },
+                "secret": {
+                    "description": "secret represents a secret that should populate this volume.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#secret\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.SecretVolumeSource"
+                        }
+                    ]
+                },
+                "storageos": {
+                    "description": "storageOS represents a StorageOS volume attached and mounted on Kubernetes nodes.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.StorageOSVolumeSource"
+                        }
+                    ]
+                },
+                "vsphereVolume": {
+                    "description": "vsphereVolume represents a vSphere volume attached and mounted on kubelets host machine\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.VsphereVirtualDiskVolumeSource"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.VsphereVirtualDiskVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "fsType is filesystem type to mount.\nMust be a filesystem type supported by the host operating system.\nEx. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified.\n+optional",
+                    "type": "string"
+                },
+                "storagePolicyID": {
+                    "description": "storagePolicyID is the storage Policy Based Management (SPBM) profile ID associated with the StoragePolicyName.\n+optional",
+                    "type": "string"
+                },
+                "storagePolicyName": {
+                    "description": "storagePolicyName is the storage Policy Based Management (SPBM) profile name.\n+optional",
+                    "type": "string"
+                },
+                "volumePath": {
+                    "description": "volumePath is the path that identifies vSphere volume vmdk",
+                    "type": "string"
+                }
+            }
+        },
         "v1.WeightedPodAffinityTerm": {
             "type": "object",
             "properties": {
@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -22,21 +24,113 @@ type Config struct {
 
 	EnableMetrics bool `env:"ENABLE_METRICS" default:"true"`
 
-	PodRestartThreshold int `env:"POD_RESTART_THRESHOLD" default:"5"`
+	PodRestartThreshold          int           `env:"POD_RESTART_THRESHOLD" default:"5"`
+	PodPendingGracePeriod        time.Duration `env:"POD_PENDING_GRACE_PERIOD" default:"5m"`
+	ClusterPodPendingGracePeriod time.Duration `env:"CLUSTER_POD_PENDING_GRACE_PERIOD" default:"30s"`
+
+	HealthScoringPolicyFile string `env:"HEALTH_SCORING_POLICY_FILE" default:""`
+
+	ThrottlingScrapeInterval time.Duration `env:"THROTTLING_SCRAPE_INTERVAL" default:"1m"`
+
+	HealthHistoryInterval  time.Duration `env:"HEALTH_HISTORY_INTERVAL" default:"0"`
+	HealthHistoryRetention time.Duration `env:"HEALTH_HISTORY_RETENTION" default:"24h"`
+	HealthHistoryMaxSeries int           `env:"HEALTH_HISTORY_MAX_SERIES" default:"2000"`
+	HealthHistoryFile      string        `env:"HEALTH_HISTORY_FILE" default:""`
+
+	CostPrices         ResourcePrices
+	CostNodePoolPrices map[string]ResourcePrices `env:"COST_NODE_POOL_PRICES"`
+	CostGPUResources   []string                  `env:"COST_GPU_RESOURCES"`
+
+	RedactionEnabled             bool     `env:"REDACTION_ENABLED" default:"true"`
+	RedactionKeyPatterns         []string `env:"REDACTION_KEY_PATTERNS"`
+	RedactionAnnotationAllowlist []string `env:"REDACTION_ANNOTATION_ALLOWLIST"`
+	RedactionEntropyThreshold    float64  `env:"REDACTION_ENTROPY_THRESHOLD" default:"4.2"`
+	UnredactedAccessToken        string   `env:"UNREDACTED_ACCESS_TOKEN" default:""`
+}
+
+// ResourcePrices are hourly prices used for cost estimates.
+type ResourcePrices struct {
+	CPUCoreHour   float64 `env:"COST_CPU_CORE_HOUR" default:"0.031611"`
+	MemoryGiBHour float64 `env:"COST_MEMORY_GIB_HOUR" default:"0.004237"`
+	GPUHour       float64 `env:"COST_GPU_HOUR" default:"0.95"`
+}
+
+// minHealthHistoryInterval keeps health history recording, which scores the
+// whole cluster, from loading the API server.
+const minHealthHistoryInterval = 30 * time.Second
+
+// minThrottlingScrapeInterval matches the shortest window CPU throttling is
+// measured over.
+const minThrottlingScrapeInterval = 10 * time.Second
+
+// DefaultCostGPUResources are the extended resources counted as GPUs and
+// charged at the GPU price.
+var DefaultCostGPUResources = []string{"nvidia.com/gpu"}
+
+var DefaultRedactionKeyPatterns = []string{
+	"*PASSWORD*",
+	"*PASSWD*",
+	"*TOKEN*",
+	"*SECRET*",
+	"*API_KEY*",
+	"*APIKEY*",
+	"*PRIVATE_KEY*",
+	"*CREDENTIAL*",
+	"*ACCESS_KEY*",
+}
+
+var DefaultRedactionAnnotationAllowlist = []string{
+	"kubernetes.io/*",
+	"prometheus.io/*",
+	"deployment.kubernetes.io/*",
+	"cluster-autoscaler.kubernetes.io/*",
+	"kubectl.kubernetes.io/default-container",
+	"kubectl.kubernetes.io/restartedAt",
 }
 
 func Load() (*Config, error) {
+	poolPrices, err := parseNodePoolPrices(getEnv("COST_NODE_POOL_PRICES", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
 	cfg := &Config{
-		Port:                getEnvAsInt("PORT", 8080),
-		ReadTimeout:         getEnvAsDuration("READ_TIMEOUT", 10*time.Second),
-		WriteTimeout:        getEnvAsDuration("WRITE_TIMEOUT", 10*time.Second),
-		ShutdownTimeout:     getEnvAsDuration("SHUTDOWN_TIMEOUT", 10*time.Second),
-		LogLevel:            getEnv("LOG_LEVEL", "info"),
-		LogFormat:           getEnv("LOG_FORMAT", "json"),
-		K8sTimeout:          getEnvAsDuration("K8S_TIMEOUT", 30*time.Second),
-		NodeName:            getEnv("NODE_NAME", ""),
-		EnableMetrics:       getEnvAsBool("ENABLE_METRICS", true),
-		PodRestartThreshold: getEnvAsInt("POD_RESTART_THRESHOLD", 5),
+		Port:                  getEnvAsInt("PORT", 8080),
+		ReadTimeout:           getEnvAsDuration("READ_TIMEOUT", 10*time.Second),
+		WriteTimeout:          getEnvAsDuration("WRITE_TIMEOUT", 10*time.Second),
+		ShutdownTimeout:       getEnvAsDuration("SHUTDOWN_TIMEOUT", 10*time.Second),
+		LogLevel:              getEnv("LOG_LEVEL", "info"),
+		LogFormat:             getEnv("LOG_FORMAT", "json"),
+		K8sTimeout:            getEnvAsDuration("K8S_TIMEOUT", 30*time.Second),
+		NodeName:              getEnv("NODE_NAME", ""),
+		EnableMetrics:         getEnvAsBool("ENABLE_METRICS", true),
+		PodRestartThreshold:   getEnvAsInt("POD_RESTART_THRESHOLD", 5),
+		PodPendingGracePeriod: getEnvAsDuration("POD_PENDING_GRACE_PERIOD", 5*time.Minute),
+
+		ClusterPodPendingGracePeriod: getEnvAsDuration("CLUSTER_POD_PENDING_GRACE_PERIOD", 30*time.Second),
+
+		HealthScoringPolicyFile: getEnv("HEALTH_SCORING_POLICY_FILE", ""),
+
+		ThrottlingScrapeInterval: getEnvAsDuration("THROTTLING_SCRAPE_INTERVAL", time.Minute),
+
+		HealthHistoryInterval:  getEnvAsDuration("HEALTH_HISTORY_INTERVAL", 0),
+		HealthHistoryRetention: getEnvAsDuration("HEALTH_HISTORY_RETENTION", 24*time.Hour),
+		HealthHistoryMaxSeries: getEnvAsInt("HEALTH_HISTORY_MAX_SERIES", 2000),
+		HealthHistoryFile:      getEnv("HEALTH_HISTORY_FILE", ""),
+
+		CostPrices: ResourcePrices{
+			CPUCoreHour:   getEnvAsFloat("COST_CPU_CORE_HOUR", 0.031611),
+			MemoryGiBHour: getEnvAsFloat("COST_MEMORY_GIB_HOUR", 0.004237),
+			GPUHour:       getEnvAsFloat("COST_GPU_HOUR", 0.95),
+		},
+		CostNodePoolPrices: poolPrices,
+		CostGPUResources:   getEnvAsStringSlice("COST_GPU_RESOURCES", DefaultCostGPUResources),
+
+		RedactionEnabled:             getEnvAsBool("REDACTION_ENABLED", true),
+		RedactionKeyPatterns:         getEnvAsStringSlice("REDACTION_KEY_PATTERNS", DefaultRedactionKeyPatterns),
+		RedactionAnnotationAllowlist: getEnvAsStringSlice("REDACTION_ANNOTATION_ALLOWLIST", DefaultRedactionAnnotationAllowlist),
+		RedactionEntropyThreshold:    getEnvAsFloat("REDACTION_ENTROPY_THRESHOLD", 4.2),
+		UnredactedAccessToken:        getEnv("UNREDACTED_ACCESS_TOKEN", ""),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -64,9 +158,94 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid pod restart threshold: %d (must be >= 0)", c.PodRestartThreshold)
 	}
 
+	if c.PodPendingGracePeriod < 0 {
+		return fmt.Errorf("invalid pod pending grace period: %s (must be >= 0)", c.PodPendingGracePeriod)
+	}
+
+	if c.ClusterPodPendingGracePeriod < 0 {
+		return fmt.Errorf("invalid cluster pod pending grace period: %s (must be >= 0)", c.ClusterPodPendingGracePeriod)
+	}
+
+	if c.ThrottlingScrapeInterval != 0 && c.ThrottlingScrapeInterval < minThrottlingScrapeInterval {
+		return fmt.Errorf("invalid throttling scrape interval: %s (must be 0 or >= %s)", c.ThrottlingScrapeInterval, minThrottlingScrapeInterval)
+	}
+
+	if c.HealthHistoryInterval != 0 && c.HealthHistoryInterval < minHealthHistoryInterval {
+		return fmt.Errorf("invalid health history interval: %s (must be 0 or >= %s)", c.HealthHistoryInterval, minHealthHistoryInterval)
+	}
+
+	if c.HealthHistoryRetention <= 0 {
+		return fmt.Errorf("invalid health history retention: %s (must be > 0)", c.HealthHistoryRetention)
+	}
+
+	if c.HealthHistoryMaxSeries <= 0 {
+		return fmt.Errorf("invalid health history max series: %d (must be > 0)", c.HealthHistoryMaxSeries)
+	}
+
+	if err := c.CostPrices.validate(); err != nil {
+		return fmt.Errorf("invalid cost prices: %w", err)
+	}
+	for pool, prices := range c.CostNodePoolPrices {
+		if err := prices.validate(); err != nil {
+			return fmt.Errorf("invalid cost prices for node pool %s: %w", pool, err)
+		}
+	}
+
+	for _, patterns := range [][]string{c.RedactionKeyPatterns, c.RedactionAnnotationAllowlist} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+			}
+		}
+	}
+
+	if c.RedactionEntropyThreshold <= 0 {
+		return fmt.Errorf("invalid redaction entropy threshold: %v (must be > 0)", c.RedactionEntropyThreshold)
+	}
+
+	return nil
+}
+
+func (p ResourcePrices) validate() error {
+	if p.CPUCoreHour < 0 || p.MemoryGiBHour < 0 || p.GPUHour < 0 {
+		return fmt.Errorf("prices must be >= 0")
+	}
 	return nil
 }
 
+// parseNodePoolPrices parses comma-separated pool=cpu:memory:gpu entries of
+// hourly prices, e.g. "spot=0.0095:0.0013:0,a100=0.031611:0.004237:2.93".
+func parseNodePoolPrices(value string) (map[string]ResourcePrices, error) {
+	prices := map[string]ResourcePrices{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		pool, rest, ok := strings.Cut(entry, "=")
+		parts := strings.Split(rest, ":")
+		if !ok || pool == "" || len(parts) != 3 {
+			return nil, fmt.Errorf("invalid node pool price %q: must be pool=cpu:memory:gpu", entry)
+		}
+
+		values := make([]float64, len(parts))
+		for i, part := range parts {
+			v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid node pool price %q: %w", entry, err)
+			}
+			values[i] = v
+		}
+		prices[strings.TrimSpace(pool)] = ResourcePrices{
+			CPUCoreHour:   values[0],
+			MemoryGiBHour: values[1],
+			GPUHour:       values[2],
+		}
+	}
+	return prices, nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -97,3 +276,26 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsStringSlice(key string, defaultValue []string) []string {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	values := []string{}
+	for _, item := range strings.Split(valueStr, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
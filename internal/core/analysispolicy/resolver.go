@@ -0,0 +1,196 @@
+// Package analysispolicy resolves the analysis settings of a namespace from
+// its annotations, falling back to the global configuration.
+package analysispolicy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// knownIssueTypes are the values accepted in the ignore-issue-types
+// annotation: namespace report issue types and cluster report categories.
+var knownIssueTypes = []string{
+	string(models.PodIssueHighRestarts),
+	string(models.PodIssuePending),
+	string(models.PodIssueFailed),
+	string(models.PodIssueCrashLoop),
+	string(models.PodIssueImagePull),
+	string(models.PodIssueResourceConstraints),
+	string(models.PodIssueUnschedulable),
+	string(models.PodIssueJobFailed),
+	string(models.PodIssueQuotaNearLimit),
+	string(models.PodIssueQuotaExhausted),
+	models.IssueCategoryPending,
+	models.IssueCategoryOOMKilled,
+	models.IssueCategoryEvicted,
+	models.IssueCategoryUnhealthy,
+	models.IssueCategoryInitError,
+	models.IssueCategoryVolumeMount,
+	models.IssueCategoryConfigError,
+	models.IssueCategoryNetworkError,
+	models.IssueCategoryResourceQuota,
+}
+
+// Resolver reads namespace annotations into analysis policies.
+type Resolver struct {
+	client   kubernetes.Interface
+	defaults models.AnalysisPolicy
+	logger   *slog.Logger
+}
+
+func NewResolver(client kubernetes.Interface, cfg *config.Config, logger *slog.Logger) *Resolver {
+	return &Resolver{
+		client: client,
+		defaults: models.AnalysisPolicy{
+			RestartThreshold:         cfg.PodRestartThreshold,
+			IgnoreIssueTypes:         []string{},
+			PendingGracePeriod:       cfg.PodPendingGracePeriod,
+			PendingGracePeriodString: cfg.PodPendingGracePeriod.String(),
+			Overrides:                []string{},
+		},
+		logger: logger,
+	}
+}
+
+// WithPendingGracePeriod returns a resolver whose default pending grace
+// period is grace instead of the configured one. Namespace annotations still
+// override it.
+func (r *Resolver) WithPendingGracePeriod(grace time.Duration) *Resolver {
+	resolver := *r
+	resolver.defaults.PendingGracePeriod = grace
+	resolver.defaults.PendingGracePeriodString = grace.String()
+	return &resolver
+}
+
+// Defaults returns the policy of namespaces without overrides.
+func (r *Resolver) Defaults() models.AnalysisPolicy {
+	return r.defaults
+}
+
+// ForNamespace returns the effective policy of one namespace. The global
+// defaults apply when the namespace cannot be read.
+func (r *Resolver) ForNamespace(ctx context.Context, namespace string) models.AnalysisPolicy {
+	ns, err := r.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return r.defaults
+	}
+	if err != nil {
+		r.logger.Warn("failed to read namespace analysis policy, using defaults",
+			"namespace", namespace,
+			"error", err.Error())
+		return r.defaults
+	}
+	return Parse(ns.Annotations, r.defaults)
+}
+
+// ForAllNamespaces returns the effective policy of every namespace, keyed by
+// name. Namespaces missing from the map use Defaults; on failure the map is
+// empty.
+func (r *Resolver) ForAllNamespaces(ctx context.Context) map[string]models.AnalysisPolicy {
+	policies := make(map[string]models.AnalysisPolicy)
+
+	namespaces, err := r.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		r.logger.Warn("failed to list namespace analysis policies, using defaults",
+			"error", err.Error())
+		return policies
+	}
+
+	for _, ns := range namespaces.Items {
+		policies[ns.Name] = Parse(ns.Annotations, r.defaults)
+	}
+	return policies
+}
+
+// Parse applies the analysis annotations to defaults. An invalid annotation
+// leaves its setting at the default and is reported in the policy's Errors.
+func Parse(annotations map[string]string, defaults models.AnalysisPolicy) models.AnalysisPolicy {
+	policy := defaults
+	policy.Overrides = []string{}
+	policy.Errors = nil
+
+	if value, ok := annotations[models.AnnotationRestartThreshold]; ok {
+		threshold, err := parseRestartThreshold(value)
+		if err != nil {
+			policy.Errors = append(policy.Errors, fmt.Sprintf("%s: %v", models.AnnotationRestartThreshold, err))
+		} else {
+			policy.RestartThreshold = threshold
+			policy.Overrides = append(policy.Overrides, models.AnnotationRestartThreshold)
+		}
+	}
+
+	if value, ok := annotations[models.AnnotationIgnoreIssueTypes]; ok {
+		issueTypes, err := parseIgnoreIssueTypes(value)
+		if err != nil {
+			policy.Errors = append(policy.Errors, fmt.Sprintf("%s: %v", models.AnnotationIgnoreIssueTypes, err))
+		} else {
+			policy.IgnoreIssueTypes = issueTypes
+			policy.Overrides = append(policy.Overrides, models.AnnotationIgnoreIssueTypes)
+		}
+	}
+
+	if value, ok := annotations[models.AnnotationPendingGracePeriod]; ok {
+		grace, err := parsePendingGracePeriod(value)
+		if err != nil {
+			policy.Errors = append(policy.Errors, fmt.Sprintf("%s: %v", models.AnnotationPendingGracePeriod, err))
+		} else {
+			policy.PendingGracePeriod = grace
+			policy.PendingGracePeriodString = grace.String()
+			policy.Overrides = append(policy.Overrides, models.AnnotationPendingGracePeriod)
+		}
+	}
+
+	return policy
+}
+
+func parseRestartThreshold(value string) (int, error) {
+	threshold, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || threshold < 0 || threshold > models.MaxPolicyRestartThreshold {
+		return 0, fmt.Errorf("%q is not an integer between 0 and %d", value, models.MaxPolicyRestartThreshold)
+	}
+	return threshold, nil
+}
+
+// parseIgnoreIssueTypes accepts a comma-separated list of known issue types,
+// compared case-insensitively, and returns their canonical names.
+func parseIgnoreIssueTypes(value string) ([]string, error) {
+	issueTypes := []string{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		canonical := ""
+		for _, known := range knownIssueTypes {
+			if strings.EqualFold(item, known) {
+				canonical = known
+				break
+			}
+		}
+		if canonical == "" {
+			return nil, fmt.Errorf("unknown issue type %q", item)
+		}
+		issueTypes = append(issueTypes, canonical)
+	}
+	return issueTypes, nil
+}
+
+func parsePendingGracePeriod(value string) (time.Duration, error) {
+	grace, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || grace < 0 || grace > models.MaxPolicyPendingGracePeriod {
+		return 0, fmt.Errorf("%q is not a duration between 0s and %s", value, models.MaxPolicyPendingGracePeriod)
+	}
+	return grace, nil
+}
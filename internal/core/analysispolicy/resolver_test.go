@@ -0,0 +1,133 @@
+package analysispolicy
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newNamespace(name string, annotations map[string]string) *v1.Namespace {
+	return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+}
+
+func TestParse(t *testing.T) {
+	defaults := NewResolver(fake.NewSimpleClientset(), &config.Config{
+		PodRestartThreshold:   5,
+		PodPendingGracePeriod: 5 * time.Minute,
+	}, slog.Default()).Defaults()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		threshold   int
+		ignored     []string
+		grace       time.Duration
+		overrides   []string
+		errors      int
+	}{
+		{
+			name:      "no annotations",
+			threshold: 5,
+			ignored:   []string{},
+			grace:     5 * time.Minute,
+			overrides: []string{},
+		},
+		{
+			name: "all overrides",
+			annotations: map[string]string{
+				models.AnnotationRestartThreshold:   "50",
+				models.AnnotationIgnoreIssueTypes:   "crashloopbackoff, Evicted",
+				models.AnnotationPendingGracePeriod: "30m",
+			},
+			threshold: 50,
+			ignored:   []string{"CrashLoopBackOff", "Evicted"},
+			grace:     30 * time.Minute,
+			overrides: []string{
+				models.AnnotationRestartThreshold,
+				models.AnnotationIgnoreIssueTypes,
+				models.AnnotationPendingGracePeriod,
+			},
+		},
+		{
+			name: "invalid values fall back to defaults",
+			annotations: map[string]string{
+				models.AnnotationRestartThreshold:   "-1",
+				models.AnnotationIgnoreIssueTypes:   "CrashLoopBackOff,NotAType",
+				models.AnnotationPendingGracePeriod: "48h",
+			},
+			threshold: 5,
+			ignored:   []string{},
+			grace:     5 * time.Minute,
+			overrides: []string{},
+			errors:    3,
+		},
+		{
+			name:        "valid and invalid mixed",
+			annotations: map[string]string{models.AnnotationRestartThreshold: "1", models.AnnotationPendingGracePeriod: "soon"},
+			threshold:   1,
+			ignored:     []string{},
+			grace:       5 * time.Minute,
+			overrides:   []string{models.AnnotationRestartThreshold},
+			errors:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := Parse(tt.annotations, defaults)
+
+			assert.Equal(t, tt.threshold, policy.RestartThreshold)
+			assert.Equal(t, tt.ignored, policy.IgnoreIssueTypes)
+			assert.Equal(t, tt.grace, policy.PendingGracePeriod)
+			assert.Equal(t, tt.grace.String(), policy.PendingGracePeriodString)
+			assert.Equal(t, tt.overrides, policy.Overrides)
+			assert.Len(t, policy.Errors, tt.errors)
+		})
+	}
+}
+
+func TestResolver(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newNamespace("batch", map[string]string{models.AnnotationRestartThreshold: "50"}),
+		newNamespace("plain", nil),
+	)
+	resolver := NewResolver(client, &config.Config{PodRestartThreshold: 5}, slog.Default())
+	ctx := context.Background()
+
+	assert.Equal(t, 50, resolver.ForNamespace(ctx, "batch").RestartThreshold)
+	assert.Equal(t, 5, resolver.ForNamespace(ctx, "plain").RestartThreshold)
+	assert.Equal(t, resolver.Defaults(), resolver.ForNamespace(ctx, "missing"))
+
+	all := resolver.ForAllNamespaces(ctx)
+	require.Len(t, all, 2)
+	assert.Equal(t, 50, all["batch"].RestartThreshold)
+	assert.False(t, all["batch"].Ignores(string(models.PodIssueCrashLoop)))
+}
+
+func TestResolver_WithPendingGracePeriod(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newNamespace("slow", map[string]string{models.AnnotationPendingGracePeriod: "30m"}),
+		newNamespace("plain", nil),
+	)
+	resolver := NewResolver(client, &config.Config{PodPendingGracePeriod: 5 * time.Minute}, slog.Default())
+	cluster := resolver.WithPendingGracePeriod(30 * time.Second)
+	ctx := context.Background()
+
+	assert.Equal(t, 30*time.Second, cluster.Defaults().PendingGracePeriod)
+	assert.Equal(t, "30s", cluster.Defaults().PendingGracePeriodString)
+	assert.Equal(t, 30*time.Second, cluster.ForNamespace(ctx, "plain").PendingGracePeriod)
+	assert.Equal(t, 30*time.Minute, cluster.ForNamespace(ctx, "slow").PendingGracePeriod)
+
+	// The original resolver keeps its defaults.
+	assert.Equal(t, 5*time.Minute, resolver.ForNamespace(ctx, "plain").PendingGracePeriod)
+}
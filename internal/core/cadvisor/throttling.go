@@ -0,0 +1,187 @@
+// Package cadvisor reads container CPU throttling from the cAdvisor metrics
+// the kubelet exposes in the Prometheus text format.
+package cadvisor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	periodsMetric   = "container_cpu_cfs_periods_total"
+	throttledMetric = "container_cpu_cfs_throttled_periods_total"
+
+	// maxLineSize bounds one exposition line; cAdvisor lines carry many
+	// labels, including image names.
+	maxLineSize = 1 << 20
+)
+
+// ContainerKey identifies a container in cAdvisor labels.
+type ContainerKey struct {
+	Namespace string
+	Pod       string
+	Container string
+}
+
+// Counters are the cumulative CFS counters of a container.
+type Counters struct {
+	Periods   float64
+	Throttled float64
+}
+
+// Source reads the CFS counters of every container on a node. It is an
+// interface so tests can replace the API server node proxy.
+type Source interface {
+	Counters(ctx context.Context, nodeName string) (map[ContainerKey]Counters, error)
+}
+
+// NewProxySource reaches the kubelet through the API server's nodes/proxy
+// subresource, so the agent needs no direct network access to nodes.
+func NewProxySource(client kubernetes.Interface) Source {
+	return &proxySource{client: client}
+}
+
+type proxySource struct {
+	client kubernetes.Interface
+}
+
+func (p *proxySource) Counters(ctx context.Context, nodeName string) (map[ContainerKey]Counters, error) {
+	raw, err := p.client.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("metrics/cadvisor").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cadvisor metrics for node %s: %w", nodeName, err)
+	}
+
+	counters, err := ParseCounters(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cadvisor metrics for node %s: %w", nodeName, err)
+	}
+	return counters, nil
+}
+
+// ParseCounters returns the cumulative CFS periods and throttled periods of
+// each container. Containers without CPU limits have no CFS periods and are
+// left out, as are the pod-level and pause container series.
+func ParseCounters(r io.Reader) (map[ContainerKey]Counters, error) {
+	periods := make(map[ContainerKey]float64)
+	throttled := make(map[ContainerKey]float64)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		var target map[ContainerKey]float64
+		var rest string
+		switch {
+		case strings.HasPrefix(line, periodsMetric+"{"):
+			target, rest = periods, line[len(periodsMetric):]
+		case strings.HasPrefix(line, throttledMetric+"{"):
+			target, rest = throttled, line[len(throttledMetric):]
+		default:
+			continue
+		}
+
+		labels, value, err := parseSample(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample %q: %w", line, err)
+		}
+
+		key := ContainerKey{Namespace: labels["namespace"], Pod: labels["pod"], Container: labels["container"]}
+		if key.Container == "" || key.Container == "POD" || key.Pod == "" {
+			continue
+		}
+		target[key] += value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	counters := make(map[ContainerKey]Counters, len(periods))
+	for key, total := range periods {
+		if total > 0 {
+			counters[key] = Counters{Periods: total, Throttled: throttled[key]}
+		}
+	}
+	return counters, nil
+}
+
+// parseSample parses `{labels} value [timestamp]`.
+func parseSample(s string) (map[string]string, float64, error) {
+	labels, rest, err := parseLabels(s)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return nil, 0, fmt.Errorf("missing value")
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, 0, err
+	}
+	return labels, value, nil
+}
+
+// parseLabels parses a `{name="value",...}` label set and returns what
+// follows it. Values may contain escaped quotes, backslashes and newlines.
+func parseLabels(s string) (map[string]string, string, error) {
+	if !strings.HasPrefix(s, "{") {
+		return nil, "", fmt.Errorf("missing labels")
+	}
+
+	labels := make(map[string]string)
+	i := 1
+	for {
+		for i < len(s) && (s[i] == ',' || s[i] == ' ') {
+			i++
+		}
+		if i < len(s) && s[i] == '}' {
+			return labels, s[i+1:], nil
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		if eq < 0 || i+eq+1 >= len(s) || s[i+eq+1] != '"' {
+			return nil, "", fmt.Errorf("malformed label at offset %d", i)
+		}
+		name := strings.TrimSpace(s[i : i+eq])
+		i += eq + 2
+
+		var value strings.Builder
+		for {
+			if i >= len(s) {
+				return nil, "", fmt.Errorf("unterminated label value")
+			}
+			c := s[i]
+			if c == '"' {
+				i++
+				break
+			}
+			if c == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i])
+				}
+			} else {
+				value.WriteByte(c)
+			}
+			i++
+		}
+		labels[name] = value.String()
+	}
+}
@@ -0,0 +1,48 @@
+package cadvisor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleMetrics = `# HELP container_cpu_cfs_periods_total Number of elapsed enforcement period intervals.
+# TYPE container_cpu_cfs_periods_total counter
+container_cpu_cfs_periods_total{container="",id="/kubepods/burstable/pod1",image="",name="",namespace="shop",pod="web-1"} 1000 1718000000000
+container_cpu_cfs_periods_total{container="app",id="/kubepods/burstable/pod1/a",image="registry/app:1",name="a",namespace="shop",pod="web-1"} 1000 1718000000000
+container_cpu_cfs_periods_total{container="sidecar",id="/kubepods/burstable/pod1/b",image="registry/proxy:2",name="b",namespace="shop",pod="web-1"} 400 1718000000000
+container_cpu_cfs_periods_total{container="POD",id="/kubepods/burstable/pod1/c",image="pause",name="c",namespace="shop",pod="web-1"} 10
+container_cpu_cfs_periods_total{container="idle",id="/x",image="i",name="d",namespace="shop",pod="web-1"} 0
+# HELP container_cpu_cfs_throttled_periods_total Number of throttled period intervals.
+# TYPE container_cpu_cfs_throttled_periods_total counter
+container_cpu_cfs_throttled_periods_total{container="app",id="/kubepods/burstable/pod1/a",image="registry/app:1",name="a",namespace="shop",pod="web-1"} 250 1718000000000
+container_cpu_cfs_throttled_periods_total{container="POD",id="/kubepods/burstable/pod1/c",image="pause",name="c",namespace="shop",pod="web-1"} 10
+container_cpu_usage_seconds_total{container="app",namespace="shop",pod="web-1"} 12.5
+container_cpu_cfs_throttled_periods_total{container="odd",id="/y",image="a \"quoted\", image",name="e",namespace="ops",pod="job-1"} 5
+container_cpu_cfs_periods_total{container="odd",id="/y",image="a \"quoted\", image",name="e",namespace="ops",pod="job-1"} 10
+`
+
+func TestParseCounters(t *testing.T) {
+	counters, err := ParseCounters(strings.NewReader(sampleMetrics))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[ContainerKey]Counters{
+		{Namespace: "shop", Pod: "web-1", Container: "app"}:     {Periods: 1000, Throttled: 250},
+		{Namespace: "shop", Pod: "web-1", Container: "sidecar"}: {Periods: 400},
+		{Namespace: "ops", Pod: "job-1", Container: "odd"}:      {Periods: 10, Throttled: 5},
+	}, counters)
+}
+
+func TestParseCountersInvalid(t *testing.T) {
+	for _, input := range []string{
+		`container_cpu_cfs_periods_total{container="app",pod="p" 10`,
+		`container_cpu_cfs_periods_total{container="app",pod="p"}`,
+		`container_cpu_cfs_periods_total{container=app} 10`,
+		`container_cpu_cfs_periods_total{container="app"} ten`,
+	} {
+		_, err := ParseCounters(strings.NewReader(input))
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,194 @@
+package cadvisor
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// MinWindow is the shortest interval a throttling ratio is computed
+	// over. Shorter intervals hold too few CFS periods, 100ms each by
+	// default, to be meaningful.
+	MinWindow = 10 * time.Second
+
+	// maxConcurrentScrapes bounds the cAdvisor requests in flight during a
+	// poll.
+	maxConcurrentScrapes = 8
+)
+
+// Tracker turns the cumulative CFS counters of a node into the fraction of
+// periods each container was throttled in between two polls of the node, so
+// that the ratio reflects recent behaviour rather than the container's
+// lifetime. Only Poll scrapes nodes and advances their baselines; Throttling
+// reads the ratios of the last poll, so how often scores are requested does
+// not change the window throttling is measured over. It is safe for
+// concurrent use.
+type Tracker struct {
+	source    Source
+	interval  time.Duration
+	maxWindow time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu    sync.Mutex
+	nodes map[string]*nodeState
+}
+
+type nodeState struct {
+	requested time.Time
+	baseline  *scrape
+	ratios    map[ContainerKey]float64
+}
+
+type scrape struct {
+	at       time.Time
+	counters map[ContainerKey]Counters
+}
+
+// NewTracker returns a tracker that Run polls every interval; a zero
+// interval leaves throttling unavailable. Nodes whose throttling was not
+// asked for within maxWindow are no longer polled, and a baseline older than
+// maxWindow is discarded rather than used.
+func NewTracker(source Source, interval, maxWindow time.Duration, logger *slog.Logger) *Tracker {
+	return &Tracker{
+		source:    source,
+		interval:  interval,
+		maxWindow: maxWindow,
+		logger:    logger,
+		now:       time.Now,
+		nodes:     make(map[string]*nodeState),
+	}
+}
+
+// Throttling returns the throttled fraction of each container's CFS periods
+// between the node's last two polls and has the node polled from now on. It
+// returns nil until the node has been polled twice at least MinWindow apart.
+// Containers that are new since the earlier poll, have restarted or ran no
+// CFS periods are left out. The returned map must not be modified.
+func (t *Tracker) Throttling(nodeName string) map[ContainerKey]float64 {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.nodes[nodeName]
+	if !ok {
+		state = &nodeState{}
+		t.nodes[nodeName] = state
+	}
+	state.requested = now
+	return state.ratios
+}
+
+// Run polls every interval until ctx is done.
+func (t *Tracker) Run(ctx context.Context) {
+	if t.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		t.Poll(ctx)
+	}
+}
+
+// Poll scrapes the nodes concurrently and computes their ratios against the
+// previous poll. A node that cannot be scraped has no ratios until its next
+// successful poll.
+func (t *Tracker) Poll(ctx context.Context) {
+	now := t.now()
+
+	t.mu.Lock()
+	nodes := make([]string, 0, len(t.nodes))
+	for node, state := range t.nodes {
+		if now.Sub(state.requested) > t.maxWindow {
+			delete(t.nodes, node)
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	t.mu.Unlock()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentScrapes)
+	failed := 0
+
+	for _, node := range nodes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(node string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			counters, err := t.source.Counters(ctx, node)
+			if err != nil {
+				mu.Lock()
+				failed++
+				mu.Unlock()
+				t.logger.Debug("failed to read cadvisor metrics",
+					slog.String("node", node),
+					slog.String("error", err.Error()))
+			}
+			t.record(node, counters, err == nil)
+		}(node)
+	}
+	wg.Wait()
+
+	if failed > 0 && ctx.Err() == nil {
+		t.logger.Warn("failed to read cadvisor metrics of some nodes, scoring their pods without throttling",
+			slog.Int("failed", failed),
+			slog.Int("nodes", len(nodes)))
+	}
+}
+
+func (t *Tracker) record(nodeName string, counters map[ContainerKey]Counters, ok bool) {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, tracked := t.nodes[nodeName]
+	if !tracked {
+		return
+	}
+	if !ok {
+		state.ratios = nil
+		return
+	}
+
+	baseline := state.baseline
+	if baseline != nil && now.Sub(baseline.at) < MinWindow {
+		return
+	}
+	state.baseline = &scrape{at: now, counters: counters}
+	if baseline == nil || now.Sub(baseline.at) > t.maxWindow {
+		state.ratios = nil
+		return
+	}
+
+	ratios := make(map[ContainerKey]float64, len(counters))
+	for key, current := range counters {
+		previous, ok := baseline.counters[key]
+		if !ok {
+			continue
+		}
+		periods := current.Periods - previous.Periods
+		throttled := current.Throttled - previous.Throttled
+		// Counters going backwards belong to a restarted container.
+		if periods <= 0 || throttled < 0 {
+			continue
+		}
+		ratios[key] = throttled / periods
+	}
+	state.ratios = ratios
+}
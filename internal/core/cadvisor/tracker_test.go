@@ -0,0 +1,83 @@
+package cadvisor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// queuedSource returns queued scrapes, one per call.
+type queuedSource struct {
+	scrapes []map[ContainerKey]Counters
+}
+
+func (q *queuedSource) Counters(ctx context.Context, nodeName string) (map[ContainerKey]Counters, error) {
+	if len(q.scrapes) == 0 {
+		return nil, errors.New("kubelet unreachable")
+	}
+	counters := q.scrapes[0]
+	q.scrapes = q.scrapes[1:]
+	return counters, nil
+}
+
+func TestTracker(t *testing.T) {
+	app := ContainerKey{Namespace: "shop", Pod: "web-1", Container: "app"}
+	sidecar := ContainerKey{Namespace: "shop", Pod: "web-1", Container: "sidecar"}
+	worker := ContainerKey{Namespace: "shop", Pod: "worker-1", Container: "worker"}
+
+	source := &queuedSource{scrapes: []map[ContainerKey]Counters{
+		// Throttled heavily long ago: a lifetime ratio would be 0.5.
+		{app: {Periods: 10000, Throttled: 5000}, sidecar: {Periods: 400}},
+		// Too soon after the baseline.
+		{app: {Periods: 10010, Throttled: 5000}, sidecar: {Periods: 410}},
+		{app: {Periods: 13000, Throttled: 5300}, sidecar: {Periods: 400}, worker: {Periods: 100, Throttled: 90}},
+		// The app container restarted and its counters started over.
+		{app: {Periods: 500, Throttled: 10}, sidecar: {Periods: 1400, Throttled: 700}, worker: {Periods: 200, Throttled: 90}},
+		// Only scraped again after the window.
+		{app: {Periods: 9000, Throttled: 900}},
+	}}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewTracker(source, time.Minute, 15*time.Minute, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	tracker.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	tracker.Poll(ctx)
+	assert.Len(t, source.scrapes, 5, "nodes are only polled once asked for")
+
+	assert.Nil(t, tracker.Throttling("node-a"))
+	tracker.Poll(ctx)
+	assert.Nil(t, tracker.Throttling("node-a"), "the first poll only records a baseline")
+
+	now = now.Add(2 * time.Second)
+	tracker.Poll(ctx)
+	assert.Nil(t, tracker.Throttling("node-a"))
+
+	now = now.Add(5 * time.Minute)
+	tracker.Poll(ctx)
+	// The sidecar ran no periods since the baseline and the worker is new.
+	assert.Equal(t, map[ContainerKey]float64{app: 0.1}, tracker.Throttling("node-a"))
+	assert.Equal(t, map[ContainerKey]float64{app: 0.1}, tracker.Throttling("node-a"))
+	assert.Len(t, source.scrapes, 2, "reading throttling does not scrape")
+
+	now = now.Add(5 * time.Minute)
+	tracker.Poll(ctx)
+	assert.Equal(t, map[ContainerKey]float64{sidecar: 0.7, worker: 0}, tracker.Throttling("node-a"))
+
+	now = now.Add(time.Hour)
+	tracker.Poll(ctx)
+	assert.Len(t, source.scrapes, 1, "a node not asked for within the window is no longer polled")
+	assert.Nil(t, tracker.Throttling("node-a"), "its ratios are forgotten")
+
+	tracker.Poll(ctx)
+	assert.Nil(t, tracker.Throttling("node-a"), "polling starts over from a new baseline")
+
+	now = now.Add(time.Minute)
+	tracker.Poll(ctx)
+	assert.Nil(t, tracker.Throttling("node-a"), "a failed scrape leaves the node without ratios")
+}
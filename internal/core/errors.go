@@ -8,4 +8,8 @@ var (
 	ErrNodeNotFound = errors.New("node not found")
 
 	ErrMetricsNotAvailable = errors.New("metrics server not available")
+
+	ErrHealthHistoryDisabled = errors.New("health score history is disabled")
+
+	ErrHealthHistoryNotFound = errors.New("no health score history")
 )
@@ -2,19 +2,42 @@ package factory
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/cadvisor"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/healthhistory"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/services"
 	"github.com/sumandas0/k8s-cluster-agent/internal/kubernetes"
 )
 
-func NewServices(clients *kubernetes.Clients, cfg *config.Config, logger *slog.Logger) *core.Services {
+// minThrottlingWindow is the longest interval CPU throttling is measured over
+// when the health history scores less often than that.
+const minThrottlingWindow = 15 * time.Minute
+
+func NewServices(clients *kubernetes.Clients, cfg *config.Config, scoring *scoringpolicy.Set, history *healthhistory.Store, logger *slog.Logger) *core.Services {
+	podService := services.NewPodService(clients.Kubernetes, logger)
+	throttling := cadvisor.NewTracker(cadvisor.NewProxySource(clients.Kubernetes), cfg.ThrottlingScrapeInterval, throttlingWindow(cfg), logger)
+	healthScore := kubernetes.NewHealthScoreService(clients.Kubernetes, clients.Metrics, cfg, scoring, throttling, logger)
+
 	return &core.Services{
-		Pod:           services.NewPodService(clients.Kubernetes, logger),
+		Pod:           podService,
 		Node:          services.NewNodeService(clients.Kubernetes, clients.Metrics, logger),
 		Namespace:     services.NewNamespaceService(clients.Kubernetes, cfg, logger),
-		HealthScore:   kubernetes.NewHealthScoreService(clients.Kubernetes, logger),
-		ClusterIssues: kubernetes.NewClusterIssuesService(clients.Kubernetes, logger),
+		HealthScore:   healthScore,
+		ClusterIssues: kubernetes.NewClusterIssuesService(clients.Kubernetes, cfg, logger),
+		Security:      services.NewSecurityService(clients.Kubernetes, podService, logger),
+		Allocation:    services.NewAllocationService(clients.Kubernetes, clients.Metrics, cfg, logger),
+		HealthHistory: services.NewHealthHistoryService(healthScore, history, cfg, logger),
+		Throttling:    throttling,
 	}
 }
+
+// throttlingWindow is how long a node keeps being polled for throttling after
+// it was last scored. It leaves room for two health history rounds, so that
+// the nodes the history scores are polled without a pause.
+func throttlingWindow(cfg *config.Config) time.Duration {
+	return max(minThrottlingWindow, 2*cfg.HealthHistoryInterval)
+}
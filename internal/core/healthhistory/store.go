@@ -0,0 +1,297 @@
+// Package healthhistory keeps a bounded, file-backed history of pod and
+// workload health scores and derives trends from it.
+package healthhistory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// fileVersion is the layout version of the history file.
+const fileVersion = 1
+
+// Sample is one score to record. Kind is empty for pods.
+type Sample struct {
+	Namespace string
+	Kind      string
+	Name      string
+	Score     int
+}
+
+// Point is one recorded score, stored as [unix seconds, score] to keep the
+// file small.
+type Point struct {
+	Time  time.Time
+	Score int
+}
+
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]int64{p.Time.Unix(), int64(p.Score)})
+}
+
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var raw [2]int64
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	p.Time = time.Unix(raw[0], 0).UTC()
+	p.Score = int(raw[1])
+	return nil
+}
+
+// Series is the history of one pod or workload, oldest point first.
+type Series struct {
+	Namespace string  `json:"namespace"`
+	Kind      string  `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Points    []Point `json:"points"`
+}
+
+func (s *Series) key() string {
+	return s.Namespace + "/" + s.Kind + "/" + s.Name
+}
+
+func (s *Series) latest() Point {
+	return s.Points[len(s.Points)-1]
+}
+
+func (s *Series) clone() Series {
+	c := *s
+	c.Points = append([]Point(nil), s.Points...)
+	return c
+}
+
+type file struct {
+	Version   int      `json:"version"`
+	Pods      []Series `json:"pods"`
+	Workloads []Series `json:"workloads"`
+}
+
+// Store holds the history in memory and persists it to a file when a path
+// is set. Points older than the retention are dropped, and beyond maxSeries
+// the series updated least recently, then the healthiest, are dropped.
+type Store struct {
+	mu        sync.RWMutex
+	path      string
+	retention time.Duration
+	maxSeries int
+	pods      map[string]*Series
+	workloads map[string]*Series
+}
+
+// New returns an empty store.
+func New(path string, retention time.Duration, maxSeries int) *Store {
+	return &Store{
+		path:      path,
+		retention: retention,
+		maxSeries: maxSeries,
+		pods:      make(map[string]*Series),
+		workloads: make(map[string]*Series),
+	}
+}
+
+// Open returns a store loaded from path. A missing file is an empty store.
+func Open(path string, retention time.Duration, maxSeries int) (*Store, error) {
+	store := New(path, retention, maxSeries)
+	if path == "" {
+		return store, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read health history: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to decode health history %s: %w", path, err)
+	}
+	if f.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported health history version %d in %s", f.Version, path)
+	}
+
+	for i := range f.Pods {
+		if len(f.Pods[i].Points) > 0 {
+			store.pods[f.Pods[i].key()] = &f.Pods[i]
+		}
+	}
+	for i := range f.Workloads {
+		if len(f.Workloads[i].Points) > 0 {
+			store.workloads[f.Workloads[i].key()] = &f.Workloads[i]
+		}
+	}
+	store.prune(time.Now())
+
+	return store, nil
+}
+
+// SetAside renames a history file that Open rejected to
+// <path>.corrupt-<unix time>, so that a new store at path does not overwrite
+// it, and returns the new name.
+func SetAside(path string, now time.Time) (string, error) {
+	aside := fmt.Sprintf("%s.corrupt-%d", path, now.Unix())
+	if err := os.Rename(path, aside); err != nil {
+		return "", fmt.Errorf("failed to set aside health history: %w", err)
+	}
+	return aside, nil
+}
+
+// Record appends one round of scores taken at the given time.
+func (s *Store) Record(at time.Time, pods, workloads []Sample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	add := func(series map[string]*Series, sample Sample) {
+		entry := &Series{Namespace: sample.Namespace, Kind: sample.Kind, Name: sample.Name}
+		if existing, ok := series[entry.key()]; ok {
+			entry = existing
+		} else {
+			series[entry.key()] = entry
+		}
+		entry.Points = append(entry.Points, Point{Time: at.UTC().Truncate(time.Second), Score: sample.Score})
+	}
+
+	for _, sample := range pods {
+		sample.Kind = ""
+		add(s.pods, sample)
+	}
+	for _, sample := range workloads {
+		add(s.workloads, sample)
+	}
+
+	s.prune(at)
+}
+
+// prune drops points past the retention and evicts series over the limit.
+// The caller holds the lock.
+func (s *Store) prune(now time.Time) {
+	cutoff := now.Add(-s.retention)
+	for _, series := range []map[string]*Series{s.pods, s.workloads} {
+		for key, entry := range series {
+			first := sort.Search(len(entry.Points), func(i int) bool {
+				return !entry.Points[i].Time.Before(cutoff)
+			})
+			if first == len(entry.Points) {
+				delete(series, key)
+				continue
+			}
+			entry.Points = entry.Points[first:]
+		}
+	}
+
+	excess := len(s.pods) + len(s.workloads) - s.maxSeries
+	if s.maxSeries <= 0 || excess <= 0 {
+		return
+	}
+
+	type candidate struct {
+		series map[string]*Series
+		key    string
+		latest Point
+	}
+	candidates := make([]candidate, 0, len(s.pods)+len(s.workloads))
+	for _, series := range []map[string]*Series{s.pods, s.workloads} {
+		for key, entry := range series {
+			candidates = append(candidates, candidate{series: series, key: key, latest: entry.latest()})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if !a.latest.Time.Equal(b.latest.Time) {
+			return a.latest.Time.Before(b.latest.Time)
+		}
+		if a.latest.Score != b.latest.Score {
+			return a.latest.Score > b.latest.Score
+		}
+		return a.key < b.key
+	})
+	for _, c := range candidates[:excess] {
+		delete(c.series, c.key)
+	}
+}
+
+// Save writes the store to its file. The file is replaced atomically so a
+// crash mid-write keeps the previous history.
+func (s *Store) Save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	s.mu.RLock()
+	f := file{Version: fileVersion, Pods: sortedSeries(s.pods), Workloads: sortedSeries(s.workloads)}
+	data, err := json.Marshal(f)
+	s.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode health history: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write health history: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write health history: %w", err)
+	}
+	// Without a sync a crash after the rename can leave an empty file in
+	// place of the history.
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write health history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write health history: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write health history: %w", err)
+	}
+	return nil
+}
+
+// Pod returns a copy of a pod's history.
+func (s *Store) Pod(namespace, name string) (Series, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.pods[namespace+"//"+name]
+	if !ok {
+		return Series{}, false
+	}
+	return entry.clone(), true
+}
+
+// Workloads returns copies of the workload histories of a namespace, or of
+// all namespaces when namespace is empty.
+func (s *Store) Workloads(namespace string) []Series {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := []Series{}
+	for _, entry := range s.workloads {
+		if namespace == "" || entry.Namespace == namespace {
+			result = append(result, entry.clone())
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].key() < result[j].key() })
+	return result
+}
+
+func sortedSeries(series map[string]*Series) []Series {
+	result := make([]Series, 0, len(series))
+	for _, entry := range series {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].key() < result[j].key() })
+	return result
+}
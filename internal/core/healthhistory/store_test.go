@@ -0,0 +1,196 @@
+package healthhistory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+var start = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func pointsAt(interval time.Duration, scores ...int) []Point {
+	points := make([]Point, len(scores))
+	for i, score := range scores {
+		points[i] = Point{Time: start.Add(time.Duration(i) * interval), Score: score}
+	}
+	return points
+}
+
+func TestStoreRecordAndRetention(t *testing.T) {
+	store := New("", 2*time.Hour, 100)
+
+	store.Record(start, []Sample{{Namespace: "default", Name: "web-1", Score: 90}}, nil)
+	store.Record(start.Add(time.Hour), []Sample{{Namespace: "default", Name: "web-1", Score: 80}}, []Sample{{Namespace: "default", Kind: "Deployment", Name: "web", Score: 85}})
+
+	series, ok := store.Pod("default", "web-1")
+	require.True(t, ok)
+	assert.Equal(t, []Point{{Time: start, Score: 90}, {Time: start.Add(time.Hour), Score: 80}}, series.Points)
+
+	// Points past the retention are dropped, and so are series left empty.
+	store.Record(start.Add(150*time.Minute), nil, []Sample{{Namespace: "default", Kind: "Deployment", Name: "web", Score: 70}})
+
+	series, ok = store.Pod("default", "web-1")
+	require.True(t, ok)
+	assert.Equal(t, []Point{{Time: start.Add(time.Hour), Score: 80}}, series.Points)
+
+	store.Record(start.Add(5*time.Hour), nil, nil)
+	_, ok = store.Pod("default", "web-1")
+	assert.False(t, ok)
+	assert.Empty(t, store.Workloads(""))
+}
+
+func TestStoreEvictsOverMaxSeries(t *testing.T) {
+	store := New("", 24*time.Hour, 2)
+
+	store.Record(start, []Sample{{Namespace: "a", Name: "stale", Score: 10}}, nil)
+	store.Record(start.Add(time.Minute), []Sample{
+		{Namespace: "a", Name: "healthy", Score: 100},
+		{Namespace: "a", Name: "failing", Score: 20},
+	}, nil)
+
+	// The least recently updated series goes first.
+	_, ok := store.Pod("a", "stale")
+	assert.False(t, ok)
+
+	store.Record(start.Add(2*time.Minute), nil, []Sample{{Namespace: "a", Kind: "Deployment", Name: "web", Score: 50}})
+
+	// Among series updated together, the healthiest goes first.
+	_, ok = store.Pod("a", "healthy")
+	assert.False(t, ok)
+	_, ok = store.Pod("a", "failing")
+	assert.True(t, ok)
+	assert.Len(t, store.Workloads("a"), 1)
+}
+
+func TestStoreSaveAndOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.json")
+	now := time.Now().UTC().Truncate(time.Second)
+
+	store, err := Open(path, time.Hour, 100)
+	require.NoError(t, err)
+	store.Record(now, []Sample{{Namespace: "default", Name: "web-1", Score: 75}}, []Sample{
+		{Namespace: "default", Kind: "Deployment", Name: "web", Score: 80},
+		{Namespace: "jobs", Kind: "CronJob", Name: "report", Score: 60},
+	})
+	require.NoError(t, store.Save())
+
+	reopened, err := Open(path, time.Hour, 100)
+	require.NoError(t, err)
+
+	series, ok := reopened.Pod("default", "web-1")
+	require.True(t, ok)
+	assert.Equal(t, []Point{{Time: now, Score: 75}}, series.Points)
+	assert.Len(t, reopened.Workloads(""), 2)
+
+	workloads := reopened.Workloads("jobs")
+	require.Len(t, workloads, 1)
+	assert.Equal(t, "CronJob", workloads[0].Kind)
+	assert.Equal(t, "report", workloads[0].Name)
+
+	// Returned series are copies.
+	series.Points[0].Score = 0
+	series, _ = reopened.Pod("default", "web-1")
+	assert.Equal(t, 75, series.Points[0].Score)
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := Open(filepath.Join(dir, "missing.json"), time.Hour, 100)
+	require.NoError(t, err)
+	assert.Empty(t, store.Workloads(""))
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corrupt, []byte("{"), 0o600))
+	_, err = Open(corrupt, time.Hour, 100)
+	assert.Error(t, err)
+
+	future := filepath.Join(dir, "future.json")
+	require.NoError(t, os.WriteFile(future, []byte(`{"version":99}`), 0o600))
+	_, err = Open(future, time.Hour, 100)
+	assert.ErrorContains(t, err, "unsupported health history version")
+
+	aside, err := SetAside(corrupt, start)
+	require.NoError(t, err)
+	assert.Equal(t, corrupt+".corrupt-1717243200", aside)
+	data, err := os.ReadFile(aside)
+	require.NoError(t, err)
+	assert.Equal(t, "{", string(data), "the rejected file is kept as it was")
+	_, err = os.Stat(corrupt)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name           string
+		points         []Point
+		direction      string
+		change         int
+		degradingSince *time.Time
+	}{
+		{
+			name:      "single point",
+			points:    pointsAt(time.Hour, 80),
+			direction: models.HealthTrendUnknown,
+		},
+		{
+			name:      "flat",
+			points:    pointsAt(time.Hour, 90, 91, 90, 89, 90),
+			direction: models.HealthTrendStable,
+		},
+		{
+			name:      "recovering",
+			points:    pointsAt(time.Hour, 40, 50, 65, 80),
+			direction: models.HealthTrendImproving,
+			change:    40,
+		},
+		{
+			name:           "collapsing after a plateau",
+			points:         pointsAt(time.Hour, 95, 96, 96, 90, 80, 72),
+			direction:      models.HealthTrendDegrading,
+			change:         -23,
+			degradingSince: ptrTime(start.Add(2 * time.Hour)),
+		},
+		{
+			name:           "decline with small recoveries",
+			points:         pointsAt(time.Hour, 60, 90, 85, 86, 80, 81, 72),
+			direction:      models.HealthTrendDegrading,
+			change:         12,
+			degradingSince: ptrTime(start.Add(time.Hour)),
+		},
+		{
+			name:      "slow drift stays unflagged",
+			points:    pointsAt(24*time.Hour, 90, 89, 88),
+			direction: models.HealthTrendStable,
+			change:    -2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trend := Analyze(tt.points)
+			assert.Equal(t, tt.direction, trend.Direction)
+			assert.Equal(t, tt.change, trend.Change)
+			assert.Equal(t, len(tt.points), trend.Samples)
+			assert.Equal(t, tt.degradingSince, trend.DegradingSince)
+		})
+	}
+}
+
+func TestSince(t *testing.T) {
+	points := pointsAt(time.Hour, 1, 2, 3)
+
+	assert.Equal(t, points, Since(points, time.Time{}))
+	assert.Equal(t, points[1:], Since(points, start.Add(time.Hour)))
+	assert.Empty(t, Since(points, start.Add(3*time.Hour)))
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
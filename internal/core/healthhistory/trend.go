@@ -0,0 +1,113 @@
+package healthhistory
+
+import (
+	"math"
+	"time"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const (
+	// trendSlopeThreshold is the slope, in points per hour, beyond which a
+	// series counts as improving or degrading.
+	trendSlopeThreshold = 1.0
+
+	// degradingTolerance is how much a score may recover between two points
+	// without ending a decline; scores such as uptime move a little on
+	// their own.
+	degradingTolerance = 2
+
+	// minDegradingDrop is how far a score must fall before the decline is
+	// reported with a start time.
+	minDegradingDrop = 5
+)
+
+// Since returns the points recorded at or after the cutoff; a zero cutoff
+// keeps them all.
+func Since(points []Point, cutoff time.Time) []Point {
+	for i, point := range points {
+		if !point.Time.Before(cutoff) {
+			return points[i:]
+		}
+	}
+	return nil
+}
+
+// Analyze derives the trend of points ordered oldest first.
+func Analyze(points []Point) models.HealthTrend {
+	trend := models.HealthTrend{Direction: models.HealthTrendUnknown, Samples: len(points)}
+	if len(points) < 2 {
+		return trend
+	}
+
+	trend.Change = points[len(points)-1].Score - points[0].Score
+	trend.SlopePerHour = math.Round(slopePerHour(points)*100) / 100
+
+	switch {
+	case trend.SlopePerHour <= -trendSlopeThreshold:
+		trend.Direction = models.HealthTrendDegrading
+	case trend.SlopePerHour >= trendSlopeThreshold:
+		trend.Direction = models.HealthTrendImproving
+	default:
+		trend.Direction = models.HealthTrendStable
+	}
+
+	if since, ok := degradingSince(points); ok {
+		trend.DegradingSince = &since
+		trend.Direction = models.HealthTrendDegrading
+	}
+
+	return trend
+}
+
+// slopePerHour fits a least squares line through the points.
+func slopePerHour(points []Point) float64 {
+	origin := points[0].Time
+	n := float64(len(points))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range points {
+		x := point.Time.Sub(origin).Hours()
+		y := float64(point.Score)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// degradingSince walks back from the latest point while scores keep falling,
+// within the tolerance, and returns when the highest score of that run was
+// last recorded if the score has since dropped by at least minDegradingDrop.
+func degradingSince(points []Point) (time.Time, bool) {
+	start := len(points) - 1
+	for start > 0 && points[start-1].Score+degradingTolerance >= points[start].Score {
+		start--
+	}
+
+	peak := start
+	for i := start; i < len(points); i++ {
+		if points[i].Score >= points[peak].Score {
+			peak = i
+		}
+	}
+
+	if points[peak].Score-points[len(points)-1].Score < minDegradingDrop {
+		return time.Time{}, false
+	}
+	return points[peak].Time, true
+}
+
+// Points converts recorded points for responses.
+func Points(points []Point) []models.HealthScorePoint {
+	result := make([]models.HealthScorePoint, len(points))
+	for i, point := range points {
+		result[i] = models.HealthScorePoint{Timestamp: point.Time, Score: point.Score}
+	}
+	return result
+}
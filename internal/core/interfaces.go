@@ -2,9 +2,11 @@ package core
 
 import (
 	"context"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/cadvisor"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
 )
 
@@ -20,22 +22,79 @@ type PodService interface {
 	GetPodFailureEvents(ctx context.Context, namespace, name string) (*models.PodFailureEvents, error)
 
 	GetPodSchedulingExplanation(ctx context.Context, namespace, name string) (*models.SchedulingExplanation, error)
+
+	GetPodTimeline(ctx context.Context, namespace, name string) (*models.PodTimeline, error)
+
+	ComparePods(ctx context.Context, a, b models.PodReference) (*models.PodComparison, error)
+
+	GetPodStartupLatency(ctx context.Context, namespace, name string) (*models.PodStartupLatency, error)
 }
 
 type NodeService interface {
+	ListNodes(ctx context.Context, opts models.NodeInventoryOptions) (*models.NodeInventory, error)
+
 	GetNodeUtilization(ctx context.Context, nodeName string) (*models.NodeUtilization, error)
+
+	ListNodeUtilization(ctx context.Context, opts models.NodeUtilizationListOptions) (*models.NodeUtilizationList, error)
+
+	GetNodePods(ctx context.Context, nodeName string, sortBy string) (*models.NodePodBreakdown, error)
+
+	GetNodeHealth(ctx context.Context, nodeName string) (*models.NodeHealth, error)
+
+	GetEvictionRanking(ctx context.Context, nodeName string, signal string) (*models.EvictionRanking, error)
+
+	SimulateNodeDrain(ctx context.Context, nodeName string) (*models.DrainSimulation, error)
+
+	GetCapacity(ctx context.Context, query models.CapacityQuery) (*models.CapacityReport, error)
 }
 
 type NamespaceService interface {
-	GetNamespaceErrors(ctx context.Context, namespace string) (*models.NamespaceErrorReport, error)
+	GetNamespaceErrors(ctx context.Context, namespace string, opts models.NamespaceErrorOptions) (*models.NamespaceErrorReport, error)
+
+	GetNamespaceStartupStats(ctx context.Context, namespace string) (*models.NamespaceStartupStats, error)
+
+	GetNamespaceQuotas(ctx context.Context, namespace string) (*models.NamespaceQuotaReport, error)
+
+	GetNamespaceEvents(ctx context.Context, namespace string) (*models.NamespaceEventDigest, error)
 }
 
 type HealthScoreService interface {
 	CalculateHealthScore(ctx context.Context, namespace, podName string) (*models.PodHealthScore, error)
+
+	CalculateNamespaceHealthScore(ctx context.Context, namespace string) (*models.AggregateHealthScore, error)
+
+	CalculateClusterHealthScore(ctx context.Context) (*models.AggregateHealthScore, error)
+
+	// SnapshotHealthScores scores every running pod and workload in the
+	// cluster without truncating the results.
+	SnapshotHealthScores(ctx context.Context) (*models.HealthScoreSnapshot, error)
+}
+
+// HealthHistoryService records health scores periodically and reports how
+// they change over time.
+type HealthHistoryService interface {
+	// Run records health scores every interval until ctx is done.
+	Run(ctx context.Context)
+
+	GetPodHealthHistory(ctx context.Context, namespace, podName string, since time.Duration) (*models.PodHealthHistory, error)
+
+	// GetWorkloadHealthTrends lists workload trends of a namespace, or of
+	// all namespaces when namespace is empty.
+	GetWorkloadHealthTrends(ctx context.Context, namespace string, since time.Duration, includePoints bool) (*models.WorkloadHealthTrends, error)
 }
 
 type ClusterIssuesService interface {
-	GetClusterIssues(ctx context.Context, namespace string, severityFilter string) (*models.ClusterIssues, error)
+	GetClusterIssues(ctx context.Context, namespace string, severityFilter string, opts models.IssueListOptions) (*models.ClusterIssues, error)
+}
+
+type SecurityService interface {
+	GetPodSecurityAudit(ctx context.Context, namespace, name string) (*models.PodSecurityAudit, error)
+
+	GetNamespaceSecurityAudit(ctx context.Context, namespace string) (*models.NamespaceSecurityAudit, error)
+}
+
+type AllocationService interface {
+	GetAllocation(ctx context.Context, query models.AllocationQuery) (*models.AllocationReport, error)
 }
 
 type Services struct {
@@ -44,4 +103,11 @@ type Services struct {
 	Namespace     NamespaceService
 	HealthScore   HealthScoreService
 	ClusterIssues ClusterIssuesService
+	Security      SecurityService
+	Allocation    AllocationService
+	HealthHistory HealthHistoryService
+
+	// Throttling polls the CPU throttling health scores read; it must be
+	// run for them to include it.
+	Throttling *cadvisor.Tracker
 }
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Allocation grouping modes. A label grouping is AllocationGroupByLabelPrefix
+// followed by the label key, e.g. "label:team".
+const (
+	AllocationGroupByNamespace   = "namespace"
+	AllocationGroupByLabelPrefix = "label:"
+)
+
+// AllocationUnlabeled groups the pods that lack the grouping label.
+const AllocationUnlabeled = "__unlabeled__"
+
+// HoursPerMonth converts hourly to monthly cost: 365 days * 24 hours / 12.
+const HoursPerMonth = 730
+
+// AllocationQuery selects the pods to report on, all namespaces when
+// Namespace is empty, and how to group them.
+type AllocationQuery struct {
+	Namespace string
+	GroupBy   string
+}
+
+// ResourcePricing holds hourly prices per vCPU, GiB of memory and GPU.
+type ResourcePricing struct {
+	CPUCoreHour   float64 `json:"cpuCoreHour"`
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+	GPUHour       float64 `json:"gpuHour"`
+}
+
+// AllocationPricing is the price list a report was computed with. Pods on
+// nodes of a pool listed in NodePools use that pool's prices.
+type AllocationPricing struct {
+	Default   ResourcePricing            `json:"default"`
+	NodePools map[string]ResourcePricing `json:"nodePools"`
+}
+
+type AllocationResources struct {
+	CPUCores  float64 `json:"cpuCores"`
+	MemoryGiB float64 `json:"memoryGiB"`
+	GPUs      float64 `json:"gpus"`
+}
+
+// AllocationCost charges each pod for the larger of its requests and usage,
+// since requests are reserved whether used or not and usage above requests
+// still consumes node capacity. Idle cost is the share paid for requested
+// but unused CPU and memory and is only known when usage is.
+type AllocationCost struct {
+	Hourly      float64 `json:"hourly"`
+	Monthly     float64 `json:"monthly"`
+	IdleHourly  float64 `json:"idleHourly"`
+	IdleMonthly float64 `json:"idleMonthly"`
+}
+
+type AllocationGroup struct {
+	Name     string               `json:"name"`
+	Pods     int                  `json:"pods"`
+	Requests AllocationResources  `json:"requests"`
+	Limits   AllocationResources  `json:"limits"`
+	Usage    *AllocationResources `json:"usage,omitempty"`
+	Idle     *AllocationResources `json:"idle,omitempty"`
+	Cost     AllocationCost       `json:"cost"`
+}
+
+// AllocationReport sums requests, limits, usage and estimated cost of the
+// running pods per group, most expensive first. Usage and idle resources are
+// omitted when metrics-server is unavailable.
+type AllocationReport struct {
+	Namespace      string            `json:"namespace,omitempty"`
+	GroupBy        string            `json:"groupBy"`
+	UsageAvailable bool              `json:"usageAvailable"`
+	Pricing        AllocationPricing `json:"pricing"`
+	Groups         []AllocationGroup `json:"groups"`
+	Total          AllocationGroup   `json:"total"`
+	CalculatedAt   time.Time         `json:"calculatedAt"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Namespace annotations that override the global analysis settings for the
+// pods in that namespace.
+const (
+	AnnotationRestartThreshold   = "cluster-agent.io/restart-threshold"
+	AnnotationIgnoreIssueTypes   = "cluster-agent.io/ignore-issue-types"
+	AnnotationPendingGracePeriod = "cluster-agent.io/pending-grace-period"
+)
+
+// Bounds for annotation values; values outside them are rejected.
+const (
+	MaxPolicyRestartThreshold   = 10000
+	MaxPolicyPendingGracePeriod = 24 * time.Hour
+)
+
+// AnalysisPolicy is the effective analysis configuration for a namespace:
+// the global configuration with any valid annotation overrides applied.
+// Overrides lists the annotations that took effect and Errors explains the
+// ones that were rejected, so a typo shows up in the report instead of
+// silently falling back.
+type AnalysisPolicy struct {
+	RestartThreshold         int           `json:"restartThreshold"`
+	IgnoreIssueTypes         []string      `json:"ignoreIssueTypes"`
+	PendingGracePeriod       time.Duration `json:"-"`
+	PendingGracePeriodString string        `json:"pendingGracePeriod"`
+	Overrides                []string      `json:"overrides"`
+	Errors                   []string      `json:"errors,omitempty"`
+}
+
+// Ignores reports whether issues of issueType, a namespace issue type or a
+// cluster issue category, are excluded by the policy.
+func (p AnalysisPolicy) Ignores(issueType string) bool {
+	for _, ignored := range p.IgnoreIssueTypes {
+		if strings.EqualFold(ignored, issueType) {
+			return true
+		}
+	}
+	return false
+}
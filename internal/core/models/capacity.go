@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// CapacityQuery describes the pod shape to plan for.
+type CapacityQuery struct {
+	Requests     v1.ResourceList
+	NodeSelector map[string]string
+	Tolerations  []v1.Toleration
+}
+
+// CapacityReport states how many additional pods of a shape fit on each node
+// and in total, given current requests, taints and node selectors.
+type CapacityReport struct {
+	Shape           map[string]string                `json:"shape"`
+	TotalFit        int                              `json:"totalFit"`
+	TotalNodes      int                              `json:"totalNodes"`
+	EligibleNodes   int                              `json:"eligibleNodes"`
+	Fragmentation   map[string]ResourceFragmentation `json:"fragmentation"`
+	LargestByCPU    *PodFit                          `json:"largestByCpu,omitempty"`
+	LargestByMemory *PodFit                          `json:"largestByMemory,omitempty"`
+	Nodes           []NodeCapacity                   `json:"nodes"`
+	CalculatedAt    time.Time                        `json:"calculatedAt"`
+}
+
+type NodeCapacity struct {
+	NodeName         string            `json:"nodeName"`
+	Eligible         bool              `json:"eligible"`
+	Reason           string            `json:"reason,omitempty"`
+	Fits             int               `json:"fits"`
+	LimitingResource string            `json:"limitingResource,omitempty"`
+	Free             map[string]string `json:"free,omitempty"`
+	Stranded         map[string]string `json:"stranded,omitempty"`
+}
+
+// ResourceFragmentation compares the free capacity of a resource on eligible
+// nodes with the part of it the shape can actually use. Stranded capacity
+// is free but left over on nodes where another resource ran out first.
+type ResourceFragmentation struct {
+	Free               string  `json:"free"`
+	Usable             string  `json:"usable"`
+	Stranded           string  `json:"stranded"`
+	StrandedPercentage float64 `json:"strandedPercentage"`
+}
+
+// PodFit is the largest pod that fits on a single eligible node.
+type PodFit struct {
+	NodeName string `json:"nodeName"`
+	CPU      string `json:"cpu"`
+	Memory   string `json:"memory"`
+}
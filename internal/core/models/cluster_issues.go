@@ -12,7 +12,15 @@ type ClusterIssues struct {
 	IssueVelocity     IssueVelocity              `json:"issueVelocity"`
 	Patterns          []IssuePattern             `json:"patterns"`
 	CriticalIssues    []ClusterPodIssue          `json:"criticalIssues"`
-	CalculatedAt      time.Time                  `json:"calculatedAt"`
+	// TotalCriticalIssues counts every critical issue; CriticalIssues holds
+	// the current page.
+	TotalCriticalIssues int    `json:"totalCriticalIssues"`
+	Continue            string `json:"continue,omitempty"`
+	// DefaultPolicy applies to every namespace not in NamespacePolicies,
+	// which holds the namespaces with analysis annotations.
+	DefaultPolicy     AnalysisPolicy            `json:"defaultPolicy"`
+	NamespacePolicies map[string]AnalysisPolicy `json:"namespacePolicies"`
+	CalculatedAt      time.Time                 `json:"calculatedAt"`
 }
 
 type NamespaceIssues struct {
@@ -0,0 +1,40 @@
+package models
+
+const (
+	PodDiffCategoryContainer      = "container"
+	PodDiffCategoryImage          = "image"
+	PodDiffCategoryEnv            = "env"
+	PodDiffCategoryResources      = "resources"
+	PodDiffCategoryContainerState = "containerState"
+	PodDiffCategoryNode           = "node"
+	PodDiffCategoryNodeLabels     = "nodeLabels"
+	PodDiffCategoryNodeTaints     = "nodeTaints"
+	PodDiffCategoryVolumes        = "volumes"
+	PodDiffCategoryQOS            = "qos"
+	PodDiffCategoryOwner          = "owner"
+)
+
+type PodReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// PodDifference is a single field that differs between the two compared
+// pods. A and B hold normalized values; an empty value means the field is
+// absent on that side.
+type PodDifference struct {
+	Category  string `json:"category"`
+	Container string `json:"container,omitempty"`
+	Field     string `json:"field"`
+	A         string `json:"a,omitempty"`
+	B         string `json:"b,omitempty"`
+}
+
+type PodComparison struct {
+	PodA                  PodReference    `json:"podA"`
+	PodB                  PodReference    `json:"podB"`
+	Identical             bool            `json:"identical"`
+	Differences           []PodDifference `json:"differences"`
+	DifferencesByCategory map[string]int  `json:"differencesByCategory"`
+	Redactions            []string        `json:"redactions,omitempty"`
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+const (
+	DrainActionEvict = "evict"
+	DrainActionSkip  = "skip"
+	// DrainActionDelete applies to pods without a controller; kubectl drain
+	// only removes them with --force and nothing recreates them.
+	DrainActionDelete = "delete"
+)
+
+// DrainSimulation predicts the outcome of draining a node without changing
+// anything in the cluster. Blockers stall or fail the drain; warnings
+// describe pods that will be lost or left Pending.
+type DrainSimulation struct {
+	NodeName    string           `json:"nodeName"`
+	Drainable   bool             `json:"drainable"`
+	TotalPods   int              `json:"totalPods"`
+	Summary     DrainSummary     `json:"summary"`
+	Blockers    []string         `json:"blockers"`
+	Warnings    []string         `json:"warnings"`
+	Pods        []DrainPodResult `json:"pods"`
+	SimulatedAt time.Time        `json:"simulatedAt"`
+}
+
+type DrainSummary struct {
+	Evicted          int `json:"evicted"`
+	DaemonSetPods    int `json:"daemonSetPods"`
+	MirrorPods       int `json:"mirrorPods"`
+	LocalStoragePods int `json:"localStoragePods"`
+	UnmanagedPods    int `json:"unmanagedPods"`
+	BlockedByPDB     int `json:"blockedByPDB"`
+	Unreschedulable  int `json:"unreschedulable"`
+}
+
+type DrainPodResult struct {
+	Namespace    string   `json:"namespace"`
+	Name         string   `json:"name"`
+	OwnerKind    string   `json:"ownerKind,omitempty"`
+	OwnerName    string   `json:"ownerName,omitempty"`
+	Action       string   `json:"action"`
+	DaemonSet    bool     `json:"daemonSet,omitempty"`
+	Mirror       bool     `json:"mirror,omitempty"`
+	LocalStorage bool     `json:"localStorage,omitempty"`
+	Unmanaged    bool     `json:"unmanaged,omitempty"`
+	BlockingPDBs []string `json:"blockingPDBs,omitempty"`
+
+	// Reschedulable is only set for evicted pods whose eviction is not
+	// blocked by a PodDisruptionBudget. TargetNode is the first
+	// node the pod fits on after the pods evicted before it were placed.
+	Reschedulable   *bool  `json:"reschedulable,omitempty"`
+	TargetNode      string `json:"targetNode,omitempty"`
+	SchedulingIssue string `json:"schedulingIssue,omitempty"`
+}
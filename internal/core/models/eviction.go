@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+const (
+	EvictionSignalMemory           = "memory"
+	EvictionSignalEphemeralStorage = "ephemeral-storage"
+)
+
+// EvictionRanking lists the pods on a node in the order the kubelet would
+// evict them if the node ran out of the given resource. Pods that exceed
+// their requests go first, then lower priority, then larger overshoot.
+type EvictionRanking struct {
+	NodeName      string `json:"nodeName"`
+	Signal        string `json:"signal"`
+	UnderPressure bool   `json:"underPressure"`
+	// UsageSource is "metrics-server" or "kubelet" depending on where pod
+	// usage was read from.
+	UsageSource string `json:"usageSource"`
+	// ThresholdSource is "kubelet" when evictionHard was read from the
+	// kubelet configuration and "default" when kubelet defaults are assumed.
+	ThresholdSource string                    `json:"thresholdSource,omitempty"`
+	Thresholds      []EvictionThresholdStatus `json:"thresholds"`
+	Pods            []EvictionCandidate       `json:"pods"`
+	Warnings        []string                  `json:"warnings,omitempty"`
+	CalculatedAt    time.Time                 `json:"calculatedAt"`
+}
+
+// EvictionThresholdStatus compares one kubelet eviction signal against its
+// hard threshold. Headroom is how much more can be consumed before the
+// kubelet starts evicting.
+type EvictionThresholdStatus struct {
+	Signal             string  `json:"signal"`
+	Threshold          string  `json:"threshold"`
+	Available          string  `json:"available"`
+	Capacity           string  `json:"capacity"`
+	Headroom           string  `json:"headroom"`
+	HeadroomPercentage float64 `json:"headroomPercentage"`
+	Breached           bool    `json:"breached"`
+}
+
+type EvictionCandidate struct {
+	Rank           int    `json:"rank"`
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	QOSClass       string `json:"qosClass"`
+	Priority       int32  `json:"priority"`
+	Usage          string `json:"usage"`
+	Request        string `json:"request"`
+	ExceedsRequest bool   `json:"exceedsRequest"`
+	// UsageAboveRequest is negative when the pod is below its request.
+	UsageAboveRequest string `json:"usageAboveRequest"`
+	// Critical pods (static, mirror or system-critical priority) are never
+	// evicted by the kubelet and are listed last.
+	Critical bool   `json:"critical"`
+	Reason   string `json:"reason"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// Health trend directions.
+const (
+	HealthTrendImproving = "improving"
+	HealthTrendStable    = "stable"
+	HealthTrendDegrading = "degrading"
+	HealthTrendUnknown   = "unknown"
+)
+
+// HealthScorePoint is one recorded health score.
+type HealthScorePoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Score     int       `json:"score"`
+}
+
+// HealthTrend summarizes recorded scores. SlopePerHour is the least squares
+// slope in score points per hour. DegradingSince is when the score started
+// its current decline, set when it has dropped noticeably since.
+type HealthTrend struct {
+	Direction      string     `json:"direction"`
+	SlopePerHour   float64    `json:"slopePerHour"`
+	Change         int        `json:"change"`
+	DegradingSince *time.Time `json:"degradingSince,omitempty"`
+	Samples        int        `json:"samples"`
+}
+
+// PodHealthHistory is the recorded health score history of one pod. History
+// outlives the pod until it ages out of retention.
+type PodHealthHistory struct {
+	Namespace string             `json:"namespace"`
+	PodName   string             `json:"podName"`
+	Interval  string             `json:"interval"`
+	Retention string             `json:"retention"`
+	Points    []HealthScorePoint `json:"points"`
+	Trend     HealthTrend        `json:"trend"`
+}
+
+// WorkloadHealthTrends lists recorded workload health trends, the most
+// degrading first.
+type WorkloadHealthTrends struct {
+	Namespace    string                `json:"namespace,omitempty"`
+	Interval     string                `json:"interval"`
+	Retention    string                `json:"retention"`
+	Workloads    []WorkloadHealthTrend `json:"workloads"`
+	CalculatedAt time.Time             `json:"calculatedAt"`
+}
+
+// WorkloadHealthTrend is the recorded health of one workload.
+type WorkloadHealthTrend struct {
+	Namespace    string             `json:"namespace"`
+	Kind         string             `json:"kind"`
+	Name         string             `json:"name"`
+	LatestScore  int                `json:"latestScore"`
+	LastRecorded time.Time          `json:"lastRecorded"`
+	Trend        HealthTrend        `json:"trend"`
+	Points       []HealthScorePoint `json:"points,omitempty"`
+}
+
+// HealthScoreSnapshot holds the scores of every running pod and workload in
+// the cluster at one point in time.
+type HealthScoreSnapshot struct {
+	Pods         []PodHealthItem       `json:"pods"`
+	Workloads    []WorkloadHealthScore `json:"workloads"`
+	CalculatedAt time.Time             `json:"calculatedAt"`
+}
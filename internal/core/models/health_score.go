@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 type PodHealthScore struct {
 	PodName      string                     `json:"podName"`
@@ -10,6 +13,15 @@ type PodHealthScore struct {
 	Components   map[string]HealthComponent `json:"components"`
 	CalculatedAt time.Time                  `json:"calculatedAt"`
 	Details      HealthDetails              `json:"details"`
+	Policy       AnalysisPolicy             `json:"policy"`
+	Scoring      ScoringPolicyRef           `json:"scoringPolicy"`
+}
+
+// ScoringPolicyRef identifies the scoring policy a score was graded with and
+// the override that selected the pod, if any.
+type ScoringPolicyRef struct {
+	Version  string `json:"version"`
+	Override string `json:"override,omitempty"`
 }
 
 type HealthComponent struct {
@@ -29,6 +41,31 @@ type HealthDetails struct {
 	ContainerStatuses []ContainerHealth `json:"containerStatuses"`
 	RecentEvents      []EventSummary    `json:"recentEvents"`
 	PodConditions     []ConditionStatus `json:"podConditions"`
+	Resources         ResourcePressure  `json:"resources"`
+}
+
+// ResourcePressure is what the resources component was graded on. Usage
+// comes from metrics-server and throttling from the kubelet's cAdvisor
+// metrics; either is left out when it cannot be read, and throttling also
+// until the node has been polled twice.
+type ResourcePressure struct {
+	UsageAvailable      bool                        `json:"usageAvailable"`
+	ThrottlingAvailable bool                        `json:"throttlingAvailable"`
+	Containers          []ContainerResourcePressure `json:"containers"`
+	// OverallScoreCapped is set when resource pressure capped the overall
+	// score.
+	OverallScoreCapped bool `json:"overallScoreCapped,omitempty"`
+}
+
+type ContainerResourcePressure struct {
+	Name              string   `json:"name"`
+	MemoryUsageBytes  *int64   `json:"memoryUsageBytes,omitempty"`
+	MemoryLimitBytes  *int64   `json:"memoryLimitBytes,omitempty"`
+	MemoryLimitRatio  *float64 `json:"memoryLimitRatio,omitempty"`
+	CPUThrottledRatio *float64 `json:"cpuThrottledRatio,omitempty"`
+	// LastOOMKilledAt is when the container was last OOMKilled, if its last
+	// termination was an OOM kill.
+	LastOOMKilledAt *time.Time `json:"lastOOMKilledAt,omitempty"`
 }
 
 type ContainerHealth struct {
@@ -41,11 +78,12 @@ type ContainerHealth struct {
 }
 
 type EventSummary struct {
-	Type     string    `json:"type"`
-	Reason   string    `json:"reason"`
-	Message  string    `json:"message"`
-	Count    int32     `json:"count"`
-	LastSeen time.Time `json:"lastSeen"`
+	Type         string            `json:"type"`
+	Reason       string            `json:"reason"`
+	Message      string            `json:"message"`
+	Count        int32             `json:"count"`
+	LastSeen     time.Time         `json:"lastSeen"`
+	Relationship EventRelationship `json:"relationship,omitempty"`
 }
 
 type ConditionStatus struct {
@@ -55,17 +93,108 @@ type ConditionStatus struct {
 	Message string `json:"message,omitempty"`
 }
 
-func (h *PodHealthScore) GetHealthStatus() string {
+// AggregateHealthScore rolls pod health scores up to a namespace or the
+// whole cluster. Pods are averaged per workload, workloads short of ready
+// replicas are penalized, and workloads are combined weighted by priority so
+// that a failing critical service outweighs a failing batch job. Components
+// holds the same weighted average per pod score component.
+type AggregateHealthScore struct {
+	Namespace      string                `json:"namespace,omitempty"`
+	OverallScore   int                   `json:"overallScore"`
+	Status         string                `json:"status"`
+	Components     map[string]int        `json:"components"`
+	PodsScored     int                   `json:"podsScored"`
+	WorkloadCount  int                   `json:"workloadCount"`
+	Namespaces     []NamespaceHealthItem `json:"namespaces,omitempty"`
+	WorstWorkloads []WorkloadHealthScore `json:"worstWorkloads"`
+	WorstPods      []PodHealthItem       `json:"worstPods"`
+	Scoring        ScoringPolicyRef      `json:"scoringPolicy"`
+	CalculatedAt   time.Time             `json:"calculatedAt"`
+}
+
+// WorkloadHealthScore is the health of one top-level owner. PodScore is the
+// mean overall score of its running pods and ReplicaPenalty is subtracted
+// from it when fewer replicas are ready than desired. Replica counts are
+// only set when StatusKnown. Weight reflects the pods' priority.
+type WorkloadHealthScore struct {
+	Namespace       string         `json:"namespace"`
+	Kind            string         `json:"kind"`
+	Name            string         `json:"name"`
+	Priority        int32          `json:"priority"`
+	Weight          float64        `json:"weight"`
+	Pods            int            `json:"pods"`
+	StatusKnown     bool           `json:"statusKnown"`
+	DesiredReplicas int32          `json:"desiredReplicas"`
+	ReadyReplicas   int32          `json:"readyReplicas"`
+	PodScore        int            `json:"podScore"`
+	ReplicaPenalty  int            `json:"replicaPenalty"`
+	Score           int            `json:"score"`
+	Status          string         `json:"status"`
+	Components      map[string]int `json:"components"`
+	WorstPod        string         `json:"worstPod,omitempty"`
+}
+
+type NamespaceHealthItem struct {
+	Namespace string `json:"namespace"`
+	Score     int    `json:"score"`
+	Status    string `json:"status"`
+	Workloads int    `json:"workloads"`
+}
+
+// PodHealthItem summarizes one pod's score with its weakest component.
+type PodHealthItem struct {
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	Score          int    `json:"score"`
+	Status         string `json:"status"`
+	WorstComponent string `json:"worstComponent"`
+}
+
+// HealthStatus maps an overall 0-100 score to its status label.
+func HealthStatus(score int) string {
 	switch {
-	case h.OverallScore >= 90:
+	case score >= 90:
 		return "Healthy"
-	case h.OverallScore >= 70:
+	case score >= 70:
 		return "Good"
-	case h.OverallScore >= 50:
+	case score >= 50:
 		return "Warning"
-	case h.OverallScore >= 30:
+	case score >= 30:
 		return "Degraded"
 	default:
 		return "Critical"
 	}
 }
+
+// ComponentStatus maps a single component's 0-100 score to its status label.
+func ComponentStatus(score int) string {
+	switch {
+	case score >= 90:
+		return "Excellent"
+	case score >= 70:
+		return "Good"
+	case score >= 50:
+		return "Fair"
+	case score >= 30:
+		return "Poor"
+	default:
+		return "Critical"
+	}
+}
+
+// WeightedScore combines component scores using their weights.
+func WeightedScore(components map[string]HealthComponent) int {
+	weightedSum := 0.0
+	totalWeight := 0.0
+
+	for _, component := range components {
+		weightedSum += float64(component.Score) * component.Weight
+		totalWeight += component.Weight
+	}
+
+	if totalWeight == 0 {
+		return 0
+	}
+
+	return int(math.Round(weightedSum / totalWeight))
+}
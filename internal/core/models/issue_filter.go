@@ -0,0 +1,43 @@
+package models
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssueListOptions narrows and pages the pods behind an issue report.
+// LabelSelector and FieldSelector use Kubernetes selector syntax and are
+// evaluated by the API server. OwnerName matches a pod's direct owner or the
+// workload behind it. IssueType keeps only issues of that type, or of that
+// category in the cluster report. Limit caps the page size, zero meaning no
+// limit, and Continue resumes after the page that returned it.
+type IssueListOptions struct {
+	LabelSelector string
+	FieldSelector string
+	OwnerName     string
+	NodeName      string
+	IssueType     string
+	Limit         int
+	Continue      string
+}
+
+// MaxIssueListLimit bounds the page size callers may request.
+const MaxIssueListLimit = 500
+
+// PodListOptions returns the selectors to send with the pod List call, with
+// NodeName folded into the field selector.
+func (o IssueListOptions) PodListOptions() metav1.ListOptions {
+	fieldSelectors := []string{}
+	if o.FieldSelector != "" {
+		fieldSelectors = append(fieldSelectors, o.FieldSelector)
+	}
+	if o.NodeName != "" {
+		fieldSelectors = append(fieldSelectors, "spec.nodeName="+o.NodeName)
+	}
+
+	return metav1.ListOptions{
+		LabelSelector: o.LabelSelector,
+		FieldSelector: strings.Join(fieldSelectors, ","),
+	}
+}
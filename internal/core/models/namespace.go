@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type PodIssueType string
@@ -14,8 +16,26 @@ const (
 	PodIssueImagePull           PodIssueType = "ImagePullError"
 	PodIssueResourceConstraints PodIssueType = "ResourceConstraints"
 	PodIssueUnschedulable       PodIssueType = "Unschedulable"
+	PodIssueJobFailed           PodIssueType = "JobFailed"
+	// Quota issues apply to the namespace rather than a single pod.
+	PodIssueQuotaNearLimit PodIssueType = "QuotaNearLimit"
+	PodIssueQuotaExhausted PodIssueType = "QuotaExhausted"
 )
 
+// OwnerKindAll selects pods regardless of what owns them.
+const OwnerKindAll = "all"
+
+// NamespaceErrorOptions narrows the namespace error analysis to pods owned
+// by the given kinds. A kind matches either the pod's direct owner or the
+// workload behind it, so ReplicaSet and Deployment select the same pods, as
+// do Job and CronJob for scheduled jobs. Pod selects pods without an owner.
+// An empty list analyzes every pod. The embedded IssueListOptions filter
+// the analyzed pods and page ProblematicPods.
+type NamespaceErrorOptions struct {
+	OwnerKinds []string
+	IssueListOptions
+}
+
 type PodIssue struct {
 	Type        PodIssueType `json:"type"`
 	Description string       `json:"description"`
@@ -51,8 +71,54 @@ type NamespaceErrorReport struct {
 	ProblematicPodsCount int                     `json:"problematicPodsCount"`
 	HealthyPodsCount     int                     `json:"healthyPodsCount"`
 	RestartThresholdUsed int                     `json:"restartThresholdUsed"`
+	Policy               AnalysisPolicy          `json:"policy"`
+	OwnerKinds           []string                `json:"ownerKinds"`
+	ExcludedPods         int                     `json:"excludedPods"`
 	Summary              []NamespaceErrorSummary `json:"summary"`
+	NamespaceIssues      []PodIssue              `json:"namespaceIssues"`
+	Workloads            []WorkloadErrorSummary  `json:"workloads"`
 	ProblematicPods      []ProblematicPod        `json:"problematicPods"`
+	Continue             string                  `json:"continue,omitempty"`
 	CriticalIssuesCount  int                     `json:"criticalIssuesCount"`
 	WarningIssuesCount   int                     `json:"warningIssuesCount"`
 }
+
+// WorkloadErrorSummary rolls up the problematic pods of one top-level owner.
+// Replica counts come from the workload's status and are only set when
+// StatusKnown; for Jobs, desired is the completion count and available the
+// number of succeeded pods. Pods without an owner are reported as kind Pod.
+type WorkloadErrorSummary struct {
+	Kind              string         `json:"kind"`
+	Name              string         `json:"name"`
+	StatusKnown       bool           `json:"statusKnown"`
+	DesiredReplicas   int32          `json:"desiredReplicas"`
+	ReadyReplicas     int32          `json:"readyReplicas"`
+	AvailableReplicas int32          `json:"availableReplicas"`
+	AnalyzedPods      int            `json:"analyzedPods"`
+	ProblematicPods   int            `json:"problematicPods"`
+	IssueTypes        []PodIssueType `json:"issueTypes"`
+	Severity          string         `json:"severity"`
+	RepresentativePod string         `json:"representativePod"`
+}
+
+// NamespaceEventDigest aggregates a namespace's Warning events. Counts
+// include repeats folded into a single event by the event recorder.
+type NamespaceEventDigest struct {
+	Namespace     string       `json:"namespace"`
+	TotalWarnings int          `json:"totalWarnings"`
+	Groups        []EventGroup `json:"groups"`
+	AnalyzedAt    time.Time    `json:"analyzedAt"`
+}
+
+// EventGroup is every Warning event with the same reason recorded against
+// objects of the same kind. Objects is the number of distinct objects.
+type EventGroup struct {
+	Reason        string      `json:"reason"`
+	ObjectKind    string      `json:"objectKind"`
+	Count         int         `json:"count"`
+	Objects       int         `json:"objects"`
+	SampleObjects []string    `json:"sampleObjects"`
+	FirstSeen     metav1.Time `json:"firstSeen"`
+	LastSeen      metav1.Time `json:"lastSeen"`
+	LatestMessage string      `json:"latestMessage"`
+}
@@ -4,13 +4,119 @@ import (
 	"time"
 )
 
+// NodeUtilization reports live usage next to what the scheduler has already
+// committed on the node. CPUPercentage and MemoryPercentage are usage
+// relative to allocatable, since capacity includes system reservations that
+// pods can never use.
 type NodeUtilization struct {
-	NodeName         string    `json:"nodeName"`
-	CPUUsage         string    `json:"cpuUsage"`
-	CPUCapacity      string    `json:"cpuCapacity"`
-	CPUPercentage    float64   `json:"cpuPercentage"`
-	MemoryUsage      string    `json:"memoryUsage"`
-	MemoryCapacity   string    `json:"memoryCapacity"`
-	MemoryPercentage float64   `json:"memoryPercentage"`
-	Timestamp        time.Time `json:"timestamp"`
+	NodeName          string    `json:"nodeName"`
+	CPUUsage          string    `json:"cpuUsage"`
+	CPUCapacity       string    `json:"cpuCapacity"`
+	CPUAllocatable    string    `json:"cpuAllocatable"`
+	CPUPercentage     float64   `json:"cpuPercentage"`
+	MemoryUsage       string    `json:"memoryUsage"`
+	MemoryCapacity    string    `json:"memoryCapacity"`
+	MemoryAllocatable string    `json:"memoryAllocatable"`
+	MemoryPercentage  float64   `json:"memoryPercentage"`
+	Timestamp         time.Time `json:"timestamp"`
+
+	// Allocation is keyed by resource name and covers cpu, memory,
+	// ephemeral-storage, pods and any extended resources the node advertises.
+	// It is omitted when the pods on the node could not be listed.
+	Allocation map[string]ResourceAllocation `json:"allocation,omitempty"`
+}
+
+// ResourceAllocation compares one resource's requests and limits with the
+// node's allocatable amount. Limits percentages above 100 mean the node is
+// overcommitted.
+type ResourceAllocation struct {
+	Capacity            string  `json:"capacity"`
+	Allocatable         string  `json:"allocatable"`
+	Requested           string  `json:"requested"`
+	Limits              string  `json:"limits,omitempty"`
+	RequestedPercentage float64 `json:"requestedPercentage"`
+	LimitsPercentage    float64 `json:"limitsPercentage,omitempty"`
+}
+
+const (
+	NodeUtilizationSortCPU    = "cpu"
+	NodeUtilizationSortMemory = "memory"
+	NodeUtilizationSortName   = "name"
+)
+
+// NodeUtilizationListOptions filters and orders a cluster-wide utilization
+// listing. Zero-valued thresholds are ignored.
+type NodeUtilizationListOptions struct {
+	SortBy        string
+	LabelSelector string
+	CPUAbove      float64
+	MemoryAbove   float64
+}
+
+type NodeUtilizationTotals struct {
+	CPUUsage                  string  `json:"cpuUsage"`
+	CPUCapacity               string  `json:"cpuCapacity"`
+	CPUAllocatable            string  `json:"cpuAllocatable"`
+	CPURequested              string  `json:"cpuRequested"`
+	CPUPercentage             float64 `json:"cpuPercentage"`
+	CPURequestedPercentage    float64 `json:"cpuRequestedPercentage"`
+	MemoryUsage               string  `json:"memoryUsage"`
+	MemoryCapacity            string  `json:"memoryCapacity"`
+	MemoryAllocatable         string  `json:"memoryAllocatable"`
+	MemoryRequested           string  `json:"memoryRequested"`
+	MemoryPercentage          float64 `json:"memoryPercentage"`
+	MemoryRequestedPercentage float64 `json:"memoryRequestedPercentage"`
+}
+
+// NodeUtilizationList is the utilization of every node matching a label
+// selector. Totals cover all selected nodes that report metrics, regardless
+// of the threshold filters applied to Nodes.
+type NodeUtilizationList struct {
+	TotalNodes          int                   `json:"totalNodes"`
+	MatchingNodes       int                   `json:"matchingNodes"`
+	NodesWithoutMetrics []string              `json:"nodesWithoutMetrics,omitempty"`
+	Totals              NodeUtilizationTotals `json:"totals"`
+	Nodes               []NodeUtilization     `json:"nodes"`
+	Timestamp           time.Time             `json:"timestamp"`
+}
+
+// NodePodUsage is one pod's share of a node. Percentages are relative to the
+// node's allocatable resources; usage fields are empty when pod metrics are
+// unavailable.
+type NodePodUsage struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	QOSClass  string `json:"qosClass"`
+
+	CPURequest              string  `json:"cpuRequest"`
+	CPULimit                string  `json:"cpuLimit"`
+	CPUUsage                string  `json:"cpuUsage,omitempty"`
+	CPURequestPercentage    float64 `json:"cpuRequestPercentage"`
+	CPUUsagePercentage      float64 `json:"cpuUsagePercentage"`
+	MemoryRequest           string  `json:"memoryRequest"`
+	MemoryLimit             string  `json:"memoryLimit"`
+	MemoryUsage             string  `json:"memoryUsage,omitempty"`
+	MemoryRequestPercentage float64 `json:"memoryRequestPercentage"`
+	MemoryUsagePercentage   float64 `json:"memoryUsagePercentage"`
+}
+
+type NodeResourceTotals struct {
+	Allocatable        string  `json:"allocatable"`
+	Requests           string  `json:"requests"`
+	Limits             string  `json:"limits"`
+	Usage              string  `json:"usage,omitempty"`
+	RequestsPercentage float64 `json:"requestsPercentage"`
+	LimitsPercentage   float64 `json:"limitsPercentage"`
+	UsagePercentage    float64 `json:"usagePercentage"`
+}
+
+type NodePodBreakdown struct {
+	NodeName         string             `json:"nodeName"`
+	PodCount         int                `json:"podCount"`
+	MetricsAvailable bool               `json:"metricsAvailable"`
+	CPU              NodeResourceTotals `json:"cpu"`
+	Memory           NodeResourceTotals `json:"memory"`
+	Pods             []NodePodUsage     `json:"pods"`
+	Timestamp        time.Time          `json:"timestamp"`
 }
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// NodeHealth scores a node the same way PodHealthScore scores a pod: each
+// component contributes a weighted 0-100 score.
+type NodeHealth struct {
+	NodeName     string                     `json:"nodeName"`
+	OverallScore int                        `json:"overallScore"`
+	Status       string                     `json:"status"`
+	Components   map[string]HealthComponent `json:"components"`
+	CalculatedAt time.Time                  `json:"calculatedAt"`
+	Details      NodeHealthDetails          `json:"details"`
+}
+
+type NodeHealthDetails struct {
+	Conditions              []NodeConditionHealth `json:"conditions"`
+	Unschedulable           bool                  `json:"unschedulable"`
+	KubeletVersion          string                `json:"kubeletVersion"`
+	ContainerRuntimeVersion string                `json:"containerRuntimeVersion"`
+	KernelVersion           string                `json:"kernelVersion,omitempty"`
+	OSImage                 string                `json:"osImage,omitempty"`
+	Heartbeat               *NodeHeartbeat        `json:"heartbeat,omitempty"`
+	RecentEvents            []EventSummary        `json:"recentEvents"`
+	Problems                []string              `json:"problems"`
+}
+
+// NodeConditionHealth is a node condition annotated with whether its current
+// status is healthy. Custom conditions are those reported by
+// node-problem-detector or other agents rather than the kubelet.
+type NodeConditionHealth struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+	Healthy            bool      `json:"healthy"`
+	Custom             bool      `json:"custom,omitempty"`
+}
+
+// NodeHeartbeat describes the kubelet's Lease in kube-node-lease.
+type NodeHeartbeat struct {
+	RenewTime            time.Time `json:"renewTime"`
+	AgeSeconds           float64   `json:"ageSeconds"`
+	LeaseDurationSeconds int32     `json:"leaseDurationSeconds"`
+	Stale                bool      `json:"stale"`
+}
+
+func (h *NodeHealth) GetHealthStatus() string {
+	return HealthStatus(h.OverallScore)
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// NodeTopologyUnknown names the group for nodes missing a zone, region or
+// pool label.
+const NodeTopologyUnknown = "unknown"
+
+type NodeInventoryOptions struct {
+	LabelSelector string
+	// PoolLabel overrides the well-known node pool labels used to assign
+	// nodes to pools.
+	PoolLabel string
+}
+
+// NodeInventory lists nodes with their platform details and groups them by
+// topology. ControlPlaneVersion is empty when discovery failed, in which
+// case version skew is not evaluated.
+type NodeInventory struct {
+	TotalNodes          int                 `json:"totalNodes"`
+	ControlPlaneVersion string              `json:"controlPlaneVersion,omitempty"`
+	Nodes               []NodeInventoryItem `json:"nodes"`
+	Regions             []NodeGroup         `json:"regions"`
+	Zones               []NodeGroup         `json:"zones"`
+	Pools               []NodePool          `json:"pools"`
+	VersionSkew         []NodeVersionSkew   `json:"versionSkew"`
+	Warnings            []string            `json:"warnings,omitempty"`
+	CollectedAt         time.Time           `json:"collectedAt"`
+}
+
+type NodeInventoryItem struct {
+	Name             string            `json:"name"`
+	Region           string            `json:"region"`
+	Zone             string            `json:"zone"`
+	Pool             string            `json:"pool"`
+	InstanceType     string            `json:"instanceType,omitempty"`
+	KubeletVersion   string            `json:"kubeletVersion"`
+	ContainerRuntime string            `json:"containerRuntime"`
+	OSImage          string            `json:"osImage"`
+	KernelVersion    string            `json:"kernelVersion"`
+	OperatingSystem  string            `json:"operatingSystem"`
+	Architecture     string            `json:"architecture"`
+	Taints           []string          `json:"taints"`
+	Allocatable      map[string]string `json:"allocatable"`
+	Ready            bool              `json:"ready"`
+	Unschedulable    bool              `json:"unschedulable"`
+	CreatedAt        time.Time         `json:"createdAt"`
+}
+
+type NodeGroup struct {
+	Name      string   `json:"name"`
+	NodeCount int      `json:"nodeCount"`
+	Nodes     []string `json:"nodes"`
+}
+
+// NodePool is heterogeneous when its nodes differ in instance type,
+// architecture, OS image, runtime, kubelet version or allocatable CPU and
+// memory. Differences lists the distinct values of each differing field.
+type NodePool struct {
+	NodeGroup
+	Heterogeneous bool     `json:"heterogeneous"`
+	Differences   []string `json:"differences,omitempty"`
+}
+
+// NodeVersionSkew flags a kubelet whose version differs from the control
+// plane. Kubelets may be up to three minor versions older than the API
+// server and never newer.
+type NodeVersionSkew struct {
+	NodeName       string `json:"nodeName"`
+	KubeletVersion string `json:"kubeletVersion"`
+	MinorVersions  int    `json:"minorVersions"`
+	Supported      bool   `json:"supported"`
+	Message        string `json:"message"`
+}
@@ -139,6 +139,8 @@ type PodDescription struct {
 	Events []EventInfo `json:"events,omitempty"`
 
 	Conditions []v1.PodCondition `json:"conditions,omitempty"`
+
+	Redactions []string `json:"redactions,omitempty"`
 }
 
 type PodStatusInfo struct {
@@ -175,6 +177,16 @@ type VolumeMountInfo struct {
 	SubPath   string `json:"subPath,omitempty"`
 }
 
+type EventRelationship string
+
+const (
+	EventRelationshipPod            EventRelationship = "Pod"
+	EventRelationshipOwner          EventRelationship = "Owner"
+	EventRelationshipNode           EventRelationship = "Node"
+	EventRelationshipPVC            EventRelationship = "PersistentVolumeClaim"
+	EventRelationshipServiceAccount EventRelationship = "ServiceAccount"
+)
+
 type EventInfo struct {
 	Type           string      `json:"type"`
 	Reason         string      `json:"reason"`
@@ -183,6 +195,11 @@ type EventInfo struct {
 	LastTimestamp  metav1.Time `json:"lastTimestamp"`
 	Count          int32       `json:"count"`
 	Source         string      `json:"source"`
+
+	// InvolvedObject is the Kind/Name of the object the event was recorded
+	// against and Relationship is how that object relates to the pod.
+	InvolvedObject string            `json:"involvedObject,omitempty"`
+	Relationship   EventRelationship `json:"relationship,omitempty"`
 }
 
 type FailureEventCategory string
@@ -195,6 +212,8 @@ const (
 	FailureEventCategoryResource   FailureEventCategory = "Resource"
 	FailureEventCategoryProbe      FailureEventCategory = "Probe"
 	FailureEventCategoryNetwork    FailureEventCategory = "Network"
+	FailureEventCategoryNode       FailureEventCategory = "Node"
+	FailureEventCategoryController FailureEventCategory = "Controller"
 	FailureEventCategoryOther      FailureEventCategory = "Other"
 )
 
@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// QuotaNearLimitPercentage is the share of a quota's hard limit at which the
+// resource is flagged as nearly exhausted.
+const QuotaNearLimitPercentage = 90.0
+
+// NamespaceQuotaReport shows how much of each ResourceQuota is used, which
+// workloads use it, and the LimitRange values applied to new containers.
+type NamespaceQuotaReport struct {
+	Namespace   string               `json:"namespace"`
+	Quotas      []ResourceQuotaUsage `json:"quotas"`
+	LimitRanges []LimitRangeSummary  `json:"limitRanges"`
+	Warnings    []string             `json:"warnings,omitempty"`
+	AnalyzedAt  time.Time            `json:"analyzedAt"`
+}
+
+type ResourceQuotaUsage struct {
+	Name string `json:"name"`
+	// Scopes and ScopeSelector restrict which pods the quota counts.
+	Scopes        []string             `json:"scopes,omitempty"`
+	ScopeSelector []string             `json:"scopeSelector,omitempty"`
+	Resources     []QuotaResourceUsage `json:"resources"`
+	NearLimit     bool                 `json:"nearLimit"`
+	// TopConsumers ranks the workloads whose pods use the largest share of
+	// any pod-level resource in the quota.
+	TopConsumers []QuotaConsumer `json:"topConsumers"`
+}
+
+type QuotaResourceUsage struct {
+	Resource   string  `json:"resource"`
+	Used       string  `json:"used"`
+	Hard       string  `json:"hard"`
+	Percentage float64 `json:"percentage"`
+	NearLimit  bool    `json:"nearLimit"`
+	Exhausted  bool    `json:"exhausted"`
+}
+
+type QuotaConsumer struct {
+	Kind  string            `json:"kind"`
+	Name  string            `json:"name"`
+	Pods  int               `json:"pods"`
+	Usage map[string]string `json:"usage"`
+	// LargestShare is the highest percentage of any hard limit this
+	// workload accounts for, in LargestShareResource.
+	LargestShare         float64 `json:"largestShare"`
+	LargestShareResource string  `json:"largestShareResource"`
+}
+
+// LimitRangeSummary lists a LimitRange's constraints per limit type and
+// resource. Defaults are injected into containers that set no value.
+type LimitRangeSummary struct {
+	Name   string           `json:"name"`
+	Limits []LimitRangeItem `json:"limits"`
+}
+
+type LimitRangeItem struct {
+	Type                 string `json:"type"`
+	Resource             string `json:"resource"`
+	Min                  string `json:"min,omitempty"`
+	Max                  string `json:"max,omitempty"`
+	Default              string `json:"default,omitempty"`
+	DefaultRequest       string `json:"defaultRequest,omitempty"`
+	MaxLimitRequestRatio string `json:"maxLimitRequestRatio,omitempty"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+const (
+	PodSecurityLevelPrivileged = "privileged"
+	PodSecurityLevelBaseline   = "baseline"
+	PodSecurityLevelRestricted = "restricted"
+
+	SecurityCheckLevelBestPractice = "bestPractice"
+)
+
+type SecurityFinding struct {
+	Check       string `json:"check"`
+	Level       string `json:"level"`
+	Severity    string `json:"severity"`
+	Container   string `json:"container,omitempty"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+type NamespaceSecurityPolicy struct {
+	Enforce        string `json:"enforce,omitempty"`
+	EnforceVersion string `json:"enforceVersion,omitempty"`
+	Audit          string `json:"audit,omitempty"`
+	AuditVersion   string `json:"auditVersion,omitempty"`
+	Warn           string `json:"warn,omitempty"`
+	WarnVersion    string `json:"warnVersion,omitempty"`
+}
+
+type PodSecurityAudit struct {
+	PodName             string                   `json:"podName"`
+	Namespace           string                   `json:"namespace"`
+	Level               string                   `json:"level"`
+	BaselineCompliant   bool                     `json:"baselineCompliant"`
+	RestrictedCompliant bool                     `json:"restrictedCompliant"`
+	NamespacePolicy     *NamespaceSecurityPolicy `json:"namespacePolicy,omitempty"`
+	ViolatesEnforce     bool                     `json:"violatesEnforce"`
+	ViolatesAudit       bool                     `json:"violatesAudit"`
+	ViolatesWarn        bool                     `json:"violatesWarn"`
+	Findings            []SecurityFinding        `json:"findings"`
+	CriticalCount       int                      `json:"criticalCount"`
+	WarningCount        int                      `json:"warningCount"`
+	InfoCount           int                      `json:"infoCount"`
+	AuditedAt           time.Time                `json:"auditedAt"`
+}
+
+type PodSecuritySummary struct {
+	Name            string `json:"name"`
+	Level           string `json:"level"`
+	ViolatesEnforce bool   `json:"violatesEnforce"`
+	FindingsCount   int    `json:"findingsCount"`
+	CriticalCount   int    `json:"criticalCount"`
+}
+
+type NamespaceSecurityAudit struct {
+	Namespace       string                   `json:"namespace"`
+	NamespacePolicy *NamespaceSecurityPolicy `json:"namespacePolicy,omitempty"`
+	TotalPods       int                      `json:"totalPods"`
+	PodsByLevel     map[string]int           `json:"podsByLevel"`
+	// PodsBlockedIfEnforced counts the pods that would be rejected if the
+	// namespace enforced the given level.
+	PodsBlockedIfEnforced map[string]int       `json:"podsBlockedIfEnforced"`
+	PodsViolatingEnforce  int                  `json:"podsViolatingEnforce"`
+	FindingsByCheck       map[string]int       `json:"findingsByCheck"`
+	Pods                  []PodSecuritySummary `json:"pods"`
+	AuditedAt             time.Time            `json:"auditedAt"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	StartupPhaseScheduling     = "scheduling"
+	StartupPhaseImagePull      = "imagePull"
+	StartupPhaseInitContainers = "initContainers"
+	StartupPhaseAppReadiness   = "appReadiness"
+)
+
+type ImagePullLatency struct {
+	Container string  `json:"container"`
+	Seconds   float64 `json:"seconds"`
+	Cached    bool    `json:"cached"`
+	Message   string  `json:"message,omitempty"`
+}
+
+// PodStartupLatency breaks the time from pod creation to Ready into phases.
+// All durations are in seconds; phases that could not be determined are 0.
+type PodStartupLatency struct {
+	PodName      string      `json:"podName"`
+	Namespace    string      `json:"namespace"`
+	WorkloadKind string      `json:"workloadKind"`
+	WorkloadName string      `json:"workloadName"`
+	CreatedAt    metav1.Time `json:"createdAt"`
+	Ready        bool        `json:"ready"`
+	RestartCount int32       `json:"restartCount"`
+	// ReadinessProbeFailures counts failed readiness probes. The Ready
+	// condition only records its latest transition, so when the probe has
+	// failed the pod may have been Ready before TimeToReadySeconds.
+	ReadinessProbeFailures int32 `json:"readinessProbeFailures"`
+
+	SchedulingSeconds     float64 `json:"schedulingSeconds"`
+	ImagePullSeconds      float64 `json:"imagePullSeconds"`
+	InitContainersSeconds float64 `json:"initContainersSeconds"`
+	AppReadinessSeconds   float64 `json:"appReadinessSeconds"`
+	TimeToReadySeconds    float64 `json:"timeToReadySeconds"`
+
+	// Bottleneck is the phase that took the largest share of startup time.
+	Bottleneck string             `json:"bottleneck,omitempty"`
+	ImagePulls []ImagePullLatency `json:"imagePulls,omitempty"`
+}
+
+type StartupPercentiles struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	Max float64 `json:"max"`
+}
+
+type WorkloadStartupStats struct {
+	WorkloadKind   string             `json:"workloadKind"`
+	WorkloadName   string             `json:"workloadName"`
+	ReadyPods      int                `json:"readyPods"`
+	TimeToReady    StartupPercentiles `json:"timeToReady"`
+	Scheduling     StartupPercentiles `json:"scheduling"`
+	ImagePull      StartupPercentiles `json:"imagePull"`
+	InitContainers StartupPercentiles `json:"initContainers"`
+	AppReadiness   StartupPercentiles `json:"appReadiness"`
+	Bottleneck     string             `json:"bottleneck,omitempty"`
+	SlowestPod     string             `json:"slowestPod"`
+}
+
+type NamespaceStartupStats struct {
+	Namespace   string                 `json:"namespace"`
+	AnalyzedAt  time.Time              `json:"analyzedAt"`
+	TotalPods   int                    `json:"totalPods"`
+	ReadyPods   int                    `json:"readyPods"`
+	TimeToReady StartupPercentiles     `json:"timeToReady"`
+	Workloads   []WorkloadStartupStats `json:"workloads"`
+}
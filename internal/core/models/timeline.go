@@ -0,0 +1,54 @@
+package models
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type TimelineEntryType string
+
+const (
+	TimelineEntryCreated             TimelineEntryType = "Created"
+	TimelineEntryCondition           TimelineEntryType = "Condition"
+	TimelineEntryContainerStarted    TimelineEntryType = "ContainerStarted"
+	TimelineEntryContainerTerminated TimelineEntryType = "ContainerTerminated"
+	TimelineEntryImagePull           TimelineEntryType = "ImagePull"
+	TimelineEntryProbeFailure        TimelineEntryType = "ProbeFailure"
+	TimelineEntryContainerKilled     TimelineEntryType = "ContainerKilled"
+)
+
+type TimelineEntry struct {
+	Timestamp metav1.Time       `json:"timestamp"`
+	Type      TimelineEntryType `json:"type"`
+	Reason    string            `json:"reason"`
+	Container string            `json:"container,omitempty"`
+	Message   string            `json:"message,omitempty"`
+	Count     int32             `json:"count,omitempty"`
+
+	// Duration is set for entries that span an interval, such as image pulls.
+	Duration        string  `json:"duration,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+
+	SincePrevious        string  `json:"sincePrevious"`
+	SincePreviousSeconds float64 `json:"sincePreviousSeconds"`
+	SinceCreation        string  `json:"sinceCreation"`
+	SinceCreationSeconds float64 `json:"sinceCreationSeconds"`
+}
+
+type PodTimeline struct {
+	PodName   string      `json:"podName"`
+	Namespace string      `json:"namespace"`
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// StartupDuration is the time from creation to the latest Ready=True
+	// transition; empty while the pod has never become ready.
+	StartupDuration        string  `json:"startupDuration,omitempty"`
+	StartupDurationSeconds float64 `json:"startupDurationSeconds,omitempty"`
+
+	ImagePullSeconds float64 `json:"imagePullSeconds"`
+
+	// LongestGap points at the entry that followed the largest gap, which is
+	// usually where startup time went.
+	LongestGap *TimelineEntry `json:"longestGap,omitempty"`
+
+	Entries []TimelineEntry `json:"entries"`
+}
@@ -0,0 +1,92 @@
+// Package pagination pages through sorted report lists using opaque
+// continue tokens.
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const tokenPrefix = "v2:"
+
+// ErrInvalidContinue is returned for continue tokens that were not issued by
+// Page.
+var ErrInvalidContinue = errors.New("invalid continue token")
+
+// Cursor is where a listing resumes. Snapshot is when the listing started
+// and is carried from page to page, so keys derived from times that keep
+// moving, such as an issue's LastSeen, order every page the same way.
+type Cursor struct {
+	Snapshot time.Time
+	after    string
+}
+
+// Resume decodes a continue token. An empty token starts a new listing
+// whose snapshot is now.
+func Resume(token string, now time.Time) (Cursor, error) {
+	if token == "" {
+		return Cursor{Snapshot: now.Truncate(time.Second)}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), tokenPrefix) {
+		return Cursor{}, ErrInvalidContinue
+	}
+	snapshot, after, found := strings.Cut(strings.TrimPrefix(string(raw), tokenPrefix), ":")
+	seconds, err := strconv.ParseInt(snapshot, 10, 64)
+	if !found || err != nil {
+		return Cursor{}, ErrInvalidContinue
+	}
+	return Cursor{Snapshot: time.Unix(seconds, 0), after: after}, nil
+}
+
+// Page returns up to limit items whose key sorts after the cursor, and the
+// token for the next page, which is empty on the last page. Items must be
+// sorted ascending by key and keys must be unique. Resuming after a key
+// rather than at an offset keeps pages consistent when earlier items
+// disappear between requests. A limit of zero returns every remaining item.
+func Page[T any](items []T, key func(T) string, limit int, cursor Cursor) ([]T, string) {
+	start := 0
+	if cursor.after != "" {
+		start = sort.Search(len(items), func(i int) bool {
+			return key(items[i]) > cursor.after
+		})
+	}
+
+	remaining := items[start:]
+	if limit <= 0 || len(remaining) <= limit {
+		return remaining, ""
+	}
+
+	page := remaining[:limit]
+	return page, encode(cursor.Snapshot, key(page[len(page)-1]))
+}
+
+// SortByKey sorts items ascending by key, the order Page expects.
+func SortByKey[T any](items []T, key func(T) string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(items[i]) < key(items[j])
+	})
+}
+
+// RecencyKey returns a key that sorts the newest times first, to the second,
+// followed by parts to order items seen at the same time. Times after the
+// snapshot count as the snapshot: an item that is still being observed keeps
+// its place, and one that recurs while a client pages moves to the front
+// rather than being listed twice.
+func RecencyKey(at, snapshot time.Time, parts ...string) string {
+	age := snapshot.Unix() - at.Unix()
+	if age < 0 {
+		age = 0
+	}
+	return fmt.Sprintf("%019d/%s", age, strings.Join(parts, "/"))
+}
+
+func encode(snapshot time.Time, key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s%d:%s", tokenPrefix, snapshot.Unix(), key)))
+}
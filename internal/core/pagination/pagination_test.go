@@ -0,0 +1,71 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func identity(s string) string { return s }
+
+func TestPage(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cursor, err := Resume("", now)
+	require.NoError(t, err)
+	page, next := Page(items, identity, 2, cursor)
+	assert.Equal(t, []string{"a", "b"}, page)
+	require.NotEmpty(t, next)
+
+	cursor, err = Resume(next, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, now, cursor.Snapshot.UTC(), "the snapshot is carried to later pages")
+	page, next = Page(items, identity, 2, cursor)
+	assert.Equal(t, []string{"c", "d"}, page)
+
+	cursor, err = Resume(next, now)
+	require.NoError(t, err)
+	page, last := Page(items, identity, 2, cursor)
+	assert.Equal(t, []string{"e"}, page)
+	assert.Empty(t, last)
+
+	t.Run("resumes after removed item", func(t *testing.T) {
+		page, _ := Page([]string{"a", "c", "e"}, identity, 2, cursor)
+		assert.Equal(t, []string{"e"}, page)
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		page, next := Page(items, identity, 0, Cursor{Snapshot: now})
+		assert.Equal(t, items, page)
+		assert.Empty(t, next)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		for _, token := range []string{"not-a-token", "djE6YQ"} {
+			_, err := Resume(token, now)
+			assert.ErrorIs(t, err, ErrInvalidContinue, token)
+		}
+	})
+}
+
+func TestRecencyKey(t *testing.T) {
+	snapshot := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	keys := []string{
+		RecencyKey(snapshot.Add(-time.Hour), snapshot, "default", "web-1"),
+		RecencyKey(snapshot.Add(time.Minute), snapshot, "default", "web-2"),
+		RecencyKey(snapshot, snapshot, "default", "web-1"),
+		RecencyKey(snapshot.Add(-time.Second), snapshot, "default", "web-3"),
+	}
+	SortByKey(keys, identity)
+
+	assert.Equal(t, []string{
+		RecencyKey(snapshot, snapshot, "default", "web-1"),
+		RecencyKey(snapshot.Add(time.Minute), snapshot, "default", "web-2"),
+		RecencyKey(snapshot.Add(-time.Second), snapshot, "default", "web-3"),
+		RecencyKey(snapshot.Add(-time.Hour), snapshot, "default", "web-1"),
+	}, keys, "times after the snapshot rank as the snapshot, ties by the other parts")
+}
@@ -0,0 +1,274 @@
+package relatedevents
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const maxEventsPerObject = 20
+
+type relatedObject struct {
+	kind         string
+	name         string
+	namespace    string
+	relationship models.EventRelationship
+}
+
+// Collector gathers the events recorded against a pod and against the
+// objects it depends on: its owners, PVCs, ServiceAccount and node.
+type Collector struct {
+	k8sClient kubernetes.Interface
+	logger    *slog.Logger
+}
+
+func NewCollector(k8sClient kubernetes.Interface, logger *slog.Logger) *Collector {
+	return &Collector{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+// CollectForPod returns the pod's events followed by those of its related
+// objects, newest first. Only a failure to list the pod's own events is
+// returned as an error; related objects are best effort. Node events older
+// than the pod are dropped since they cannot explain its behaviour.
+func (c *Collector) CollectForPod(ctx context.Context, pod *v1.Pod) ([]models.EventInfo, error) {
+	podEvents, err := c.listEvents(ctx, relatedObject{
+		kind:         "Pod",
+		name:         pod.Name,
+		namespace:    pod.Namespace,
+		relationship: models.EventRelationshipPod,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	seen := make(map[string]bool)
+	events := make([]models.EventInfo, 0, len(podEvents))
+	collect := func(items []v1.Event, object relatedObject) {
+		for i := range items {
+			event := &items[i]
+			key := event.Namespace + "/" + event.Name
+			if seen[key] {
+				continue
+			}
+			if object.relationship == models.EventRelationshipNode {
+				timestamp := Timestamp(event)
+				if timestamp.Before(&pod.CreationTimestamp) {
+					continue
+				}
+			}
+			seen[key] = true
+			events = append(events, toEventInfo(event, object.relationship))
+		}
+	}
+
+	collect(podEvents, relatedObject{relationship: models.EventRelationshipPod})
+
+	for _, object := range relatedObjects(pod) {
+		items, err := c.listEvents(ctx, object)
+		if err != nil {
+			c.logger.Warn("failed to get events for related object",
+				"namespace", pod.Namespace,
+				"pod", pod.Name,
+				"kind", object.kind,
+				"name", object.name,
+				"error", err.Error())
+			continue
+		}
+		collect(items, object)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+
+	c.logger.Debug("collected related events",
+		"namespace", pod.Namespace,
+		"pod", pod.Name,
+		"events", len(events))
+
+	return events, nil
+}
+
+// CollectForNode returns the events recorded against a node across all
+// namespaces, newest first.
+func (c *Collector) CollectForNode(ctx context.Context, nodeName string) ([]models.EventInfo, error) {
+	items, err := c.listEvents(ctx, relatedObject{
+		kind:         "Node",
+		name:         nodeName,
+		relationship: models.EventRelationshipNode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for node %s: %w", nodeName, err)
+	}
+
+	events := make([]models.EventInfo, 0, len(items))
+	for i := range items {
+		events = append(events, toEventInfo(&items[i], models.EventRelationshipNode))
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+
+	return events, nil
+}
+
+// CollectPodEvents returns the events recorded against the pods of a
+// namespace, or of all namespaces when namespace is empty, keyed by
+// namespace/name and newest first. It uses a single list call and so,
+// unlike CollectForPod, leaves out the events of related objects.
+func (c *Collector) CollectPodEvents(ctx context.Context, namespace string) (map[string][]models.EventInfo, error) {
+	eventList, err := c.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.kind", "Pod").String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod events: %w", err)
+	}
+
+	eventsByPod := make(map[string][]models.EventInfo)
+	for i := range eventList.Items {
+		event := &eventList.Items[i]
+		if event.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		podNamespace := event.InvolvedObject.Namespace
+		if podNamespace == "" {
+			podNamespace = event.Namespace
+		}
+		key := podNamespace + "/" + event.InvolvedObject.Name
+		eventsByPod[key] = append(eventsByPod[key], toEventInfo(event, models.EventRelationshipPod))
+	}
+
+	for _, events := range eventsByPod {
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+		})
+	}
+
+	return eventsByPod, nil
+}
+
+// listEvents returns the newest events recorded against an object. The list
+// is not limited server-side because the API server returns events in
+// storage order, not by time.
+func (c *Collector) listEvents(ctx context.Context, object relatedObject) ([]v1.Event, error) {
+	selectors := []fields.Selector{
+		fields.OneTermEqualSelector("involvedObject.kind", object.kind),
+		fields.OneTermEqualSelector("involvedObject.name", object.name),
+	}
+	if object.namespace != "" {
+		selectors = append(selectors, fields.OneTermEqualSelector("involvedObject.namespace", object.namespace))
+	}
+
+	eventList, err := c.k8sClient.CoreV1().Events(object.namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(selectors...).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := eventList.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		ti, tj := Timestamp(&items[i]), Timestamp(&items[j])
+		return ti.After(tj.Time)
+	})
+	if len(items) > maxEventsPerObject {
+		items = items[:maxEventsPerObject]
+	}
+
+	return items, nil
+}
+
+func relatedObjects(pod *v1.Pod) []relatedObject {
+	objects := []relatedObject{}
+
+	for _, owner := range pod.OwnerReferences {
+		objects = append(objects, relatedObject{
+			kind:         owner.Kind,
+			name:         owner.Name,
+			namespace:    pod.Namespace,
+			relationship: models.EventRelationshipOwner,
+		})
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		claimName := ""
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.Ephemeral != nil:
+			claimName = fmt.Sprintf("%s-%s", pod.Name, volume.Name)
+		}
+		if claimName != "" {
+			objects = append(objects, relatedObject{
+				kind:         "PersistentVolumeClaim",
+				name:         claimName,
+				namespace:    pod.Namespace,
+				relationship: models.EventRelationshipPVC,
+			})
+		}
+	}
+
+	serviceAccount := pod.Spec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	objects = append(objects, relatedObject{
+		kind:         "ServiceAccount",
+		name:         serviceAccount,
+		namespace:    pod.Namespace,
+		relationship: models.EventRelationshipServiceAccount,
+	})
+
+	// Node events are recorded in whichever namespace the reporting
+	// component chose, so they are listed across all namespaces.
+	if pod.Spec.NodeName != "" {
+		objects = append(objects, relatedObject{
+			kind:         "Node",
+			name:         pod.Spec.NodeName,
+			relationship: models.EventRelationshipNode,
+		})
+	}
+
+	return objects
+}
+
+func toEventInfo(event *v1.Event, relationship models.EventRelationship) models.EventInfo {
+	return models.EventInfo{
+		Type:           event.Type,
+		Reason:         event.Reason,
+		Message:        event.Message,
+		FirstTimestamp: event.FirstTimestamp,
+		LastTimestamp:  Timestamp(event),
+		Count:          event.Count,
+		Source:         fmt.Sprintf("%s/%s", event.Source.Component, event.Source.Host),
+		InvolvedObject: fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+		Relationship:   relationship,
+	}
+}
+
+// Timestamp returns the most recent time an event was observed, falling back
+// through the fields populated by the different event recorders.
+func Timestamp(event *v1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp
+	default:
+		return event.CreationTimestamp
+	}
+}
@@ -0,0 +1,189 @@
+package relatedevents
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newEvent(namespace, name, kind, objectName, reason string, at time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      kind,
+			Name:      objectName,
+			Namespace: namespace,
+		},
+		Type:           v1.EventTypeWarning,
+		Reason:         reason,
+		FirstTimestamp: metav1.NewTime(at),
+		LastTimestamp:  metav1.NewTime(at),
+		Count:          1,
+	}
+}
+
+// newFieldSelectorClient returns a fake clientset whose event lists honour
+// involvedObject field selectors, which the default fake tracker ignores.
+func newFieldSelectorClient(objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewSimpleClientset(objects...)
+	client.PrependReactor("list", "events", func(action ktesting.Action) (bool, runtime.Object, error) {
+		listAction := action.(ktesting.ListActionImpl)
+		selector := listAction.GetListRestrictions().Fields
+
+		all, err := client.Tracker().List(v1.SchemeGroupVersion.WithResource("events"),
+			v1.SchemeGroupVersion.WithKind("Event"), listAction.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+
+		filtered := &v1.EventList{}
+		for _, event := range all.(*v1.EventList).Items {
+			set := fields.Set{
+				"involvedObject.kind":      event.InvolvedObject.Kind,
+				"involvedObject.name":      event.InvolvedObject.Name,
+				"involvedObject.namespace": event.InvolvedObject.Namespace,
+			}
+			if selector.Matches(set) {
+				filtered.Items = append(filtered.Items, event)
+			}
+		}
+		return true, filtered, nil
+	})
+	return client
+}
+
+func TestCollector_CollectForPod(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	isController := true
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web-abc",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "ReplicaSet",
+				Name:       "web-7d9f",
+				Controller: &isController,
+			}},
+		},
+		Spec: v1.PodSpec{
+			NodeName:           "node-a",
+			ServiceAccountName: "web",
+			Volumes: []v1.Volume{{
+				Name: "data",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "web-data"},
+				},
+			}},
+		},
+	}
+
+	client := newFieldSelectorClient(
+		newEvent("default", "pod-backoff", "Pod", "web-abc", "BackOff", created.Add(50*time.Minute)),
+		newEvent("default", "rs-failed-create", "ReplicaSet", "web-7d9f", "FailedCreate", created.Add(10*time.Minute)),
+		newEvent("default", "pvc-binding", "PersistentVolumeClaim", "web-data", "FailedBinding", created.Add(5*time.Minute)),
+		newEvent("default", "sa-event", "ServiceAccount", "web", "TokenInvalid", created.Add(20*time.Minute)),
+		newEvent("default", "node-rebooted", "Node", "node-a", "Rebooted", created.Add(30*time.Minute)),
+		newEvent("default", "node-old", "Node", "node-a", "Rebooted", created.Add(-time.Hour)),
+		newEvent("default", "other-pod", "Pod", "other", "BackOff", created.Add(40*time.Minute)),
+		newEvent("default", "other-node", "Node", "node-b", "NodeNotReady", created.Add(40*time.Minute)),
+	)
+
+	collector := NewCollector(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	events, err := collector.CollectForPod(context.Background(), pod)
+	require.NoError(t, err)
+
+	got := make([]string, 0, len(events))
+	for _, event := range events {
+		got = append(got, string(event.Relationship)+":"+event.InvolvedObject+":"+event.Reason)
+	}
+
+	assert.Equal(t, []string{
+		"Pod:Pod/web-abc:BackOff",
+		"Node:Node/node-a:Rebooted",
+		"ServiceAccount:ServiceAccount/web:TokenInvalid",
+		"Owner:ReplicaSet/web-7d9f:FailedCreate",
+		"PersistentVolumeClaim:PersistentVolumeClaim/web-data:FailedBinding",
+	}, got)
+}
+
+func TestCollector_CollectForPod_DeduplicatesEvents(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default"},
+	}
+
+	// The default fake ignores field selectors, so every query returns the
+	// same event; it must only be reported once.
+	client := fake.NewSimpleClientset(
+		newEvent("default", "pod-backoff", "Pod", "web-abc", "BackOff", time.Now()),
+	)
+
+	collector := NewCollector(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	events, err := collector.CollectForPod(context.Background(), pod)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.EventRelationshipPod, events[0].Relationship)
+}
+
+func TestCollector_CollectForPod_KeepsNewestEvents(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default"},
+	}
+
+	now := time.Now()
+	objects := []runtime.Object{}
+	for i := 0; i < maxEventsPerObject+5; i++ {
+		at := now.Add(-time.Duration(i+1) * time.Minute)
+		objects = append(objects, newEvent("default", fmt.Sprintf("web-abc-%02d", i), "Pod", "web-abc", "BackOff", at))
+	}
+	// Recorded through events.k8s.io, which only sets EventTime.
+	newest := newEvent("default", "web-abc-zz", "Pod", "web-abc", "OOMKilling", now)
+	newest.FirstTimestamp, newest.LastTimestamp = metav1.Time{}, metav1.Time{}
+	newest.EventTime = metav1.NewMicroTime(now)
+	objects = append(objects, newest)
+
+	collector := NewCollector(newFieldSelectorClient(objects...), slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	events, err := collector.CollectForPod(context.Background(), pod)
+	require.NoError(t, err)
+	require.Len(t, events, maxEventsPerObject)
+	assert.Equal(t, "OOMKilling", events[0].Reason)
+	assert.True(t, events[0].LastTimestamp.Equal(&metav1.Time{Time: newest.EventTime.Time}))
+	// The oldest events are the ones dropped.
+	assert.True(t, events[maxEventsPerObject-1].LastTimestamp.After(now.Add(-time.Duration(maxEventsPerObject)*time.Minute)))
+}
+
+func TestCollector_CollectForNode(t *testing.T) {
+	now := time.Now()
+	client := newFieldSelectorClient(
+		newEvent("default", "node-a-rebooted", "Node", "node-a", "Rebooted", now.Add(-time.Hour)),
+		newEvent("kube-system", "node-a-pressure", "Node", "node-a", "EvictionThresholdMet", now.Add(-time.Minute)),
+		newEvent("default", "node-b-rebooted", "Node", "node-b", "Rebooted", now),
+	)
+
+	collector := NewCollector(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	events, err := collector.CollectForNode(context.Background(), "node-a")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "EvictionThresholdMet", events[0].Reason)
+	assert.Equal(t, "Rebooted", events[1].Reason)
+	assert.Equal(t, models.EventRelationshipNode, events[1].Relationship)
+}
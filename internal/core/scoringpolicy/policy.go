@@ -0,0 +1,549 @@
+// Package scoringpolicy loads the model used to grade pod health: component
+// weights, the score of each problem, the status cut-offs, and overrides for
+// namespaces or pods that should be graded differently.
+package scoringpolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// DefaultVersion is the version of the built-in policy.
+const DefaultVersion = "builtin-v2"
+
+// Policy is one complete scoring model. Files use the same layout in YAML
+// or JSON.
+type Policy struct {
+	Version    string            `json:"version"`
+	Statuses   []StatusThreshold `json:"statuses"`
+	Components Components        `json:"components"`
+	Overrides  []Override        `json:"overrides,omitempty"`
+
+	override string
+}
+
+// StatusThreshold labels overall scores of at least MinScore. Thresholds are
+// listed from the highest to the lowest, which must be 0.
+type StatusThreshold struct {
+	Status   string `json:"status"`
+	MinScore int    `json:"minScore"`
+}
+
+// Components configures each health component. A component with weight 0
+// does not count towards the overall score.
+type Components struct {
+	Restarts        RestartScoring        `json:"restarts"`
+	ContainerStates ContainerStateScoring `json:"containerStates"`
+	Events          EventScoring          `json:"events"`
+	Conditions      ConditionScoring      `json:"conditions"`
+	Uptime          UptimeScoring         `json:"uptime"`
+	Resources       ResourceScoring       `json:"resources"`
+}
+
+// Tier maps values up to Max to Score.
+type Tier struct {
+	Max   float64 `json:"max"`
+	Score int     `json:"score"`
+}
+
+// RestartScoring grades the total restarts of a pod. Tier maximums are
+// multiples of the namespace restart threshold, so at a threshold of 5 a tier
+// with max 0.4 covers up to 2 restarts. More restarts than the last tier
+// score ExceededScore. Pods restarting more often than RapidRestartsPerHour
+// have their score multiplied by RapidRestartFactor, but not below
+// ExceededScore.
+type RestartScoring struct {
+	Weight               float64 `json:"weight"`
+	Tiers                []Tier  `json:"tiers"`
+	ExceededScore        int     `json:"exceededScore"`
+	RapidRestartsPerHour float64 `json:"rapidRestartsPerHour"`
+	RapidRestartFactor   float64 `json:"rapidRestartFactor"`
+}
+
+// ContainerStateScoring scores waiting containers by their reason, other
+// waiting reasons by WaitingScore and containers terminated with a non-zero
+// exit code by FailedScore. The worst container sets the score.
+type ContainerStateScoring struct {
+	Weight         float64        `json:"weight"`
+	WaitingReasons map[string]int `json:"waitingReasons"`
+	WaitingScore   int            `json:"waitingScore"`
+	FailedScore    int            `json:"failedScore"`
+}
+
+// EventScoring scores Warning events seen within Window by their reason and
+// other Warning events by WarningScore.
+type EventScoring struct {
+	Weight       float64        `json:"weight"`
+	Window       string         `json:"window"`
+	Reasons      map[string]int `json:"reasons"`
+	WarningScore int            `json:"warningScore"`
+
+	window time.Duration
+}
+
+// ConditionScoring scores pod conditions that are not True by their type.
+// Conditions not listed are not scored.
+type ConditionScoring struct {
+	Weight     float64        `json:"weight"`
+	Conditions map[string]int `json:"conditions"`
+}
+
+// UptimeScoring grades the ratio of container uptime to pod age. The first
+// tier whose max is above the ratio gives the score; higher ratios score 100.
+type UptimeScoring struct {
+	Weight float64 `json:"weight"`
+	Tiers  []Tier  `json:"tiers"`
+}
+
+// ResourceScoring grades resource pressure. Memory tier maximums are the
+// fraction of its memory limit the fullest container uses, and throttling
+// tier maximums the fraction of CPU periods in which a container was
+// throttled between the last two polls of its node; higher values score the
+// matching exceeded score. A container OOMKilled within OOMWindow scores at
+// most OOMKilledScore. A resources score below CapBelowScore caps the overall
+// score at OverallScoreCap, so a pod about to run out of memory is not
+// reported healthy however well it does otherwise. With weight 0 the
+// component is reported but neither counts nor caps.
+type ResourceScoring struct {
+	Weight                  float64 `json:"weight"`
+	MemoryTiers             []Tier  `json:"memoryTiers"`
+	MemoryExceededScore     int     `json:"memoryExceededScore"`
+	ThrottlingTiers         []Tier  `json:"throttlingTiers"`
+	ThrottlingExceededScore int     `json:"throttlingExceededScore"`
+	OOMKilledScore          int     `json:"oomKilledScore"`
+	OOMWindow               string  `json:"oomWindow,omitempty"`
+	CapBelowScore           int     `json:"capBelowScore"`
+	OverallScoreCap         int     `json:"overallScoreCap"`
+
+	oomWindow time.Duration
+}
+
+// OOMWindowDuration is how far back OOMKilled containers are scored.
+func (r ResourceScoring) OOMWindowDuration() time.Duration {
+	return r.oomWindow
+}
+
+// Override regrades the pods it selects. Pods match when they are in one of
+// Namespaces and match PodSelector; either may be omitted but not both. The
+// first matching override applies. Statuses and Components are merged field
+// by field over the base policy; lists replace the base list and maps add to
+// or replace base entries.
+type Override struct {
+	Name        string          `json:"name"`
+	Namespaces  []string        `json:"namespaces,omitempty"`
+	PodSelector string          `json:"podSelector,omitempty"`
+	Statuses    json.RawMessage `json:"statuses,omitempty"`
+	Components  json.RawMessage `json:"components,omitempty"`
+}
+
+// Default returns the built-in policy.
+func Default() *Policy {
+	return &Policy{
+		Version: DefaultVersion,
+		Statuses: []StatusThreshold{
+			{Status: "Healthy", MinScore: 90},
+			{Status: "Good", MinScore: 70},
+			{Status: "Warning", MinScore: 50},
+			{Status: "Degraded", MinScore: 30},
+			{Status: "Critical", MinScore: 0},
+		},
+		Components: Components{
+			Restarts: RestartScoring{
+				Weight:               0.25,
+				Tiers:                []Tier{{Max: 0.4, Score: 85}, {Max: 1, Score: 70}, {Max: 2, Score: 50}, {Max: 4, Score: 30}},
+				ExceededScore:        10,
+				RapidRestartsPerHour: 1,
+				RapidRestartFactor:   0.5,
+			},
+			ContainerStates: ContainerStateScoring{
+				Weight: 0.20,
+				WaitingReasons: map[string]int{
+					"CrashLoopBackOff": 20,
+					"Error":            20,
+					"ImagePullBackOff": 30,
+					"ErrImagePull":     30,
+				},
+				WaitingScore: 50,
+				FailedScore:  40,
+			},
+			Events: EventScoring{
+				Weight: 0.15,
+				Window: "24h",
+				Reasons: map[string]int{
+					"Failed":           30,
+					"FailedScheduling": 30,
+					"FailedMount":      30,
+					"FailedBinding":    30,
+					"FailedCreate":     30,
+					"BackOff":          40,
+					"CrashLoopBackOff": 40,
+					"Rebooted":         40,
+					"Unhealthy":        50,
+				},
+				WarningScore: 70,
+				window:       24 * time.Hour,
+			},
+			Conditions: ConditionScoring{
+				Weight: 0.15,
+				Conditions: map[string]int{
+					string(corev1.PodReady):        50,
+					string(corev1.PodScheduled):    30,
+					string(corev1.ContainersReady): 60,
+					string(corev1.PodInitialized):  70,
+				},
+			},
+			Uptime: UptimeScoring{
+				Weight: 0.10,
+				Tiers:  []Tier{{Max: 0.5, Score: 50}, {Max: 0.8, Score: 70}, {Max: 0.95, Score: 85}},
+			},
+			Resources: ResourceScoring{
+				Weight:                  0.15,
+				MemoryTiers:             []Tier{{Max: 0.8, Score: 100}, {Max: 0.9, Score: 70}, {Max: 0.95, Score: 40}},
+				MemoryExceededScore:     10,
+				ThrottlingTiers:         []Tier{{Max: 0.1, Score: 100}, {Max: 0.25, Score: 70}, {Max: 0.5, Score: 50}},
+				ThrottlingExceededScore: 30,
+				OOMKilledScore:          40,
+				OOMWindow:               "24h",
+				CapBelowScore:           40,
+				OverallScoreCap:         69,
+				oomWindow:               24 * time.Hour,
+			},
+		},
+	}
+}
+
+// Status labels an overall score.
+func (p *Policy) Status(score int) string {
+	for _, threshold := range p.Statuses {
+		if score >= threshold.MinScore {
+			return threshold.Status
+		}
+	}
+	return p.Statuses[len(p.Statuses)-1].Status
+}
+
+// EventWindow is how far back events are scored.
+func (p *Policy) EventWindow() time.Duration {
+	return p.Components.Events.window
+}
+
+// Ref identifies the policy in responses.
+func (p *Policy) Ref() models.ScoringPolicyRef {
+	return models.ScoringPolicyRef{Version: p.Version, Override: p.override}
+}
+
+// Set is a validated base policy with its overrides resolved.
+type Set struct {
+	base      *Policy
+	overrides []override
+}
+
+type override struct {
+	namespaces []string
+	selector   labels.Selector
+	policy     *Policy
+}
+
+// Load reads and validates a policy file. Without a path the built-in policy
+// is used.
+func Load(path string) (*Set, error) {
+	if path == "" {
+		return NewSet(Default())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scoring policy: %w", err)
+	}
+
+	set, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scoring policy %s: %w", path, err)
+	}
+	return set, nil
+}
+
+// Parse decodes and validates a YAML or JSON policy. Unknown fields are
+// rejected so that typos do not silently fall back to zero values.
+func Parse(data []byte) (*Set, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, err
+	}
+	return NewSet(policy)
+}
+
+// NewSet validates the policy and resolves its overrides.
+func NewSet(base *Policy) (*Set, error) {
+	if err := base.validate(); err != nil {
+		return nil, err
+	}
+
+	set := &Set{base: base}
+	seen := make(map[string]bool, len(base.Overrides))
+	for i, o := range base.Overrides {
+		if o.Name == "" {
+			return nil, fmt.Errorf("overrides[%d]: name is required", i)
+		}
+		if seen[o.Name] {
+			return nil, fmt.Errorf("override %s: duplicate name", o.Name)
+		}
+		seen[o.Name] = true
+
+		resolved, err := resolveOverride(base, o)
+		if err != nil {
+			return nil, fmt.Errorf("override %s: %w", o.Name, err)
+		}
+		set.overrides = append(set.overrides, resolved)
+	}
+	return set, nil
+}
+
+func resolveOverride(base *Policy, o Override) (override, error) {
+	if len(o.Namespaces) == 0 && o.PodSelector == "" {
+		return override{}, fmt.Errorf("namespaces or podSelector is required")
+	}
+
+	selector := labels.Everything()
+	if o.PodSelector != "" {
+		parsed, err := labels.Parse(o.PodSelector)
+		if err != nil {
+			return override{}, fmt.Errorf("invalid podSelector: %w", err)
+		}
+		selector = parsed
+	}
+
+	policy, err := base.merge(o)
+	if err != nil {
+		return override{}, err
+	}
+	if err := policy.validate(); err != nil {
+		return override{}, err
+	}
+
+	return override{namespaces: o.Namespaces, selector: selector, policy: policy}, nil
+}
+
+// merge applies an override to a deep copy of the policy.
+func (p *Policy) merge(o Override) (*Policy, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	merged := &Policy{}
+	if err := json.Unmarshal(data, merged); err != nil {
+		return nil, err
+	}
+	merged.Overrides = nil
+	merged.override = o.Name
+
+	if len(o.Statuses) > 0 {
+		if err := decodeStrict(o.Statuses, &merged.Statuses); err != nil {
+			return nil, fmt.Errorf("invalid statuses: %w", err)
+		}
+	}
+	if len(o.Components) > 0 {
+		if err := decodeStrict(o.Components, &merged.Components); err != nil {
+			return nil, fmt.Errorf("invalid components: %w", err)
+		}
+	}
+	return merged, nil
+}
+
+func decodeStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// Version is the version of the loaded policy.
+func (s *Set) Version() string {
+	return s.base.Version
+}
+
+// Base returns the policy of pods no override selects.
+func (s *Set) Base() *Policy {
+	return s.base
+}
+
+// ForPod returns the policy that grades the pod.
+func (s *Set) ForPod(pod *corev1.Pod) *Policy {
+	for _, o := range s.overrides {
+		if (len(o.namespaces) == 0 || slices.Contains(o.namespaces, pod.Namespace)) &&
+			o.selector.Matches(labels.Set(pod.Labels)) {
+			return o.policy
+		}
+	}
+	return s.base
+}
+
+// ForNamespace returns the policy that labels namespace-wide scores: the
+// first override that selects the whole namespace, without a pod selector.
+func (s *Set) ForNamespace(namespace string) *Policy {
+	for _, o := range s.overrides {
+		if o.selector.Empty() && slices.Contains(o.namespaces, namespace) {
+			return o.policy
+		}
+	}
+	return s.base
+}
+
+func (p *Policy) validate() error {
+	if p.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+
+	if len(p.Statuses) == 0 {
+		return fmt.Errorf("statuses are required")
+	}
+	for i, threshold := range p.Statuses {
+		if threshold.Status == "" {
+			return fmt.Errorf("statuses[%d]: status is required", i)
+		}
+		if err := validateScore(threshold.MinScore); err != nil {
+			return fmt.Errorf("statuses[%d]: minScore %w", i, err)
+		}
+		if i > 0 && threshold.MinScore >= p.Statuses[i-1].MinScore {
+			return fmt.Errorf("statuses[%d]: minScore must be lower than the previous status", i)
+		}
+	}
+	if last := p.Statuses[len(p.Statuses)-1]; last.MinScore != 0 {
+		return fmt.Errorf("statuses: the last status must have minScore 0")
+	}
+
+	c := &p.Components
+	weights := []float64{c.Restarts.Weight, c.ContainerStates.Weight, c.Events.Weight, c.Conditions.Weight, c.Uptime.Weight, c.Resources.Weight}
+	total := 0.0
+	for _, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("components: weights must be >= 0")
+		}
+		total += weight
+	}
+	if total == 0 {
+		return fmt.Errorf("components: at least one weight must be > 0")
+	}
+
+	if err := validateTiers(c.Restarts.Tiers, 0); err != nil {
+		return fmt.Errorf("components.restarts.tiers: %w", err)
+	}
+	if err := validateScore(c.Restarts.ExceededScore); err != nil {
+		return fmt.Errorf("components.restarts.exceededScore %w", err)
+	}
+	if c.Restarts.RapidRestartsPerHour <= 0 {
+		return fmt.Errorf("components.restarts.rapidRestartsPerHour must be > 0")
+	}
+	if c.Restarts.RapidRestartFactor < 0 || c.Restarts.RapidRestartFactor > 1 {
+		return fmt.Errorf("components.restarts.rapidRestartFactor must be between 0 and 1")
+	}
+
+	if err := validateScores(c.ContainerStates.WaitingReasons); err != nil {
+		return fmt.Errorf("components.containerStates.waitingReasons: %w", err)
+	}
+	if err := validateScore(c.ContainerStates.WaitingScore); err != nil {
+		return fmt.Errorf("components.containerStates.waitingScore %w", err)
+	}
+	if err := validateScore(c.ContainerStates.FailedScore); err != nil {
+		return fmt.Errorf("components.containerStates.failedScore %w", err)
+	}
+
+	window, err := time.ParseDuration(c.Events.Window)
+	if err != nil || window <= 0 {
+		return fmt.Errorf("components.events.window: invalid duration %q (must be > 0)", c.Events.Window)
+	}
+	c.Events.window = window
+	if err := validateScores(c.Events.Reasons); err != nil {
+		return fmt.Errorf("components.events.reasons: %w", err)
+	}
+	if err := validateScore(c.Events.WarningScore); err != nil {
+		return fmt.Errorf("components.events.warningScore %w", err)
+	}
+
+	if err := validateScores(c.Conditions.Conditions); err != nil {
+		return fmt.Errorf("components.conditions.conditions: %w", err)
+	}
+
+	if err := validateTiers(c.Uptime.Tiers, 1); err != nil {
+		return fmt.Errorf("components.uptime.tiers: %w", err)
+	}
+
+	if err := c.Resources.validate(); err != nil {
+		return fmt.Errorf("components.resources.%w", err)
+	}
+
+	return nil
+}
+
+func (r *ResourceScoring) validate() error {
+	if err := validateTiers(r.MemoryTiers, 1); err != nil {
+		return fmt.Errorf("memoryTiers: %w", err)
+	}
+	if err := validateTiers(r.ThrottlingTiers, 1); err != nil {
+		return fmt.Errorf("throttlingTiers: %w", err)
+	}
+	for name, score := range map[string]int{
+		"memoryExceededScore":     r.MemoryExceededScore,
+		"throttlingExceededScore": r.ThrottlingExceededScore,
+		"oomKilledScore":          r.OOMKilledScore,
+		"capBelowScore":           r.CapBelowScore,
+		"overallScoreCap":         r.OverallScoreCap,
+	} {
+		if err := validateScore(score); err != nil {
+			return fmt.Errorf("%s %w", name, err)
+		}
+	}
+
+	// Policies that leave the component out need not set a window.
+	if r.OOMWindow == "" && r.Weight == 0 {
+		return nil
+	}
+	window, err := time.ParseDuration(r.OOMWindow)
+	if err != nil || window <= 0 {
+		return fmt.Errorf("oomWindow: invalid duration %q (must be > 0)", r.OOMWindow)
+	}
+	r.oomWindow = window
+	return nil
+}
+
+// validateTiers checks that tier maximums are positive, ascending and, when
+// limit is set, at most limit.
+func validateTiers(tiers []Tier, limit float64) error {
+	for i, tier := range tiers {
+		if tier.Max <= 0 || (limit > 0 && tier.Max > limit) {
+			return fmt.Errorf("[%d]: invalid max %v", i, tier.Max)
+		}
+		if i > 0 && tier.Max <= tiers[i-1].Max {
+			return fmt.Errorf("[%d]: max must be greater than the previous tier", i)
+		}
+		if err := validateScore(tier.Score); err != nil {
+			return fmt.Errorf("[%d]: score %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateScores(scores map[string]int) error {
+	for name, score := range scores {
+		if err := validateScore(score); err != nil {
+			return fmt.Errorf("%s: score %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateScore(score int) error {
+	if score < 0 || score > 100 {
+		return fmt.Errorf("must be between 0 and 100, got %d", score)
+	}
+	return nil
+}
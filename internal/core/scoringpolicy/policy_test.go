@@ -0,0 +1,252 @@
+package scoringpolicy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const batchPolicy = `
+version: "2024-06"
+statuses:
+  - {status: Healthy, minScore: 80}
+  - {status: Unhealthy, minScore: 0}
+components:
+  restarts:
+    weight: 0.3
+    tiers: [{max: 1, score: 80}, {max: 3, score: 40}]
+    exceededScore: 5
+    rapidRestartsPerHour: 2
+    rapidRestartFactor: 0.5
+  containerStates:
+    weight: 0.3
+    waitingReasons: {CrashLoopBackOff: 10}
+    waitingScore: 50
+    failedScore: 40
+  events:
+    weight: 0.2
+    window: 6h
+    reasons: {FailedMount: 20}
+    warningScore: 70
+  conditions:
+    weight: 0.2
+    conditions: {Ready: 50}
+  uptime:
+    weight: 0
+overrides:
+  - name: batch
+    podSelector: workload-type=batch
+    components:
+      restarts:
+        weight: 0.05
+      containerStates:
+        failedScore: 90
+  - name: sandbox
+    namespaces: [sandbox]
+    statuses:
+      - {status: Fine, minScore: 0}
+`
+
+func newPod(namespace string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: namespace, Labels: labels}}
+}
+
+func TestDefaultIsValid(t *testing.T) {
+	set, err := NewSet(Default())
+	require.NoError(t, err)
+
+	assert.Equal(t, DefaultVersion, set.Version())
+	assert.Equal(t, 24*time.Hour, set.Base().EventWindow())
+	assert.Equal(t, "Healthy", set.Base().Status(90))
+	assert.Equal(t, "Good", set.Base().Status(89))
+	assert.Equal(t, "Critical", set.Base().Status(0))
+}
+
+func TestDefaultRoundTrips(t *testing.T) {
+	data, err := yaml.Marshal(Default())
+	require.NoError(t, err)
+
+	set, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, Default().Components, set.Base().Components)
+}
+
+func TestParseOverrides(t *testing.T) {
+	set, err := Parse([]byte(batchPolicy))
+	require.NoError(t, err)
+
+	base := set.Base()
+	assert.Equal(t, "2024-06", base.Ref().Version)
+	assert.Empty(t, base.Ref().Override)
+	assert.Equal(t, 6*time.Hour, base.EventWindow())
+
+	batch := set.ForPod(newPod("jobs", map[string]string{"workload-type": "batch"}))
+	assert.Equal(t, "batch", batch.Ref().Override)
+	assert.Equal(t, 0.05, batch.Components.Restarts.Weight)
+	assert.Equal(t, 90, batch.Components.ContainerStates.FailedScore)
+	// Fields the override leaves out keep their base values.
+	assert.Equal(t, base.Components.Restarts.Tiers, batch.Components.Restarts.Tiers)
+	assert.Equal(t, map[string]int{"CrashLoopBackOff": 10}, batch.Components.ContainerStates.WaitingReasons)
+	assert.Equal(t, 6*time.Hour, batch.EventWindow())
+	assert.Equal(t, "Unhealthy", batch.Status(79))
+	// The base policy is not changed by merging.
+	assert.Equal(t, 0.3, base.Components.Restarts.Weight)
+
+	sandbox := set.ForPod(newPod("sandbox", nil))
+	assert.Equal(t, "sandbox", sandbox.Ref().Override)
+	assert.Equal(t, "Fine", sandbox.Status(0))
+
+	assert.Same(t, base, set.ForPod(newPod("default", nil)))
+	assert.Same(t, sandbox, set.ForNamespace("sandbox"))
+	// Overrides with a pod selector do not label whole namespaces.
+	assert.Same(t, base, set.ForNamespace("jobs"))
+}
+
+func TestParseJSON(t *testing.T) {
+	data, err := json.Marshal(Default())
+	require.NoError(t, err)
+
+	set, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultVersion, set.Version())
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *Policy)
+		raw    string
+		err    string
+	}{
+		{
+			name: "unknown field",
+			raw:  "version: v1\nweights: {}\n",
+			err:  "unknown field",
+		},
+		{
+			name:   "missing version",
+			modify: func(p *Policy) { p.Version = "" },
+			err:    "version is required",
+		},
+		{
+			name: "statuses not descending",
+			modify: func(p *Policy) {
+				p.Statuses = []StatusThreshold{{Status: "A", MinScore: 50}, {Status: "B", MinScore: 60}, {Status: "C", MinScore: 0}}
+			},
+			err: "statuses[1]",
+		},
+		{
+			name:   "last status above zero",
+			modify: func(p *Policy) { p.Statuses = []StatusThreshold{{Status: "A", MinScore: 50}} },
+			err:    "minScore 0",
+		},
+		{
+			name:   "negative weight",
+			modify: func(p *Policy) { p.Components.Uptime.Weight = -1 },
+			err:    "weights must be >= 0",
+		},
+		{
+			name: "all weights zero",
+			modify: func(p *Policy) {
+				p.Components = Components{Events: EventScoring{Window: "1h"}, Restarts: RestartScoring{RapidRestartsPerHour: 1}}
+			},
+			err: "at least one weight",
+		},
+		{
+			name:   "score out of range",
+			modify: func(p *Policy) { p.Components.Events.Reasons["Failed"] = 120 },
+			err:    "components.events.reasons",
+		},
+		{
+			name:   "tiers not ascending",
+			modify: func(p *Policy) { p.Components.Uptime.Tiers = []Tier{{Max: 0.8, Score: 70}, {Max: 0.5, Score: 50}} },
+			err:    "components.uptime.tiers",
+		},
+		{
+			name:   "invalid window",
+			modify: func(p *Policy) { p.Components.Events.Window = "soon" },
+			err:    "components.events.window",
+		},
+		{
+			name:   "resources without oom window",
+			modify: func(p *Policy) { p.Components.Resources.OOMWindow = "" },
+			err:    "components.resources.oomWindow",
+		},
+		{
+			name:   "resources overall cap out of range",
+			modify: func(p *Policy) { p.Components.Resources.OverallScoreCap = 101 },
+			err:    "overallScoreCap",
+		},
+		{
+			name:   "override without target",
+			modify: func(p *Policy) { p.Overrides = []Override{{Name: "x"}} },
+			err:    "namespaces or podSelector",
+		},
+		{
+			name:   "override with invalid selector",
+			modify: func(p *Policy) { p.Overrides = []Override{{Name: "x", PodSelector: "a in (b"}} },
+			err:    "invalid podSelector",
+		},
+		{
+			name: "override with invalid weight",
+			modify: func(p *Policy) {
+				p.Overrides = []Override{{Name: "x", Namespaces: []string{"a"}, Components: json.RawMessage(`{"uptime":{"weight":-1}}`)}}
+			},
+			err: "override x",
+		},
+		{
+			name: "override with unknown field",
+			modify: func(p *Policy) {
+				p.Overrides = []Override{{Name: "x", Namespaces: []string{"a"}, Components: json.RawMessage(`{"uptim":{}}`)}}
+			},
+			err: "unknown field",
+		},
+		{
+			name: "duplicate override",
+			modify: func(p *Policy) {
+				p.Overrides = []Override{{Name: "x", Namespaces: []string{"a"}}, {Name: "x", Namespaces: []string{"b"}}}
+			},
+			err: "duplicate name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.raw)
+			if tt.modify != nil {
+				policy := Default()
+				tt.modify(policy)
+				var err error
+				data, err = yaml.Marshal(policy)
+				require.NoError(t, err)
+			}
+
+			_, err := Parse(data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	set, err := Load("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultVersion, set.Version())
+
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(batchPolicy), 0o600))
+	set, err = Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-06", set.Version())
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
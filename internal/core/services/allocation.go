@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+type allocationService struct {
+	k8sClient     kubernetes.Interface
+	metricsClient metricsclientset.Interface
+	pricing       models.AllocationPricing
+	gpuResources  []v1.ResourceName
+	logger        *slog.Logger
+}
+
+func NewAllocationService(k8sClient kubernetes.Interface, metricsClient metricsclientset.Interface, cfg *config.Config, logger *slog.Logger) core.AllocationService {
+	pricing := models.AllocationPricing{
+		Default:   resourcePricing(cfg.CostPrices),
+		NodePools: make(map[string]models.ResourcePricing, len(cfg.CostNodePoolPrices)),
+	}
+	for pool, prices := range cfg.CostNodePoolPrices {
+		pricing.NodePools[pool] = resourcePricing(prices)
+	}
+
+	gpuResources := make([]v1.ResourceName, 0, len(cfg.CostGPUResources))
+	for _, name := range cfg.CostGPUResources {
+		gpuResources = append(gpuResources, v1.ResourceName(name))
+	}
+
+	return &allocationService{
+		k8sClient:     k8sClient,
+		metricsClient: metricsClient,
+		pricing:       pricing,
+		gpuResources:  gpuResources,
+		logger:        logger,
+	}
+}
+
+func resourcePricing(prices config.ResourcePrices) models.ResourcePricing {
+	return models.ResourcePricing{
+		CPUCoreHour:   prices.CPUCoreHour,
+		MemoryGiBHour: prices.MemoryGiBHour,
+		GPUHour:       prices.GPUHour,
+	}
+}
+
+// GetAllocation sums the requests, limits and usage of the running pods per
+// group and estimates their cost with the prices of the node pool each pod
+// runs in.
+func (s *allocationService) GetAllocation(ctx context.Context, query models.AllocationQuery) (*models.AllocationReport, error) {
+	s.logger.Debug("calculating resource allocation",
+		"namespace", query.Namespace,
+		"groupBy", query.GroupBy)
+
+	podList, err := s.k8sClient.CoreV1().Pods(query.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	report := &models.AllocationReport{
+		Namespace:    query.Namespace,
+		GroupBy:      query.GroupBy,
+		Pricing:      s.pricing,
+		Groups:       []models.AllocationGroup{},
+		Total:        models.AllocationGroup{Name: "total"},
+		CalculatedAt: time.Now(),
+	}
+
+	nodePools := s.nodePools(ctx)
+	usageByPod, usageAvailable := s.listPodUsage(ctx, query.Namespace)
+	report.UsageAvailable = usageAvailable
+	if usageAvailable {
+		report.Total.Usage = &models.AllocationResources{}
+		report.Total.Idle = &models.AllocationResources{}
+	}
+
+	groups := make(map[string]*models.AllocationGroup)
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		// Only scheduled, running pods hold node resources.
+		if pod.Spec.NodeName == "" || isPodTerminated(pod) {
+			continue
+		}
+
+		name := allocationGroupName(pod, query.GroupBy)
+		group, ok := groups[name]
+		if !ok {
+			group = &models.AllocationGroup{Name: name}
+			if usageAvailable {
+				group.Usage = &models.AllocationResources{}
+				group.Idle = &models.AllocationResources{}
+			}
+			groups[name] = group
+		}
+
+		prices := s.pricing.Default
+		if poolPrices, ok := s.pricing.NodePools[nodePools[pod.Spec.NodeName]]; ok {
+			prices = poolPrices
+		}
+
+		var usage v1.ResourceList
+		if usageAvailable {
+			usage = usageByPod[pod.Namespace+"/"+pod.Name]
+		}
+		for _, target := range []*models.AllocationGroup{group, &report.Total} {
+			s.addPodAllocation(target, pod, usage, usageAvailable, prices)
+		}
+	}
+
+	for _, group := range groups {
+		report.Groups = append(report.Groups, roundAllocationGroup(*group))
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Cost.Monthly != report.Groups[j].Cost.Monthly {
+			return report.Groups[i].Cost.Monthly > report.Groups[j].Cost.Monthly
+		}
+		return report.Groups[i].Name < report.Groups[j].Name
+	})
+	report.Total = roundAllocationGroup(report.Total)
+
+	s.logger.Info("resource allocation calculated",
+		"namespace", query.Namespace,
+		"groups", len(report.Groups),
+		"pods", report.Total.Pods,
+		"monthlyCost", report.Total.Cost.Monthly,
+		"usageAvailable", usageAvailable)
+
+	return report, nil
+}
+
+// nodePools maps node names to their pool. On failure every pod is priced
+// with the default prices.
+func (s *allocationService) nodePools(ctx context.Context) map[string]string {
+	pools := make(map[string]string)
+
+	nodes, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		s.logger.Warn("failed to list nodes for node pool pricing, using default prices",
+			"error", err.Error())
+		return pools
+	}
+
+	for _, node := range nodes.Items {
+		pools[node.Name] = firstLabel(node.Labels, poolLabels...)
+	}
+	return pools
+}
+
+// listPodUsage returns the summed container usage of each pod keyed by
+// namespace/name, using one list call. The second result is false when
+// metrics-server cannot be reached.
+func (s *allocationService) listPodUsage(ctx context.Context, namespace string) (map[string]v1.ResourceList, bool) {
+	if s.metricsClient == nil {
+		return nil, false
+	}
+
+	metricsList, err := s.metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		s.logger.Warn("failed to list pod metrics, reporting allocation without usage",
+			"namespace", namespace,
+			"error", err.Error())
+		return nil, false
+	}
+
+	usageByPod := make(map[string]v1.ResourceList, len(metricsList.Items))
+	for _, podMetrics := range metricsList.Items {
+		usage := v1.ResourceList{}
+		for _, container := range podMetrics.Containers {
+			addResourceList(usage, container.Usage)
+		}
+		usageByPod[podMetrics.Namespace+"/"+podMetrics.Name] = usage
+	}
+	return usageByPod, true
+}
+
+func allocationGroupName(pod *v1.Pod, groupBy string) string {
+	labelKey, ok := strings.CutPrefix(groupBy, models.AllocationGroupByLabelPrefix)
+	if !ok {
+		return pod.Namespace
+	}
+	if value := pod.Labels[labelKey]; value != "" {
+		return value
+	}
+	return models.AllocationUnlabeled
+}
+
+// addPodAllocation adds one pod's resources and cost to the group. A pod
+// without metrics counts as using nothing.
+func (s *allocationService) addPodAllocation(group *models.AllocationGroup, pod *v1.Pod, usage v1.ResourceList, usageAvailable bool, prices models.ResourcePricing) {
+	requests := s.allocationResources(podRequests(pod))
+	limits := s.allocationResources(podLimits(pod))
+
+	group.Pods++
+	addAllocationResources(&group.Requests, requests)
+	addAllocationResources(&group.Limits, limits)
+
+	billed := requests
+	if usageAvailable {
+		used := s.allocationResources(usage)
+		idle := models.AllocationResources{
+			CPUCores:  math.Max(requests.CPUCores-used.CPUCores, 0),
+			MemoryGiB: math.Max(requests.MemoryGiB-used.MemoryGiB, 0),
+		}
+		addAllocationResources(group.Usage, used)
+		addAllocationResources(group.Idle, idle)
+
+		billed.CPUCores = math.Max(requests.CPUCores, used.CPUCores)
+		billed.MemoryGiB = math.Max(requests.MemoryGiB, used.MemoryGiB)
+
+		idleHourly := hourlyCost(idle, prices)
+		group.Cost.IdleHourly += idleHourly
+		group.Cost.IdleMonthly += idleHourly * models.HoursPerMonth
+	}
+
+	hourly := hourlyCost(billed, prices)
+	group.Cost.Hourly += hourly
+	group.Cost.Monthly += hourly * models.HoursPerMonth
+}
+
+// allocationResources converts a resource list, counting every configured
+// GPU resource as GPUs.
+func (s *allocationService) allocationResources(list v1.ResourceList) models.AllocationResources {
+	var gpus int64
+	for _, name := range s.gpuResources {
+		quantity := list[name]
+		gpus += quantity.Value()
+	}
+	return models.AllocationResources{
+		CPUCores:  float64(list.Cpu().MilliValue()) / 1000,
+		MemoryGiB: float64(list.Memory().Value()) / (1 << 30),
+		GPUs:      float64(gpus),
+	}
+}
+
+func addAllocationResources(total *models.AllocationResources, add models.AllocationResources) {
+	total.CPUCores += add.CPUCores
+	total.MemoryGiB += add.MemoryGiB
+	total.GPUs += add.GPUs
+}
+
+func hourlyCost(r models.AllocationResources, prices models.ResourcePricing) float64 {
+	return r.CPUCores*prices.CPUCoreHour + r.MemoryGiB*prices.MemoryGiBHour + r.GPUs*prices.GPUHour
+}
+
+// roundAllocationGroup rounds resources to three decimal places, hourly cost
+// to four and monthly cost to cents. It runs once all pods are summed so
+// rounding errors do not accumulate.
+func roundAllocationGroup(group models.AllocationGroup) models.AllocationGroup {
+	group.Requests = roundAllocationResources(group.Requests)
+	group.Limits = roundAllocationResources(group.Limits)
+	if group.Usage != nil {
+		usage := roundAllocationResources(*group.Usage)
+		group.Usage = &usage
+	}
+	if group.Idle != nil {
+		idle := roundAllocationResources(*group.Idle)
+		group.Idle = &idle
+	}
+	group.Cost = models.AllocationCost{
+		Hourly:      roundTo(group.Cost.Hourly, 4),
+		Monthly:     roundTo(group.Cost.Monthly, 2),
+		IdleHourly:  roundTo(group.Cost.IdleHourly, 4),
+		IdleMonthly: roundTo(group.Cost.IdleMonthly, 2),
+	}
+	return group
+}
+
+func roundAllocationResources(r models.AllocationResources) models.AllocationResources {
+	return models.AllocationResources{
+		CPUCores:  roundTo(r.CPUCores, 3),
+		MemoryGiB: roundTo(r.MemoryGiB, 3),
+		GPUs:      r.GPUs,
+	}
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
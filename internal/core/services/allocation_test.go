@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newPoolNode(name, pool string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{"cloud.google.com/gke-nodepool": pool},
+	}}
+}
+
+func withTeam(pod *v1.Pod, team string) *v1.Pod {
+	pod.Labels = map[string]string{"team": team}
+	return pod
+}
+
+func newAllocationTestService(t *testing.T, metricsErr error) *allocationService {
+	t.Helper()
+
+	gpuPod := newNodeTestPod("team-a", "trainer", "node-a", "2", "4Gi")
+	gpuPod.Spec.Containers[0].Resources.Requests["amd.com/gpu"] = resource.MustParse("1")
+
+	donePod := newNodeTestPod("team-b", "done", "node-b", "4", "4Gi")
+	donePod.Status.Phase = v1.PodSucceeded
+
+	k8sClient := fake.NewSimpleClientset(
+		newPoolNode("node-a", "default-pool"),
+		newPoolNode("node-b", "spot"),
+		withTeam(newNodeTestPod("team-a", "web", "node-a", "1", "2Gi"), "payments"),
+		withTeam(gpuPod, "ml"),
+		newNodeTestPod("team-b", "batch", "node-b", "2", "1Gi"),
+		donePod,
+		newNodeTestPod("team-b", "unscheduled", "", "8", "8Gi"),
+	)
+
+	metricsClient := metricsfake.NewSimpleClientset()
+	metricsClient.PrependReactor("list", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+		if metricsErr != nil {
+			return true, nil, metricsErr
+		}
+		return true, &metricsv1beta1.PodMetricsList{Items: []metricsv1beta1.PodMetrics{
+			newTestPodMetrics("team-a", "web", "250m", "1Gi"),
+			newTestPodMetrics("team-a", "trainer", "3", "1Gi"),
+			newTestPodMetrics("team-b", "batch", "500m", "512Mi"),
+		}}, nil
+	})
+
+	cfg := &config.Config{
+		CostPrices: config.ResourcePrices{CPUCoreHour: 0.04, MemoryGiBHour: 0.005, GPUHour: 1},
+		CostNodePoolPrices: map[string]config.ResourcePrices{
+			"spot": {CPUCoreHour: 0.01, MemoryGiBHour: 0.001},
+		},
+		CostGPUResources: []string{"nvidia.com/gpu", "amd.com/gpu"},
+	}
+	return NewAllocationService(k8sClient, metricsClient, cfg, slog.Default()).(*allocationService)
+}
+
+func TestAllocationService_GetAllocation(t *testing.T) {
+	service := newAllocationTestService(t, nil)
+
+	report, err := service.GetAllocation(context.Background(), models.AllocationQuery{GroupBy: models.AllocationGroupByNamespace})
+	require.NoError(t, err)
+
+	assert.True(t, report.UsageAvailable)
+	assert.Equal(t, 3, report.Total.Pods)
+	require.Len(t, report.Groups, 2)
+
+	teamA := report.Groups[0]
+	assert.Equal(t, "team-a", teamA.Name)
+	assert.Equal(t, 2, teamA.Pods)
+	assert.InDelta(t, 3.0, teamA.Requests.CPUCores, 0.001)
+	assert.InDelta(t, 6.0, teamA.Requests.MemoryGiB, 0.001)
+	assert.InDelta(t, 1.0, teamA.Requests.GPUs, 0.001)
+	assert.InDelta(t, 4.0, teamA.Limits.CPUCores, 0.001)
+	require.NotNil(t, teamA.Usage)
+	assert.InDelta(t, 3.25, teamA.Usage.CPUCores, 0.001)
+	// The trainer uses more CPU than it requested, which is not idle and is
+	// billed at usage.
+	assert.InDelta(t, 0.75, teamA.Idle.CPUCores, 0.001)
+	assert.InDelta(t, 4.0, teamA.Idle.MemoryGiB, 0.001)
+	assert.InDelta(t, 1.19, teamA.Cost.Hourly, 0.0001)
+	assert.InDelta(t, 1.19*models.HoursPerMonth, teamA.Cost.Monthly, 0.01)
+	assert.InDelta(t, 0.05, teamA.Cost.IdleHourly, 0.0001)
+
+	teamB := report.Groups[1]
+	assert.Equal(t, "team-b", teamB.Name)
+	assert.Equal(t, 1, teamB.Pods)
+	assert.InDelta(t, 0.021, teamB.Cost.Hourly, 0.0001, "spot pool prices apply")
+
+	assert.InDelta(t, 1.211, report.Total.Cost.Hourly, 0.0001)
+}
+
+func TestAllocationService_GetAllocation_GroupByLabel(t *testing.T) {
+	service := newAllocationTestService(t, nil)
+
+	report, err := service.GetAllocation(context.Background(), models.AllocationQuery{GroupBy: "label:team"})
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, group := range report.Groups {
+		names = append(names, group.Name)
+	}
+	assert.Equal(t, []string{"ml", "payments", models.AllocationUnlabeled}, names)
+}
+
+func TestAllocationService_GetAllocation_WithoutMetrics(t *testing.T) {
+	service := newAllocationTestService(t, errors.New("metrics unavailable"))
+
+	report, err := service.GetAllocation(context.Background(), models.AllocationQuery{
+		Namespace: "team-a",
+		GroupBy:   models.AllocationGroupByNamespace,
+	})
+	require.NoError(t, err)
+
+	assert.False(t, report.UsageAvailable)
+	require.Len(t, report.Groups, 1)
+	assert.Nil(t, report.Groups[0].Usage)
+	assert.Nil(t, report.Groups[0].Idle)
+	assert.InDelta(t, 1.15, report.Groups[0].Cost.Hourly, 0.0001, "cost falls back to requests")
+	assert.Zero(t, report.Groups[0].Cost.IdleHourly)
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/healthhistory"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+type healthHistoryService struct {
+	healthScore core.HealthScoreService
+	store       *healthhistory.Store
+	interval    time.Duration
+	retention   time.Duration
+	logger      *slog.Logger
+}
+
+// NewHealthHistoryService records into store every HealthHistoryInterval. A
+// nil store disables the history.
+func NewHealthHistoryService(healthScore core.HealthScoreService, store *healthhistory.Store, cfg *config.Config, logger *slog.Logger) core.HealthHistoryService {
+	return &healthHistoryService{
+		healthScore: healthScore,
+		store:       store,
+		interval:    cfg.HealthHistoryInterval,
+		retention:   cfg.HealthHistoryRetention,
+		logger:      logger,
+	}
+}
+
+// Run records once right away so the history starts filling at startup,
+// then every interval.
+func (s *healthHistoryService) Run(ctx context.Context) {
+	if s.store == nil || s.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.record(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *healthHistoryService) record(ctx context.Context) {
+	// A round must not outlast the interval, or rounds would pile up on a
+	// slow API server.
+	roundCtx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	snapshot, err := s.healthScore.SnapshotHealthScores(roundCtx)
+	if err != nil {
+		// Rounds cut short by shutdown are not worth a warning.
+		if ctx.Err() == nil {
+			s.logger.Warn("failed to record health scores", "error", err)
+		}
+		return
+	}
+
+	pods := make([]healthhistory.Sample, 0, len(snapshot.Pods))
+	for _, pod := range snapshot.Pods {
+		pods = append(pods, healthhistory.Sample{Namespace: pod.Namespace, Name: pod.Name, Score: pod.Score})
+	}
+	workloads := make([]healthhistory.Sample, 0, len(snapshot.Workloads))
+	for _, workload := range snapshot.Workloads {
+		workloads = append(workloads, healthhistory.Sample{
+			Namespace: workload.Namespace,
+			Kind:      workload.Kind,
+			Name:      workload.Name,
+			Score:     workload.Score,
+		})
+	}
+
+	s.store.Record(snapshot.CalculatedAt, pods, workloads)
+	if err := s.store.Save(); err != nil {
+		s.logger.Warn("failed to save health history", "error", err)
+	}
+
+	s.logger.Debug("recorded health scores",
+		"pods", len(pods),
+		"workloads", len(workloads))
+}
+
+// GetPodHealthHistory returns the recorded scores of a pod, limited to the
+// last since when it is set.
+func (s *healthHistoryService) GetPodHealthHistory(ctx context.Context, namespace, podName string, since time.Duration) (*models.PodHealthHistory, error) {
+	if s.store == nil {
+		return nil, core.ErrHealthHistoryDisabled
+	}
+
+	series, ok := s.store.Pod(namespace, podName)
+	if !ok {
+		return nil, core.ErrHealthHistoryNotFound
+	}
+
+	points := s.window(series.Points, since)
+	if len(points) == 0 {
+		return nil, core.ErrHealthHistoryNotFound
+	}
+
+	return &models.PodHealthHistory{
+		Namespace: namespace,
+		PodName:   podName,
+		Interval:  s.interval.String(),
+		Retention: s.retention.String(),
+		Points:    healthhistory.Points(points),
+		Trend:     healthhistory.Analyze(points),
+	}, nil
+}
+
+// GetWorkloadHealthTrends lists the recorded workloads with their trends,
+// the most degrading first.
+func (s *healthHistoryService) GetWorkloadHealthTrends(ctx context.Context, namespace string, since time.Duration, includePoints bool) (*models.WorkloadHealthTrends, error) {
+	if s.store == nil {
+		return nil, core.ErrHealthHistoryDisabled
+	}
+
+	result := &models.WorkloadHealthTrends{
+		Namespace:    namespace,
+		Interval:     s.interval.String(),
+		Retention:    s.retention.String(),
+		Workloads:    []models.WorkloadHealthTrend{},
+		CalculatedAt: time.Now(),
+	}
+
+	for _, series := range s.store.Workloads(namespace) {
+		points := s.window(series.Points, since)
+		if len(points) == 0 {
+			continue
+		}
+
+		latest := points[len(points)-1]
+		trend := models.WorkloadHealthTrend{
+			Namespace:    series.Namespace,
+			Kind:         series.Kind,
+			Name:         series.Name,
+			LatestScore:  latest.Score,
+			LastRecorded: latest.Time,
+			Trend:        healthhistory.Analyze(points),
+		}
+		if includePoints {
+			trend.Points = healthhistory.Points(points)
+		}
+		result.Workloads = append(result.Workloads, trend)
+	}
+
+	sort.SliceStable(result.Workloads, func(i, j int) bool {
+		return moreDegrading(result.Workloads[i], result.Workloads[j])
+	})
+
+	return result, nil
+}
+
+func (s *healthHistoryService) window(points []healthhistory.Point, since time.Duration) []healthhistory.Point {
+	if since <= 0 {
+		return points
+	}
+	return healthhistory.Since(points, time.Now().Add(-since))
+}
+
+// moreDegrading orders workloads that are declining before the rest, then
+// by steepest slope and lowest score.
+func moreDegrading(a, b models.WorkloadHealthTrend) bool {
+	aDegrading := a.Trend.Direction == models.HealthTrendDegrading
+	bDegrading := b.Trend.Direction == models.HealthTrendDegrading
+	if aDegrading != bDegrading {
+		return aDegrading
+	}
+	if a.Trend.SlopePerHour != b.Trend.SlopePerHour {
+		return a.Trend.SlopePerHour < b.Trend.SlopePerHour
+	}
+	return a.LatestScore < b.LatestScore
+}
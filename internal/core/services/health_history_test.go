@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/healthhistory"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// stubHealthScoreService returns queued snapshots, one per call.
+type stubHealthScoreService struct {
+	core.HealthScoreService
+	snapshots []*models.HealthScoreSnapshot
+	err       error
+}
+
+func (s *stubHealthScoreService) SnapshotHealthScores(ctx context.Context) (*models.HealthScoreSnapshot, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	snapshot := s.snapshots[0]
+	s.snapshots = s.snapshots[1:]
+	return snapshot, nil
+}
+
+func newHistorySnapshot(at time.Time, podScore int, workloadScores map[string]int) *models.HealthScoreSnapshot {
+	snapshot := &models.HealthScoreSnapshot{
+		Pods:         []models.PodHealthItem{{Namespace: "default", Name: "web-1", Score: podScore}},
+		CalculatedAt: at,
+	}
+	for name, score := range workloadScores {
+		snapshot.Workloads = append(snapshot.Workloads, models.WorkloadHealthScore{
+			Namespace: "default",
+			Kind:      "Deployment",
+			Name:      name,
+			Score:     score,
+		})
+	}
+	return snapshot
+}
+
+func TestHealthHistoryService(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	stub := &stubHealthScoreService{}
+	for i, scores := range []struct {
+		pod, web, api int
+	}{
+		{95, 95, 80},
+		{94, 94, 82},
+		{80, 85, 84},
+		{70, 75, 86},
+	} {
+		at := now.Add(time.Duration(i-3) * time.Hour)
+		stub.snapshots = append(stub.snapshots, newHistorySnapshot(at, scores.pod, map[string]int{"web": scores.web, "api": scores.api}))
+	}
+
+	path := filepath.Join(t.TempDir(), "history.json")
+	store := healthhistory.New(path, 24*time.Hour, 100)
+	cfg := &config.Config{HealthHistoryInterval: 5 * time.Minute, HealthHistoryRetention: 24 * time.Hour}
+	svc := NewHealthHistoryService(stub, store, cfg, slog.New(slog.NewTextHandler(io.Discard, nil))).(*healthHistoryService)
+
+	for range 4 {
+		svc.record(context.Background())
+	}
+
+	history, err := svc.GetPodHealthHistory(context.Background(), "default", "web-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "5m0s", history.Interval)
+	assert.Equal(t, "24h0m0s", history.Retention)
+	require.Len(t, history.Points, 4)
+	assert.Equal(t, 70, history.Points[3].Score)
+	assert.Equal(t, models.HealthTrendDegrading, history.Trend.Direction)
+	require.NotNil(t, history.Trend.DegradingSince)
+	assert.Equal(t, now.Add(-3*time.Hour), *history.Trend.DegradingSince)
+
+	history, err = svc.GetPodHealthHistory(context.Background(), "default", "web-1", 90*time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, history.Points, 2)
+
+	_, err = svc.GetPodHealthHistory(context.Background(), "default", "missing", 0)
+	assert.ErrorIs(t, err, core.ErrHealthHistoryNotFound)
+
+	trends, err := svc.GetWorkloadHealthTrends(context.Background(), "default", 0, false)
+	require.NoError(t, err)
+	require.Len(t, trends.Workloads, 2)
+	assert.Equal(t, "web", trends.Workloads[0].Name)
+	assert.Equal(t, 75, trends.Workloads[0].LatestScore)
+	assert.Equal(t, models.HealthTrendDegrading, trends.Workloads[0].Trend.Direction)
+	assert.Empty(t, trends.Workloads[0].Points)
+	assert.Equal(t, "api", trends.Workloads[1].Name)
+	assert.Equal(t, models.HealthTrendImproving, trends.Workloads[1].Trend.Direction)
+
+	trends, err = svc.GetWorkloadHealthTrends(context.Background(), "", 0, true)
+	require.NoError(t, err)
+	assert.Len(t, trends.Workloads[0].Points, 4)
+
+	trends, err = svc.GetWorkloadHealthTrends(context.Background(), "other", 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, trends.Workloads)
+
+	// The history survives a restart.
+	reopened, err := healthhistory.Open(path, 24*time.Hour, 100)
+	require.NoError(t, err)
+	series, ok := reopened.Pod("default", "web-1")
+	require.True(t, ok)
+	assert.Len(t, series.Points, 4)
+}
+
+func TestHealthHistoryService_RecordFailure(t *testing.T) {
+	store := healthhistory.New("", time.Hour, 100)
+	stub := &stubHealthScoreService{err: errors.New("api server unavailable")}
+	cfg := &config.Config{HealthHistoryInterval: time.Minute, HealthHistoryRetention: time.Hour}
+	svc := NewHealthHistoryService(stub, store, cfg, slog.New(slog.NewTextHandler(io.Discard, nil))).(*healthHistoryService)
+
+	svc.record(context.Background())
+
+	trends, err := svc.GetWorkloadHealthTrends(context.Background(), "", 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, trends.Workloads)
+}
+
+func TestHealthHistoryService_Disabled(t *testing.T) {
+	cfg := &config.Config{HealthHistoryRetention: time.Hour}
+	svc := NewHealthHistoryService(&stubHealthScoreService{}, nil, cfg, slog.Default())
+
+	// Run returns at once when the history is disabled.
+	svc.Run(context.Background())
+
+	_, err := svc.GetPodHealthHistory(context.Background(), "default", "web-1", 0)
+	assert.ErrorIs(t, err, core.ErrHealthHistoryDisabled)
+	_, err = svc.GetWorkloadHealthTrends(context.Background(), "", 0, false)
+	assert.ErrorIs(t, err, core.ErrHealthHistoryDisabled)
+}
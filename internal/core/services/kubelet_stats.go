@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// kubeletStatsSource reads node-local data that only the kubelet has. It is
+// an interface so tests can replace the API server node proxy.
+type kubeletStatsSource interface {
+	Summary(ctx context.Context, nodeName string) (*kubeletSummary, error)
+	EvictionHard(ctx context.Context, nodeName string) (map[string]string, error)
+}
+
+// kubeletSummary is the subset of the kubelet /stats/summary response used
+// by the agent.
+type kubeletSummary struct {
+	Node kubeletNodeStats  `json:"node"`
+	Pods []kubeletPodStats `json:"pods"`
+}
+
+type kubeletNodeStats struct {
+	Memory  *kubeletMemoryStats  `json:"memory,omitempty"`
+	Fs      *kubeletFsStats      `json:"fs,omitempty"`
+	Runtime *kubeletRuntimeStats `json:"runtime,omitempty"`
+}
+
+type kubeletRuntimeStats struct {
+	ImageFs *kubeletFsStats `json:"imageFs,omitempty"`
+}
+
+type kubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	Memory           *kubeletMemoryStats `json:"memory,omitempty"`
+	EphemeralStorage *kubeletFsStats     `json:"ephemeral-storage,omitempty"`
+}
+
+type kubeletMemoryStats struct {
+	AvailableBytes  *uint64 `json:"availableBytes,omitempty"`
+	WorkingSetBytes *uint64 `json:"workingSetBytes,omitempty"`
+}
+
+type kubeletFsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	CapacityBytes  *uint64 `json:"capacityBytes,omitempty"`
+	UsedBytes      *uint64 `json:"usedBytes,omitempty"`
+	InodesFree     *uint64 `json:"inodesFree,omitempty"`
+	Inodes         *uint64 `json:"inodes,omitempty"`
+}
+
+// proxyKubeletStats reaches the kubelet through the API server's nodes/proxy
+// subresource, so the agent needs no direct network access to nodes.
+type proxyKubeletStats struct {
+	k8sClient kubernetes.Interface
+}
+
+func (p *proxyKubeletStats) Summary(ctx context.Context, nodeName string) (*kubeletSummary, error) {
+	raw, err := p.get(ctx, nodeName, "stats/summary")
+	if err != nil {
+		return nil, err
+	}
+
+	var summary kubeletSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, fmt.Errorf("failed to decode kubelet stats summary for node %s: %w", nodeName, err)
+	}
+	return &summary, nil
+}
+
+func (p *proxyKubeletStats) EvictionHard(ctx context.Context, nodeName string) (map[string]string, error) {
+	raw, err := p.get(ctx, nodeName, "configz")
+	if err != nil {
+		return nil, err
+	}
+
+	var configz struct {
+		KubeletConfig struct {
+			EvictionHard map[string]string `json:"evictionHard"`
+		} `json:"kubeletconfig"`
+	}
+	if err := json.Unmarshal(raw, &configz); err != nil {
+		return nil, fmt.Errorf("failed to decode kubelet config for node %s: %w", nodeName, err)
+	}
+	return configz.KubeletConfig.EvictionHard, nil
+}
+
+func (p *proxyKubeletStats) get(ctx context.Context, nodeName, path string) ([]byte, error) {
+	raw, err := p.k8sClient.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix(path).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubelet %s for node %s: %w", path, nodeName, err)
+	}
+	return raw, nil
+}
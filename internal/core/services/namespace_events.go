@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
+)
+
+const (
+	// recentEventWindow and maxRecentEvents bound the events attached to
+	// each problematic pod.
+	recentEventWindow = time.Hour
+	maxRecentEvents   = 5
+
+	maxDigestSampleObjects = 5
+)
+
+// podEventIndex groups a namespace's pod events by involved object UID, so a
+// recreated pod with the same name does not inherit its predecessor's
+// events. Events recorded without a UID are matched by name.
+type podEventIndex struct {
+	byUID  map[types.UID][]v1.Event
+	byName map[string][]v1.Event
+}
+
+func newPodEventIndex(events []v1.Event) *podEventIndex {
+	index := &podEventIndex{
+		byUID:  make(map[types.UID][]v1.Event),
+		byName: make(map[string][]v1.Event),
+	}
+
+	for i := range events {
+		event := &events[i]
+		if event.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		if event.InvolvedObject.UID != "" {
+			index.byUID[event.InvolvedObject.UID] = append(index.byUID[event.InvolvedObject.UID], *event)
+		} else {
+			index.byName[event.InvolvedObject.Name] = append(index.byName[event.InvolvedObject.Name], *event)
+		}
+	}
+
+	return index
+}
+
+func (i *podEventIndex) forPod(pod *v1.Pod) []v1.Event {
+	events := i.byName[pod.Name]
+	if pod.UID != "" {
+		events = append(append([]v1.Event(nil), i.byUID[pod.UID]...), events...)
+	}
+	return events
+}
+
+// recentWarningEvents returns the newest Warning events from the last hour.
+func recentWarningEvents(events []v1.Event) []models.EventInfo {
+	result := []models.EventInfo{}
+	cutoff := time.Now().Add(-recentEventWindow)
+
+	for i := range events {
+		event := &events[i]
+		if event.Type == v1.EventTypeWarning && relatedevents.Timestamp(event).After(cutoff) {
+			result = append(result, models.EventInfo{
+				Type:           event.Type,
+				Reason:         event.Reason,
+				Message:        event.Message,
+				FirstTimestamp: event.FirstTimestamp,
+				LastTimestamp:  event.LastTimestamp,
+				Count:          event.Count,
+				Source:         fmt.Sprintf("%s/%s", event.Source.Component, event.Source.Host),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastTimestamp.After(result[j].LastTimestamp.Time)
+	})
+
+	if len(result) > maxRecentEvents {
+		result = result[:maxRecentEvents]
+	}
+	return result
+}
+
+// GetNamespaceEvents aggregates the namespace's Warning events by reason and
+// involved object kind.
+func (s *namespaceService) GetNamespaceEvents(ctx context.Context, namespace string) (*models.NamespaceEventDigest, error) {
+	s.logger.Debug("building namespace event digest", "namespace", namespace)
+
+	eventList, err := s.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "type=Warning",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events in namespace %s: %w", namespace, err)
+	}
+
+	digest := &models.NamespaceEventDigest{
+		Namespace:  namespace,
+		Groups:     []models.EventGroup{},
+		AnalyzedAt: time.Now(),
+	}
+
+	type group struct {
+		entry   models.EventGroup
+		objects map[string]bool
+		latest  time.Time
+	}
+	groups := make(map[string]*group)
+
+	for i := range eventList.Items {
+		event := &eventList.Items[i]
+		if event.Type != v1.EventTypeWarning {
+			continue
+		}
+
+		count := event.Count
+		if count < 1 {
+			count = 1
+		}
+		digest.TotalWarnings += int(count)
+
+		kind := event.InvolvedObject.Kind
+		key := event.Reason + "/" + kind
+		g, ok := groups[key]
+		if !ok {
+			g = &group{
+				entry: models.EventGroup{
+					Reason:        event.Reason,
+					ObjectKind:    kind,
+					SampleObjects: []string{},
+				},
+				objects: make(map[string]bool),
+			}
+			groups[key] = g
+		}
+
+		g.entry.Count += int(count)
+		if !g.objects[event.InvolvedObject.Name] {
+			g.objects[event.InvolvedObject.Name] = true
+			if len(g.entry.SampleObjects) < maxDigestSampleObjects {
+				g.entry.SampleObjects = append(g.entry.SampleObjects, event.InvolvedObject.Name)
+			}
+		}
+
+		first := event.FirstTimestamp
+		if first.IsZero() {
+			first = relatedevents.Timestamp(event)
+		}
+		last := relatedevents.Timestamp(event)
+		if g.entry.FirstSeen.IsZero() || first.Before(&g.entry.FirstSeen) {
+			g.entry.FirstSeen = first
+		}
+		if last.After(g.latest) {
+			g.latest = last.Time
+			g.entry.LastSeen = last
+			g.entry.LatestMessage = event.Message
+		}
+	}
+
+	for _, g := range groups {
+		g.entry.Objects = len(g.objects)
+		sort.Strings(g.entry.SampleObjects)
+		digest.Groups = append(digest.Groups, g.entry)
+	}
+
+	sort.Slice(digest.Groups, func(i, j int) bool {
+		if digest.Groups[i].Count != digest.Groups[j].Count {
+			return digest.Groups[i].Count > digest.Groups[j].Count
+		}
+		return digest.Groups[i].LastSeen.After(digest.Groups[j].LastSeen.Time)
+	})
+
+	s.logger.Info("namespace event digest complete",
+		"namespace", namespace,
+		"warnings", digest.TotalWarnings,
+		"groups", len(digest.Groups))
+
+	return digest, nil
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newNamespaceEvent(name, kind, object string, uid types.UID, reason string, count int32, first, last time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      kind,
+			Name:      object,
+			Namespace: "test-ns",
+			UID:       uid,
+		},
+		Type:           v1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " on " + object,
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(first),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+func TestNamespaceService_GetNamespaceErrors_SharesEventList(t *testing.T) {
+	now := time.Now()
+
+	crashing := createCrashLoopPod("test-ns", "web-0", "statefulset")
+	crashing.UID = "uid-current"
+	other := createCrashLoopPod("test-ns", "web-1", "statefulset")
+	other.UID = "uid-other"
+
+	fakeClient := fake.NewSimpleClientset(
+		crashing,
+		other,
+		createCrashLoopPod("test-ns", "web-2", "statefulset"),
+		newNamespaceEvent("current", "Pod", "web-0", "uid-current", "BackOff", 3, now.Add(-10*time.Minute), now.Add(-time.Minute)),
+		newNamespaceEvent("previous", "Pod", "web-0", "uid-previous", "FailedMount", 1, now.Add(-30*time.Minute), now.Add(-20*time.Minute)),
+		newNamespaceEvent("no-uid", "Pod", "web-2", "", "BackOff", 1, now.Add(-5*time.Minute), now.Add(-5*time.Minute)),
+	)
+
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	report, err := service.GetNamespaceErrors(context.Background(), "test-ns", models.NamespaceErrorOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 3, report.ProblematicPodsCount)
+
+	eventLists := 0
+	for _, action := range fakeClient.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == "events" {
+			eventLists++
+		}
+	}
+	assert.Equal(t, 1, eventLists)
+
+	events := map[string][]models.EventInfo{}
+	for _, pod := range report.ProblematicPods {
+		events[pod.Name] = pod.Events
+	}
+	require.Len(t, events["web-0"], 1, "events of a previous pod with the same name are not attached")
+	assert.Equal(t, "BackOff", events["web-0"][0].Reason)
+	assert.Empty(t, events["web-1"])
+	require.Len(t, events["web-2"], 1)
+}
+
+func TestNamespaceService_GetNamespaceEvents(t *testing.T) {
+	now := time.Now()
+
+	normal := newNamespaceEvent("scaled", "Deployment", "web", "", "ScalingReplicaSet", 1, now, now)
+	normal.Type = v1.EventTypeNormal
+
+	fakeClient := fake.NewSimpleClientset(
+		newNamespaceEvent("backoff-1", "Pod", "web-1", "u1", "BackOff", 10, now.Add(-2*time.Hour), now.Add(-time.Minute)),
+		newNamespaceEvent("backoff-2", "Pod", "web-2", "u2", "BackOff", 5, now.Add(-time.Hour), now.Add(-2*time.Minute)),
+		newNamespaceEvent("backoff-3", "Pod", "web-1", "u1", "BackOff", 1, now.Add(-3*time.Hour), now.Add(-3*time.Hour)),
+		newNamespaceEvent("pvc", "PersistentVolumeClaim", "data-db-0", "u3", "ProvisioningFailed", 4, now.Add(-time.Hour), now),
+		normal,
+	)
+
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	digest, err := service.GetNamespaceEvents(context.Background(), "test-ns")
+	require.NoError(t, err)
+
+	assert.Equal(t, 20, digest.TotalWarnings)
+	require.Len(t, digest.Groups, 2)
+
+	backoff := digest.Groups[0]
+	assert.Equal(t, "BackOff", backoff.Reason)
+	assert.Equal(t, "Pod", backoff.ObjectKind)
+	assert.Equal(t, 16, backoff.Count)
+	assert.Equal(t, 2, backoff.Objects)
+	assert.Equal(t, []string{"web-1", "web-2"}, backoff.SampleObjects)
+	assert.WithinDuration(t, now.Add(-3*time.Hour), backoff.FirstSeen.Time, time.Second)
+	assert.WithinDuration(t, now.Add(-time.Minute), backoff.LastSeen.Time, time.Second)
+	assert.Equal(t, "BackOff on web-1", backoff.LatestMessage)
+
+	assert.Equal(t, "ProvisioningFailed", digest.Groups[1].Reason)
+	assert.Equal(t, "PersistentVolumeClaim", digest.Groups[1].ObjectKind)
+}
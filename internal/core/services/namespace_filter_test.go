@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/pagination"
+)
+
+func withLabelsAndNode(pod *v1.Pod, app, node string) *v1.Pod {
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels["app"] = app
+	pod.Spec.NodeName = node
+	return pod
+}
+
+func problematicPodNames(pods []models.ProblematicPod) []string {
+	names := []string{}
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestNamespaceService_GetNamespaceErrors_Filters(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		withLabelsAndNode(withOwner(createCrashLoopPod("test-ns", "checkout-abc-1", "deployment"), "ReplicaSet", "checkout-abc"), "checkout", "node-a"),
+		withLabelsAndNode(withOwner(createPod("test-ns", "checkout-abc-2", "deployment", "Running", 0, 9), "ReplicaSet", "checkout-abc"), "checkout", "node-b"),
+		withLabelsAndNode(withOwner(createImagePullErrorPod("test-ns", "search-def-1", "deployment"), "ReplicaSet", "search-def"), "search", "node-a"),
+		withLabelsAndNode(createPod("test-ns", "search-healthy", "", "Running", 0, 0), "search", "node-a"),
+	)
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	tests := []struct {
+		name        string
+		opts        models.IssueListOptions
+		analyzed    int
+		healthy     int
+		problematic []string
+	}{
+		{
+			name:        "label selector",
+			opts:        models.IssueListOptions{LabelSelector: "app=checkout"},
+			analyzed:    2,
+			problematic: []string{"checkout-abc-1", "checkout-abc-2"},
+		},
+		{
+			name:        "owner name matches workload",
+			opts:        models.IssueListOptions{OwnerName: "search"},
+			analyzed:    1,
+			problematic: []string{"search-def-1"},
+		},
+		{
+			name:        "node name",
+			opts:        models.IssueListOptions{NodeName: "node-b"},
+			analyzed:    1,
+			problematic: []string{"checkout-abc-2"},
+		},
+		{
+			name:        "issue type leaves other problematic pods out of both counts",
+			opts:        models.IssueListOptions{IssueType: "imagepullerror"},
+			analyzed:    4,
+			healthy:     1,
+			problematic: []string{"search-def-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := service.GetNamespaceErrors(context.Background(), "test-ns",
+				models.NamespaceErrorOptions{IssueListOptions: tt.opts})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.analyzed, report.TotalPodsAnalyzed)
+			assert.Equal(t, tt.healthy, report.HealthyPodsCount)
+			assert.Equal(t, len(tt.problematic), report.ProblematicPodsCount)
+			assert.Equal(t, tt.problematic, problematicPodNames(report.ProblematicPods))
+		})
+	}
+}
+
+func TestNamespaceService_GetNamespaceErrors_Pagination(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		createCrashLoopPod("test-ns", "pod-c", ""),
+		createCrashLoopPod("test-ns", "pod-a", ""),
+		createPod("test-ns", "pod-b", "", "Running", 0, 7),
+		createPod("test-ns", "pod-d", "", "Running", 0, 12),
+		createImagePullErrorPod("test-ns", "pod-e", ""),
+	)
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+	ctx := context.Background()
+
+	full, err := service.GetNamespaceErrors(ctx, "test-ns", models.NamespaceErrorOptions{})
+	require.NoError(t, err)
+	require.Len(t, full.ProblematicPods, 5)
+	assert.Empty(t, full.Continue)
+
+	var paged []string
+	opts := models.NamespaceErrorOptions{IssueListOptions: models.IssueListOptions{Limit: 2}}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5)
+		report, err := service.GetNamespaceErrors(ctx, "test-ns", opts)
+		require.NoError(t, err)
+		assert.Equal(t, 5, report.ProblematicPodsCount)
+		assert.LessOrEqual(t, len(report.ProblematicPods), 2)
+
+		paged = append(paged, problematicPodNames(report.ProblematicPods)...)
+		if report.Continue == "" {
+			break
+		}
+		opts.Continue = report.Continue
+	}
+	assert.Equal(t, problematicPodNames(full.ProblematicPods), paged)
+
+	opts.Continue = "bogus"
+	_, err = service.GetNamespaceErrors(ctx, "test-ns", opts)
+	assert.ErrorIs(t, err, pagination.ErrInvalidContinue)
+}
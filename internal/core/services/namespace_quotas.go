@@ -0,0 +1,369 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
+)
+
+// maxQuotaConsumers caps the workloads listed per quota.
+const maxQuotaConsumers = 5
+
+func (s *namespaceService) GetNamespaceQuotas(ctx context.Context, namespace string) (*models.NamespaceQuotaReport, error) {
+	s.logger.Debug("analyzing namespace quotas", "namespace", namespace)
+
+	quotas, err := s.k8sClient.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas in namespace %s: %w", namespace, err)
+	}
+
+	limitRanges, err := s.k8sClient.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit ranges in namespace %s: %w", namespace, err)
+	}
+
+	report := &models.NamespaceQuotaReport{
+		Namespace:   namespace,
+		Quotas:      make([]models.ResourceQuotaUsage, 0, len(quotas.Items)),
+		LimitRanges: make([]models.LimitRangeSummary, 0, len(limitRanges.Items)),
+		AnalyzedAt:  time.Now(),
+	}
+
+	// Quotas only count pods that have not terminated.
+	var activePods []v1.Pod
+	if len(quotas.Items) > 0 {
+		pods, err := s.k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			s.logger.Warn("failed to list pods for quota consumers",
+				"namespace", namespace,
+				"error", err.Error())
+			report.Warnings = append(report.Warnings, "pods could not be listed; top consumers are not reported")
+		} else {
+			for i := range pods.Items {
+				if !isPodTerminated(&pods.Items[i]) {
+					activePods = append(activePods, pods.Items[i])
+				}
+			}
+		}
+	}
+	jobs := s.listPodJobs(ctx, namespace, activePods)
+
+	for i := range quotas.Items {
+		quota := &quotas.Items[i]
+		usage := models.ResourceQuotaUsage{
+			Name:          quota.Name,
+			ScopeSelector: formatScopeSelector(quota.Spec.ScopeSelector),
+			Resources:     quotaResourceUsages(quota),
+			TopConsumers:  quotaConsumers(quota, activePods, jobs),
+		}
+		for _, scope := range quota.Spec.Scopes {
+			usage.Scopes = append(usage.Scopes, string(scope))
+		}
+		for _, resourceUsage := range usage.Resources {
+			if resourceUsage.NearLimit {
+				usage.NearLimit = true
+			}
+		}
+		report.Quotas = append(report.Quotas, usage)
+	}
+
+	for i := range limitRanges.Items {
+		report.LimitRanges = append(report.LimitRanges, summarizeLimitRange(&limitRanges.Items[i]))
+	}
+
+	sort.Slice(report.Quotas, func(i, j int) bool { return report.Quotas[i].Name < report.Quotas[j].Name })
+	sort.Slice(report.LimitRanges, func(i, j int) bool { return report.LimitRanges[i].Name < report.LimitRanges[j].Name })
+
+	s.logger.Info("namespace quota analysis complete",
+		"namespace", namespace,
+		"quotas", len(report.Quotas),
+		"limitRanges", len(report.LimitRanges))
+
+	return report, nil
+}
+
+// quotaResourceUsages compares used with hard for every resource in the
+// quota. A zero hard limit forbids the resource outright, so it is never
+// reported as running out.
+func quotaResourceUsages(quota *v1.ResourceQuota) []models.QuotaResourceUsage {
+	hardLimits := quota.Status.Hard
+	if len(hardLimits) == 0 {
+		hardLimits = quota.Spec.Hard
+	}
+
+	usages := make([]models.QuotaResourceUsage, 0, len(hardLimits))
+	for name, hard := range hardLimits {
+		used := quota.Status.Used[name]
+		percentage := percentOf(used, hard)
+		usages = append(usages, models.QuotaResourceUsage{
+			Resource:   string(name),
+			Used:       used.String(),
+			Hard:       hard.String(),
+			Percentage: percentage,
+			NearLimit:  !hard.IsZero() && percentage >= models.QuotaNearLimitPercentage,
+			Exhausted:  !hard.IsZero() && used.Cmp(hard) >= 0,
+		})
+	}
+
+	sort.Slice(usages, func(i, j int) bool { return usages[i].Resource < usages[j].Resource })
+	return usages
+}
+
+// podQuotaUsage returns how much of a quota resource the pod accounts for.
+// Only pod-level compute resources and the pod count can be attributed.
+func podQuotaUsage(pod *v1.Pod, name v1.ResourceName) (resource.Quantity, bool) {
+	switch {
+	case name == v1.ResourcePods:
+		return *resource.NewQuantity(1, resource.DecimalSI), true
+	case name == v1.ResourceCPU || name == v1.ResourceMemory || name == v1.ResourceEphemeralStorage:
+		return podRequests(pod)[name], true
+	case strings.HasPrefix(string(name), "requests."):
+		return podRequests(pod)[v1.ResourceName(strings.TrimPrefix(string(name), "requests."))], true
+	case strings.HasPrefix(string(name), "limits."):
+		return podLimits(pod)[v1.ResourceName(strings.TrimPrefix(string(name), "limits."))], true
+	}
+	return resource.Quantity{}, false
+}
+
+// quotaConsumers groups the pods counted by the quota by workload and ranks
+// them by the largest share of any hard limit they account for.
+func quotaConsumers(quota *v1.ResourceQuota, pods []v1.Pod, jobs workload.Jobs) []models.QuotaConsumer {
+	hardLimits := quota.Status.Hard
+	if len(hardLimits) == 0 {
+		hardLimits = quota.Spec.Hard
+	}
+
+	type consumer struct {
+		kind, name string
+		pods       int
+		usage      v1.ResourceList
+	}
+	consumers := make(map[string]*consumer)
+
+	for i := range pods {
+		pod := &pods[i]
+		if !quotaMatchesPod(quota, pod) {
+			continue
+		}
+
+		kind, name := workload.Of(pod, jobs)
+		key := kind + "/" + name
+		c, ok := consumers[key]
+		if !ok {
+			c = &consumer{kind: kind, name: name, usage: v1.ResourceList{}}
+			consumers[key] = c
+		}
+		c.pods++
+
+		for resourceName := range hardLimits {
+			if quantity, ok := podQuotaUsage(pod, resourceName); ok {
+				addResourceList(c.usage, v1.ResourceList{resourceName: quantity})
+			}
+		}
+	}
+
+	result := make([]models.QuotaConsumer, 0, len(consumers))
+	for _, c := range consumers {
+		entry := models.QuotaConsumer{
+			Kind:  c.kind,
+			Name:  c.name,
+			Pods:  c.pods,
+			Usage: make(map[string]string, len(c.usage)),
+		}
+		for resourceName, quantity := range c.usage {
+			entry.Usage[string(resourceName)] = quantity.String()
+			share := percentOf(quantity, hardLimits[resourceName])
+			if share > entry.LargestShare || (share == entry.LargestShare && string(resourceName) < entry.LargestShareResource) {
+				entry.LargestShare = share
+				entry.LargestShareResource = string(resourceName)
+			}
+		}
+		result = append(result, entry)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].LargestShare != result[j].LargestShare {
+			return result[i].LargestShare > result[j].LargestShare
+		}
+		return result[i].Kind+"/"+result[i].Name < result[j].Kind+"/"+result[j].Name
+	})
+	if len(result) > maxQuotaConsumers {
+		result = result[:maxQuotaConsumers]
+	}
+	return result
+}
+
+// quotaMatchesPod applies the quota's scopes and scope selector the way the
+// quota admission controller does.
+func quotaMatchesPod(quota *v1.ResourceQuota, pod *v1.Pod) bool {
+	for _, scope := range quota.Spec.Scopes {
+		if !podMatchesScope(pod, scope, v1.ScopeSelectorOpExists, nil) {
+			return false
+		}
+	}
+	if quota.Spec.ScopeSelector != nil {
+		for _, expression := range quota.Spec.ScopeSelector.MatchExpressions {
+			if !podMatchesScope(pod, expression.ScopeName, expression.Operator, expression.Values) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func podMatchesScope(pod *v1.Pod, scope v1.ResourceQuotaScope, operator v1.ScopeSelectorOperator, values []string) bool {
+	switch scope {
+	case v1.ResourceQuotaScopeTerminating:
+		return pod.Spec.ActiveDeadlineSeconds != nil
+	case v1.ResourceQuotaScopeNotTerminating:
+		return pod.Spec.ActiveDeadlineSeconds == nil
+	case v1.ResourceQuotaScopeBestEffort:
+		return pod.Status.QOSClass == v1.PodQOSBestEffort
+	case v1.ResourceQuotaScopeNotBestEffort:
+		return pod.Status.QOSClass != v1.PodQOSBestEffort
+	case v1.ResourceQuotaScopeCrossNamespacePodAffinity:
+		return hasCrossNamespacePodAffinity(pod)
+	case v1.ResourceQuotaScopePriorityClass:
+		priorityClass := pod.Spec.PriorityClassName
+		switch operator {
+		case v1.ScopeSelectorOpExists:
+			return priorityClass != ""
+		case v1.ScopeSelectorOpDoesNotExist:
+			return priorityClass == ""
+		case v1.ScopeSelectorOpIn, v1.ScopeSelectorOpNotIn:
+			found := false
+			for _, value := range values {
+				if value == priorityClass {
+					found = true
+					break
+				}
+			}
+			return found == (operator == v1.ScopeSelectorOpIn)
+		}
+	}
+	return false
+}
+
+func hasCrossNamespacePodAffinity(pod *v1.Pod) bool {
+	affinity := pod.Spec.Affinity
+	if affinity == nil {
+		return false
+	}
+
+	var terms []v1.PodAffinityTerm
+	if affinity.PodAffinity != nil {
+		terms = append(terms, affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, weighted := range affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+	if affinity.PodAntiAffinity != nil {
+		terms = append(terms, affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, weighted := range affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+
+	for _, term := range terms {
+		if len(term.Namespaces) > 0 || term.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func formatScopeSelector(selector *v1.ScopeSelector) []string {
+	if selector == nil {
+		return nil
+	}
+
+	expressions := make([]string, 0, len(selector.MatchExpressions))
+	for _, expression := range selector.MatchExpressions {
+		formatted := fmt.Sprintf("%s %s", expression.ScopeName, expression.Operator)
+		if len(expression.Values) > 0 {
+			formatted += " (" + strings.Join(expression.Values, ", ") + ")"
+		}
+		expressions = append(expressions, formatted)
+	}
+	return expressions
+}
+
+// summarizeLimitRange flattens a LimitRange into one entry per limit type
+// and resource.
+func summarizeLimitRange(limitRange *v1.LimitRange) models.LimitRangeSummary {
+	summary := models.LimitRangeSummary{
+		Name:   limitRange.Name,
+		Limits: []models.LimitRangeItem{},
+	}
+
+	for _, item := range limitRange.Spec.Limits {
+		names := make(map[v1.ResourceName]bool)
+		for _, list := range []v1.ResourceList{item.Min, item.Max, item.Default, item.DefaultRequest, item.MaxLimitRequestRatio} {
+			for name := range list {
+				names[name] = true
+			}
+		}
+
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, string(name))
+		}
+		sort.Strings(sorted)
+
+		for _, name := range sorted {
+			resourceName := v1.ResourceName(name)
+			summary.Limits = append(summary.Limits, models.LimitRangeItem{
+				Type:                 string(item.Type),
+				Resource:             name,
+				Min:                  optionalQuantity(item.Min, resourceName),
+				Max:                  optionalQuantity(item.Max, resourceName),
+				Default:              optionalQuantity(item.Default, resourceName),
+				DefaultRequest:       optionalQuantity(item.DefaultRequest, resourceName),
+				MaxLimitRequestRatio: optionalQuantity(item.MaxLimitRequestRatio, resourceName),
+			})
+		}
+	}
+
+	return summary
+}
+
+func optionalQuantity(list v1.ResourceList, name v1.ResourceName) string {
+	if quantity, ok := list[name]; ok {
+		return quantity.String()
+	}
+	return ""
+}
+
+// quotaIssues flags quota resources at or above the near-limit threshold:
+// exhausted resources block new pods and are critical.
+func quotaIssues(quotas []v1.ResourceQuota) []models.PodIssue {
+	issues := []models.PodIssue{}
+	for i := range quotas {
+		for _, usage := range quotaResourceUsages(&quotas[i]) {
+			if !usage.NearLimit {
+				continue
+			}
+			issue := models.PodIssue{
+				Type:        models.PodIssueQuotaNearLimit,
+				Description: fmt.Sprintf("ResourceQuota %s: %s at %.0f%% of hard limit", quotas[i].Name, usage.Resource, usage.Percentage),
+				Severity:    "warning",
+				Details:     fmt.Sprintf("used %s of %s", usage.Used, usage.Hard),
+			}
+			if usage.Exhausted {
+				issue.Type = models.PodIssueQuotaExhausted
+				issue.Severity = "critical"
+			}
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
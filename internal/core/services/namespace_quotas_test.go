@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newTestQuota(namespace, name string, hard, used v1.ResourceList) *v1.ResourceQuota {
+	return &v1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1.ResourceQuotaSpec{Hard: hard},
+		Status:     v1.ResourceQuotaStatus{Hard: hard, Used: used},
+	}
+}
+
+func TestNamespaceService_GetNamespaceQuotas(t *testing.T) {
+	compute := newTestQuota("team-a", "compute",
+		v1.ResourceList{
+			v1.ResourceRequestsCPU:    resource.MustParse("4"),
+			v1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+			v1.ResourcePods:           resource.MustParse("10"),
+		},
+		v1.ResourceList{
+			v1.ResourceRequestsCPU:    resource.MustParse("3800m"),
+			v1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+			v1.ResourcePods:           resource.MustParse("4"),
+		},
+	)
+
+	priority := newTestQuota("team-a", "high-priority",
+		v1.ResourceList{v1.ResourcePods: resource.MustParse("2")},
+		v1.ResourceList{v1.ResourcePods: resource.MustParse("0")},
+	)
+	priority.Spec.ScopeSelector = &v1.ScopeSelector{
+		MatchExpressions: []v1.ScopedResourceSelectorRequirement{{
+			ScopeName: v1.ResourceQuotaScopePriorityClass,
+			Operator:  v1.ScopeSelectorOpIn,
+			Values:    []string{"high"},
+		}},
+	}
+
+	limitRange := &v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "team-a"},
+		Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{{
+			Type:           v1.LimitTypeContainer,
+			Max:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+			Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+			DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")},
+		}}},
+	}
+
+	finished := withOwner(newNodeTestPod("team-a", "batch-1", "node-a", "1", "1Gi"), "Job", "batch")
+	finished.Status.Phase = v1.PodSucceeded
+
+	fakeClient := fake.NewSimpleClientset(
+		compute, priority, limitRange,
+		withOwner(newNodeTestPod("team-a", "web-1", "node-a", "1", "512Mi"), "ReplicaSet", "web-abc123"),
+		withOwner(newNodeTestPod("team-a", "web-2", "node-a", "1", "512Mi"), "ReplicaSet", "web-abc123"),
+		withOwner(newNodeTestPod("team-a", "db-0", "node-a", "1500m", "1Gi"), "StatefulSet", "db"),
+		newNodeTestPod("team-a", "debug", "node-a", "300m", "0"),
+		finished,
+	)
+
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	report, err := service.GetNamespaceQuotas(context.Background(), "team-a")
+	require.NoError(t, err)
+	require.Len(t, report.Quotas, 2)
+
+	quota := report.Quotas[0]
+	assert.Equal(t, "compute", quota.Name)
+	assert.True(t, quota.NearLimit)
+	require.Len(t, quota.Resources, 3)
+	assert.Equal(t, "pods", quota.Resources[0].Resource)
+	assert.Equal(t, models.QuotaResourceUsage{
+		Resource: "requests.cpu", Used: "3800m", Hard: "4", Percentage: 95, NearLimit: true,
+	}, quota.Resources[1])
+
+	require.Len(t, quota.TopConsumers, 3)
+	assert.Equal(t, "Deployment", quota.TopConsumers[0].Kind)
+	assert.Equal(t, "web", quota.TopConsumers[0].Name)
+	assert.Equal(t, 2, quota.TopConsumers[0].Pods)
+	assert.Equal(t, "2", quota.TopConsumers[0].Usage["requests.cpu"])
+	assert.Equal(t, 50.0, quota.TopConsumers[0].LargestShare)
+	assert.Equal(t, "requests.cpu", quota.TopConsumers[0].LargestShareResource)
+	assert.Equal(t, "StatefulSet", quota.TopConsumers[1].Kind)
+	assert.Equal(t, "Pod", quota.TopConsumers[2].Kind)
+
+	scoped := report.Quotas[1]
+	assert.Equal(t, []string{"PriorityClass In (high)"}, scoped.ScopeSelector)
+	assert.False(t, scoped.NearLimit)
+	assert.Empty(t, scoped.TopConsumers)
+
+	require.Len(t, report.LimitRanges, 1)
+	assert.Equal(t, []models.LimitRangeItem{
+		{Type: "Container", Resource: "cpu", Max: "2", Default: "500m", DefaultRequest: "250m"},
+		{Type: "Container", Resource: "memory", Default: "512Mi"},
+	}, report.LimitRanges[0].Limits)
+
+	t.Run("quota issues in namespace errors", func(t *testing.T) {
+		exhausted := newTestQuota("team-a", "objects",
+			v1.ResourceList{"count/services.loadbalancers": resource.MustParse("0"), v1.ResourceServices: resource.MustParse("5")},
+			v1.ResourceList{"count/services.loadbalancers": resource.MustParse("0"), v1.ResourceServices: resource.MustParse("5")},
+		)
+		client := fake.NewSimpleClientset(compute, exhausted)
+		service := NewNamespaceService(client, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+		errorsReport, err := service.GetNamespaceErrors(context.Background(), "team-a", models.NamespaceErrorOptions{})
+		require.NoError(t, err)
+
+		require.Len(t, errorsReport.NamespaceIssues, 2)
+		assert.Equal(t, 1, errorsReport.CriticalIssuesCount)
+		assert.Equal(t, 1, errorsReport.WarningIssuesCount)
+
+		types := map[models.PodIssueType]int{}
+		for _, summary := range errorsReport.Summary {
+			types[summary.IssueType] = summary.Count
+		}
+		assert.Equal(t, map[models.PodIssueType]int{
+			models.PodIssueQuotaNearLimit: 1,
+			models.PodIssueQuotaExhausted: 1,
+		}, types)
+	})
+}
+
+func TestPodMatchesScope(t *testing.T) {
+	pod := newNodeTestPod("ns", "p", "node-a", "100m", "64Mi")
+	pod.Spec.PriorityClassName = "high"
+
+	assert.True(t, podMatchesScope(pod, v1.ResourceQuotaScopeNotTerminating, v1.ScopeSelectorOpExists, nil))
+	assert.False(t, podMatchesScope(pod, v1.ResourceQuotaScopeTerminating, v1.ScopeSelectorOpExists, nil))
+	assert.True(t, podMatchesScope(pod, v1.ResourceQuotaScopeNotBestEffort, v1.ScopeSelectorOpExists, nil))
+	assert.True(t, podMatchesScope(pod, v1.ResourceQuotaScopePriorityClass, v1.ScopeSelectorOpNotIn, []string{"low"}))
+	assert.False(t, podMatchesScope(pod, v1.ResourceQuotaScopePriorityClass, v1.ScopeSelectorOpDoesNotExist, nil))
+	assert.False(t, podMatchesScope(pod, v1.ResourceQuotaScopeCrossNamespacePodAffinity, v1.ScopeSelectorOpExists, nil))
+}
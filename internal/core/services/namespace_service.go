@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,37 +16,58 @@ import (
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/analysispolicy"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/pagination"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
 )
 
 type namespaceService struct {
-	k8sClient           kubernetes.Interface
-	logger              *slog.Logger
-	podRestartThreshold int
+	k8sClient kubernetes.Interface
+	logger    *slog.Logger
+	policies  *analysispolicy.Resolver
 }
 
 func NewNamespaceService(k8sClient kubernetes.Interface, cfg *config.Config, logger *slog.Logger) core.NamespaceService {
 	return &namespaceService{
-		k8sClient:           k8sClient,
-		logger:              logger,
-		podRestartThreshold: cfg.PodRestartThreshold,
+		k8sClient: k8sClient,
+		logger:    logger,
+		policies:  analysispolicy.NewResolver(k8sClient, cfg, logger),
 	}
 }
 
-func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace string) (*models.NamespaceErrorReport, error) {
+func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace string, opts models.NamespaceErrorOptions) (*models.NamespaceErrorReport, error) {
+	cursor, err := pagination.Resume(opts.Continue, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.policies.ForNamespace(ctx, namespace)
+
 	s.logger.Debug("analyzing namespace for errors",
 		"namespace", namespace,
-		"restartThreshold", s.podRestartThreshold)
+		"restartThreshold", policy.RestartThreshold,
+		"policyOverrides", policy.Overrides,
+		"ownerKinds", opts.OwnerKinds,
+		"labelSelector", opts.LabelSelector,
+		"issueType", opts.IssueType)
 
 	report := &models.NamespaceErrorReport{
 		Namespace:            namespace,
 		AnalysisTime:         time.Now(),
-		RestartThresholdUsed: s.podRestartThreshold,
+		RestartThresholdUsed: policy.RestartThreshold,
+		Policy:               policy,
+		OwnerKinds:           opts.OwnerKinds,
+		NamespaceIssues:      []models.PodIssue{},
+		Workloads:            []models.WorkloadErrorSummary{},
 		ProblematicPods:      []models.ProblematicPod{},
 		Summary:              []models.NamespaceErrorSummary{},
 	}
+	if len(report.OwnerKinds) == 0 {
+		report.OwnerKinds = []string{models.OwnerKindAll}
+	}
 
-	pods, err := s.k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	pods, err := s.k8sClient.CoreV1().Pods(namespace).List(ctx, opts.PodListOptions())
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return report, nil
@@ -53,14 +75,36 @@ func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace str
 		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
 	}
 
-	filteredPods := s.filterPodsByOwner(pods.Items)
+	jobs := s.listPodJobs(ctx, namespace, pods.Items)
+	filteredPods := s.filterPodsByOwner(pods.Items, opts.OwnerKinds, jobs)
+	filteredPods = filterPodsByOwnerName(filteredPods, opts.OwnerName, opts.NodeName, jobs)
+
+	// One namespace-wide list serves every problematic pod instead of a
+	// filtered list per pod.
+	var events *podEventIndex
+	eventList, err := s.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod",
+	})
+	if err != nil {
+		s.logger.Warn("failed to list pod events for namespace error analysis",
+			"namespace", namespace,
+			"error", err.Error())
+	} else {
+		events = newPodEventIndex(eventList.Items)
+	}
 	report.TotalPodsAnalyzed = len(filteredPods)
+	report.ExcludedPods = len(pods.Items) - len(filteredPods)
 
 	issueSummary := make(map[models.PodIssueType]*models.NamespaceErrorSummary)
+	podsWithIssues := 0
 
 	for i := range filteredPods {
 		pod := &filteredPods[i]
-		problematicPod := s.analyzePod(ctx, pod)
+		problematicPod := s.analyzePod(pod, jobs, events, policy)
+		if len(problematicPod.Issues) > 0 {
+			podsWithIssues++
+		}
+		problematicPod.Issues = filterIssuesByType(problematicPod.Issues, opts.IssueType)
 
 		if len(problematicPod.Issues) > 0 {
 			report.ProblematicPods = append(report.ProblematicPods, *problematicPod)
@@ -89,7 +133,38 @@ func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace str
 		}
 	}
 
-	report.HealthyPodsCount = report.TotalPodsAnalyzed - report.ProblematicPodsCount
+	// Pods whose issues were all filtered out by issueType are neither
+	// problematic in this report nor healthy.
+	report.HealthyPodsCount = report.TotalPodsAnalyzed - podsWithIssues
+
+	quotas, err := s.k8sClient.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		s.logger.Warn("failed to list resource quotas for namespace error analysis",
+			"namespace", namespace,
+			"error", err.Error())
+	} else {
+		report.NamespaceIssues = filterIssuesByType(ignoredIssues(quotaIssues(quotas.Items), policy), opts.IssueType)
+	}
+
+	for _, issue := range report.NamespaceIssues {
+		if summary, exists := issueSummary[issue.Type]; exists {
+			summary.Count++
+		} else {
+			issueSummary[issue.Type] = &models.NamespaceErrorSummary{
+				IssueType:    issue.Type,
+				Count:        1,
+				Description:  s.getIssueTypeDescription(issue.Type),
+				AffectedPods: []string{},
+			}
+		}
+
+		switch issue.Severity {
+		case "critical":
+			report.CriticalIssuesCount++
+		case "warning":
+			report.WarningIssuesCount++
+		}
+	}
 
 	for _, summary := range issueSummary {
 		report.Summary = append(report.Summary, *summary)
@@ -98,15 +173,16 @@ func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace str
 		return report.Summary[i].Count > report.Summary[j].Count
 	})
 
-	sort.Slice(report.ProblematicPods, func(i, j int) bool {
-		iCritical := s.hasCriticalIssue(&report.ProblematicPods[i])
-		jCritical := s.hasCriticalIssue(&report.ProblematicPods[j])
-		if iCritical != jCritical {
-			return iCritical
-		}
-		return report.ProblematicPods[i].RestartCount > report.ProblematicPods[j].RestartCount
+	sort.SliceStable(report.ProblematicPods, func(i, j int) bool {
+		return s.moreSevere(&report.ProblematicPods[i], &report.ProblematicPods[j])
 	})
 
+	report.Workloads = s.rollupWorkloads(ctx, namespace, filteredPods, report.ProblematicPods, jobs)
+
+	pagination.SortByKey(report.ProblematicPods, problematicPodKey)
+	report.ProblematicPods, report.Continue = pagination.Page(
+		report.ProblematicPods, problematicPodKey, opts.Limit, cursor)
+
 	s.logger.Info("namespace error analysis complete",
 		"namespace", namespace,
 		"totalPods", report.TotalPodsAnalyzed,
@@ -117,23 +193,135 @@ func (s *namespaceService) GetNamespaceErrors(ctx context.Context, namespace str
 	return report, nil
 }
 
-func (s *namespaceService) filterPodsByOwner(pods []v1.Pod) []v1.Pod {
+// filterPodsByOwner keeps the pods whose direct owner or owning workload is
+// one of ownerKinds, compared case-insensitively. No kinds keeps every pod.
+func (s *namespaceService) filterPodsByOwner(pods []v1.Pod, ownerKinds []string, jobs workload.Jobs) []v1.Pod {
+	if len(ownerKinds) == 0 {
+		return pods
+	}
+
+	wanted := make(map[string]bool, len(ownerKinds))
+	for _, kind := range ownerKinds {
+		if strings.EqualFold(kind, models.OwnerKindAll) {
+			return pods
+		}
+		wanted[strings.ToLower(kind)] = true
+	}
+
 	filtered := []v1.Pod{}
+	for i := range pods {
+		pod := &pods[i]
+		directKind := "Pod"
+		if owner := workload.Owner(pod); owner != nil {
+			directKind = owner.Kind
+		}
+		workloadKind, _ := workload.Of(pod, jobs)
 
+		if wanted[strings.ToLower(directKind)] || wanted[strings.ToLower(workloadKind)] {
+			filtered = append(filtered, *pod)
+		}
+	}
+
+	return filtered
+}
+
+// filterPodsByOwnerName keeps the pods whose direct owner or owning workload
+// is named ownerName and that run on nodeName. Empty values match every pod.
+// The node is also sent as a field selector; checking it here covers API
+// servers and fakes that ignore the selector.
+func filterPodsByOwnerName(pods []v1.Pod, ownerName, nodeName string, jobs workload.Jobs) []v1.Pod {
+	if ownerName == "" && nodeName == "" {
+		return pods
+	}
+
+	filtered := []v1.Pod{}
 	for i := range pods {
 		pod := &pods[i]
-		for _, owner := range pod.OwnerReferences {
-			if owner.Kind == "ReplicaSet" || owner.Kind == "StatefulSet" {
-				filtered = append(filtered, *pod)
-				break
+		if nodeName != "" && pod.Spec.NodeName != nodeName {
+			continue
+		}
+		if ownerName != "" {
+			owner := workload.Owner(pod)
+			if owner == nil {
+				continue
+			}
+			if _, workloadName := workload.Of(pod, jobs); workloadName != ownerName && owner.Name != ownerName {
+				continue
 			}
 		}
+		filtered = append(filtered, *pod)
 	}
 
 	return filtered
 }
 
-func (s *namespaceService) analyzePod(ctx context.Context, pod *v1.Pod) *models.ProblematicPod {
+// filterIssuesByType keeps the issues of issueType, compared
+// case-insensitively. An empty issueType keeps every issue.
+func filterIssuesByType(issues []models.PodIssue, issueType string) []models.PodIssue {
+	if issueType == "" {
+		return issues
+	}
+
+	filtered := []models.PodIssue{}
+	for _, issue := range issues {
+		if strings.EqualFold(string(issue.Type), issueType) {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered
+}
+
+// ignoredIssues drops the issues whose type the namespace policy ignores.
+func ignoredIssues(issues []models.PodIssue, policy models.AnalysisPolicy) []models.PodIssue {
+	if len(policy.IgnoreIssueTypes) == 0 {
+		return issues
+	}
+
+	kept := []models.PodIssue{}
+	for _, issue := range issues {
+		if !policy.Ignores(string(issue.Type)) {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
+}
+
+// moreSevere orders pods with critical issues first, then by restart count,
+// so that each workload's representative pod is its most severe one.
+func (s *namespaceService) moreSevere(a, b *models.ProblematicPod) bool {
+	if critical := s.hasCriticalIssue(a); critical != s.hasCriticalIssue(b) {
+		return critical
+	}
+	return a.RestartCount > b.RestartCount
+}
+
+// problematicPodKey orders the listed pods by namespace and name. Unlike
+// issues or restart counts these do not change between requests, so a pod is
+// never skipped or repeated while a client pages through the report.
+func problematicPodKey(pod models.ProblematicPod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+// listPodJobs returns the Jobs owning any of the pods. Jobs
+// are only listed when a pod is Job-owned; on failure batch pods are still
+// analyzed, just without Job status or CronJob attribution.
+func (s *namespaceService) listPodJobs(ctx context.Context, namespace string, pods []v1.Pod) workload.Jobs {
+	jobs, err := workload.ListJobs(ctx, s.k8sClient, namespace, pods)
+	if err != nil {
+		s.logger.Warn("failed to list jobs for namespace error analysis",
+			"namespace", namespace,
+			"error", err.Error())
+		return nil
+	}
+	return jobs
+}
+
+func isBatchPod(pod *v1.Pod) bool {
+	owner := workload.Owner(pod)
+	return owner != nil && owner.Kind == "Job"
+}
+
+func (s *namespaceService) analyzePod(pod *v1.Pod, jobs workload.Jobs, events *podEventIndex, policy models.AnalysisPolicy) *models.ProblematicPod {
 	now := time.Now()
 	age := now.Sub(pod.CreationTimestamp.Time)
 
@@ -147,20 +335,36 @@ func (s *namespaceService) analyzePod(ctx context.Context, pod *v1.Pod) *models.
 		Issues:    []models.PodIssue{},
 	}
 
-	s.setOwnerInfo(pod, problematicPod)
+	if workload.Owner(pod) != nil {
+		problematicPod.OwnerKind, problematicPod.OwnerName = workload.Of(pod, jobs)
+	}
 
 	problematicPod.RestartCount = s.getTotalRestartCount(pod)
 
-	if int(problematicPod.RestartCount) > s.podRestartThreshold {
+	batch := isBatchPod(pod)
+	if batch {
+		// A Completed batch pod finished its work; nothing it did on the way
+		// there is a problem any more.
+		if pod.Status.Phase == v1.PodSucceeded {
+			return problematicPod
+		}
+		if pod.Status.Phase == v1.PodFailed {
+			if issue := s.batchFailureIssue(pod, jobs); issue != nil {
+				problematicPod.Issues = append(problematicPod.Issues, *issue)
+			}
+		}
+	}
+
+	if int(problematicPod.RestartCount) > policy.RestartThreshold {
 		problematicPod.Issues = append(problematicPod.Issues, models.PodIssue{
 			Type:        models.PodIssueHighRestarts,
-			Description: fmt.Sprintf("Pod has restarted %d times (threshold: %d)", problematicPod.RestartCount, s.podRestartThreshold),
+			Description: fmt.Sprintf("Pod has restarted %d times (threshold: %d)", problematicPod.RestartCount, policy.RestartThreshold),
 			Severity:    "critical",
 			Details:     s.getRestartDetails(pod),
 		})
 	}
 
-	if pod.Status.Phase == v1.PodPending && age > 5*time.Minute {
+	if pod.Status.Phase == v1.PodPending && age > policy.PendingGracePeriod {
 		issue := models.PodIssue{
 			Type:        models.PodIssuePending,
 			Description: fmt.Sprintf("Pod has been pending for %s", s.formatDuration(age)),
@@ -182,35 +386,58 @@ func (s *namespaceService) analyzePod(ctx context.Context, pod *v1.Pod) *models.
 		problematicPod.Issues = append(problematicPod.Issues, issue)
 	}
 
-	s.checkContainerStatuses(pod, problematicPod)
+	s.checkContainerStatuses(pod, problematicPod, batch)
+	problematicPod.Issues = ignoredIssues(problematicPod.Issues, policy)
 
-	if len(problematicPod.Issues) > 0 {
-		events, err := s.getRecentPodEvents(ctx, pod)
-		if err == nil {
-			problematicPod.Events = events
-		}
+	if len(problematicPod.Issues) > 0 && events != nil {
+		problematicPod.Events = recentWarningEvents(events.forPod(pod))
 	}
 
 	return problematicPod
 }
 
-func (s *namespaceService) setOwnerInfo(pod *v1.Pod, problematicPod *models.ProblematicPod) {
-	for _, owner := range pod.OwnerReferences {
-		if owner.Kind == "ReplicaSet" {
-			problematicPod.OwnerKind = "Deployment"
-			parts := strings.Split(owner.Name, "-")
-			if len(parts) > 1 {
-				problematicPod.OwnerName = strings.Join(parts[:len(parts)-1], "-")
-			} else {
-				problematicPod.OwnerName = owner.Name
+// batchFailureIssue judges a Failed Job pod by its Job: a failed Job is
+// critical, a Job still retrying makes the attempt a warning, and a Job that
+// eventually completed makes the failed attempt history rather than an issue.
+func (s *namespaceService) batchFailureIssue(pod *v1.Pod, jobs workload.Jobs) *models.PodIssue {
+	jobName := workload.Owner(pod).Name
+	job, ok := jobs.Get(pod.Namespace, jobName)
+	if !ok {
+		return &models.PodIssue{
+			Type:        models.PodIssueFailed,
+			Description: fmt.Sprintf("Pod of Job %s failed", jobName),
+			Severity:    "warning",
+			Details:     pod.Status.Reason,
+		}
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			return &models.PodIssue{
+				Type:        models.PodIssueJobFailed,
+				Description: fmt.Sprintf("Job %s failed: %s", jobName, condition.Reason),
+				Severity:    "critical",
+				Details:     condition.Message,
 			}
-			break
-		} else if owner.Kind == "StatefulSet" {
-			problematicPod.OwnerKind = "StatefulSet"
-			problematicPod.OwnerName = owner.Name
-			break
 		}
 	}
+
+	backoffLimit := int32(6)
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+	return &models.PodIssue{
+		Type:        models.PodIssueFailed,
+		Description: fmt.Sprintf("Pod of Job %s failed; Job is retrying (%d/%d failures)", jobName, job.Status.Failed, backoffLimit),
+		Severity:    "warning",
+		Details:     pod.Status.Reason,
+	}
 }
 
 func (s *namespaceService) getTotalRestartCount(pod *v1.Pod) int32 {
@@ -245,7 +472,9 @@ func (s *namespaceService) getRestartDetails(pod *v1.Pod) string {
 	return ""
 }
 
-func (s *namespaceService) checkContainerStatuses(pod *v1.Pod, problematicPod *models.ProblematicPod) {
+// checkContainerStatuses reports waiting and terminated containers. Non-zero
+// exits are skipped for batch pods, whose failures are judged by their Job.
+func (s *namespaceService) checkContainerStatuses(pod *v1.Pod, problematicPod *models.ProblematicPod, batch bool) {
 	for i := range pod.Status.ContainerStatuses {
 		status := &pod.Status.ContainerStatuses[i]
 		if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
@@ -267,7 +496,7 @@ func (s *namespaceService) checkContainerStatuses(pod *v1.Pod, problematicPod *m
 			})
 		}
 
-		if status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+		if !batch && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
 			problematicPod.Issues = append(problematicPod.Issues, models.PodIssue{
 				Type:        models.PodIssueFailed,
 				Description: fmt.Sprintf("Container %s terminated with exit code %d", status.Name, status.State.Terminated.ExitCode),
@@ -278,46 +507,6 @@ func (s *namespaceService) checkContainerStatuses(pod *v1.Pod, problematicPod *m
 	}
 }
 
-func (s *namespaceService) getRecentPodEvents(ctx context.Context, pod *v1.Pod) ([]models.EventInfo, error) {
-	fieldSelector := fmt.Sprintf("involvedObject.kind=Pod,involvedObject.name=%s,involvedObject.namespace=%s",
-		pod.Name, pod.Namespace)
-
-	eventList, err := s.k8sClient.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fieldSelector,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	events := []models.EventInfo{}
-	cutoff := time.Now().Add(-1 * time.Hour)
-
-	for i := range eventList.Items {
-		event := &eventList.Items[i]
-		if event.LastTimestamp.After(cutoff) && event.Type == "Warning" {
-			events = append(events, models.EventInfo{
-				Type:           event.Type,
-				Reason:         event.Reason,
-				Message:        event.Message,
-				FirstTimestamp: event.FirstTimestamp,
-				LastTimestamp:  event.LastTimestamp,
-				Count:          event.Count,
-				Source:         fmt.Sprintf("%s/%s", event.Source.Component, event.Source.Host),
-			})
-		}
-	}
-
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
-	})
-
-	if len(events) > 5 {
-		events = events[:5]
-	}
-
-	return events, nil
-}
-
 func (s *namespaceService) hasCriticalIssue(pod *models.ProblematicPod) bool {
 	for _, issue := range pod.Issues {
 		if issue.Severity == "critical" {
@@ -336,6 +525,9 @@ func (s *namespaceService) getIssueTypeDescription(issueType models.PodIssueType
 		models.PodIssueImagePull:           "Pods with image pull errors",
 		models.PodIssueResourceConstraints: "Pods with insufficient resources",
 		models.PodIssueUnschedulable:       "Pods that cannot be scheduled",
+		models.PodIssueJobFailed:           "Pods of failed Jobs",
+		models.PodIssueQuotaNearLimit:      "ResourceQuota resources nearly used up",
+		models.PodIssueQuotaExhausted:      "ResourceQuota resources used up; new pods are rejected",
 	}
 
 	if desc, ok := descriptions[issueType]; ok {
@@ -364,3 +556,111 @@ func (s *namespaceService) formatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%dd", days)
 }
+
+func (s *namespaceService) GetNamespaceStartupStats(ctx context.Context, namespace string) (*models.NamespaceStartupStats, error) {
+	s.logger.Debug("computing namespace startup statistics", "namespace", namespace)
+
+	pods, err := s.k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+
+	events := newPodEventIndex(nil)
+	eventList, err := s.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod",
+	})
+	if err != nil {
+		s.logger.Warn("failed to list pod events for startup statistics",
+			"namespace", namespace,
+			"error", err.Error())
+	} else {
+		events = newPodEventIndex(eventList.Items)
+	}
+
+	stats := &models.NamespaceStartupStats{
+		Namespace:  namespace,
+		AnalyzedAt: time.Now(),
+		TotalPods:  len(pods.Items),
+		Workloads:  []models.WorkloadStartupStats{},
+	}
+
+	type workloadSamples struct {
+		kind, name                                      string
+		timeToReady, scheduling, imagePull, init, ready []float64
+		slowestPod                                      string
+		slowest                                         float64
+	}
+	workloads := make(map[string]*workloadSamples)
+	allTimeToReady := []float64{}
+	jobs := s.listPodJobs(ctx, namespace, pods.Items)
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		latency := computeStartupLatency(pod, events.forPod(pod), jobs)
+
+		// Restarted pods and pods whose readiness probe failed may report a
+		// later Ready transition than the first startup, so they would skew
+		// the distribution.
+		if !latency.Ready || latency.RestartCount > 0 || latency.ReadinessProbeFailures > 0 {
+			continue
+		}
+		stats.ReadyPods++
+
+		key := latency.WorkloadKind + "/" + latency.WorkloadName
+		samples, exists := workloads[key]
+		if !exists {
+			samples = &workloadSamples{kind: latency.WorkloadKind, name: latency.WorkloadName}
+			workloads[key] = samples
+		}
+
+		samples.timeToReady = append(samples.timeToReady, latency.TimeToReadySeconds)
+		samples.scheduling = append(samples.scheduling, latency.SchedulingSeconds)
+		samples.imagePull = append(samples.imagePull, latency.ImagePullSeconds)
+		samples.init = append(samples.init, latency.InitContainersSeconds)
+		samples.ready = append(samples.ready, latency.AppReadinessSeconds)
+		if latency.TimeToReadySeconds >= samples.slowest {
+			samples.slowest = latency.TimeToReadySeconds
+			samples.slowestPod = pod.Name
+		}
+
+		allTimeToReady = append(allTimeToReady, latency.TimeToReadySeconds)
+	}
+
+	stats.TimeToReady = startupPercentiles(allTimeToReady)
+
+	for _, samples := range workloads {
+		workload := models.WorkloadStartupStats{
+			WorkloadKind:   samples.kind,
+			WorkloadName:   samples.name,
+			ReadyPods:      len(samples.timeToReady),
+			TimeToReady:    startupPercentiles(samples.timeToReady),
+			Scheduling:     startupPercentiles(samples.scheduling),
+			ImagePull:      startupPercentiles(samples.imagePull),
+			InitContainers: startupPercentiles(samples.init),
+			AppReadiness:   startupPercentiles(samples.ready),
+			SlowestPod:     samples.slowestPod,
+		}
+		workload.Bottleneck = startupBottleneck(map[string]float64{
+			models.StartupPhaseScheduling:     workload.Scheduling.P50,
+			models.StartupPhaseImagePull:      workload.ImagePull.P50,
+			models.StartupPhaseInitContainers: workload.InitContainers.P50,
+			models.StartupPhaseAppReadiness:   workload.AppReadiness.P50,
+		})
+		stats.Workloads = append(stats.Workloads, workload)
+	}
+
+	sort.Slice(stats.Workloads, func(i, j int) bool {
+		if stats.Workloads[i].TimeToReady.P95 != stats.Workloads[j].TimeToReady.P95 {
+			return stats.Workloads[i].TimeToReady.P95 > stats.Workloads[j].TimeToReady.P95
+		}
+		return stats.Workloads[i].WorkloadName < stats.Workloads[j].WorkloadName
+	})
+
+	s.logger.Info("namespace startup statistics complete",
+		"namespace", namespace,
+		"totalPods", stats.TotalPods,
+		"readyPods", stats.ReadyPods,
+		"workloads", len(stats.Workloads))
+
+	return stats, nil
+}
@@ -8,11 +8,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
-	ktesting "k8s.io/client-go/testing"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
@@ -23,9 +23,11 @@ func TestNamespaceService_GetNamespaceErrors(t *testing.T) {
 		name                string
 		namespace           string
 		restartThreshold    int
+		ownerKinds          []string
 		pods                []runtime.Object
 		events              []runtime.Object
 		expectedTotalPods   int
+		expectedExcluded    int
 		expectedProblematic int
 		expectedCritical    int
 		expectedWarning     int
@@ -103,9 +105,51 @@ func TestNamespaceService_GetNamespaceErrors(t *testing.T) {
 				createCrashLoopPod("test-ns", "crash-pod", "statefulset"),
 				createPod("test-ns", "job-pod", "job", "Running", 0, 10),
 			},
+			expectedTotalPods:   4,
+			expectedProblematic: 3,
+			expectedCritical:    4,
+		},
+		{
+			name:             "owner kinds filter",
+			namespace:        "test-ns",
+			restartThreshold: 3,
+			ownerKinds:       []string{"Deployment", "daemonset"},
+			pods: []runtime.Object{
+				createPod("test-ns", "restart-pod", "deployment", "Running", 0, 5),
+				createPod("test-ns", "agent", "daemonset", "Running", 0, 5),
+				createCrashLoopPod("test-ns", "crash-pod", "statefulset"),
+				createPod("test-ns", "job-pod", "job", "Running", 0, 10),
+			},
+			expectedTotalPods:   2,
+			expectedExcluded:    2,
+			expectedProblematic: 2,
+			expectedCritical:    2,
+		},
+		{
+			name:             "completed job pod is healthy",
+			namespace:        "test-ns",
+			restartThreshold: 3,
+			pods: []runtime.Object{
+				createPod("test-ns", "done", "job", "Succeeded", 0, 4),
+			},
+			expectedTotalPods: 1,
+		},
+		{
+			name:             "failed job pods judged by job outcome",
+			namespace:        "test-ns",
+			restartThreshold: 5,
+			pods: []runtime.Object{
+				createFailedJobPod("test-ns", "retrying", "retrying-job"),
+				createFailedJobPod("test-ns", "given-up", "failed-job"),
+				createFailedJobPod("test-ns", "flaky", "completed-job"),
+				createJob("test-ns", "retrying-job", ""),
+				createJob("test-ns", "failed-job", batchv1.JobFailed),
+				createJob("test-ns", "completed-job", batchv1.JobComplete),
+			},
 			expectedTotalPods:   3,
 			expectedProblematic: 2,
-			expectedCritical:    3,
+			expectedCritical:    1,
+			expectedWarning:     1,
 		},
 		{
 			name:             "configurable restart threshold",
@@ -133,7 +177,7 @@ func TestNamespaceService_GetNamespaceErrors(t *testing.T) {
 			service := NewNamespaceService(fakeClient, cfg, logger)
 
 			ctx := context.Background()
-			report, err := service.GetNamespaceErrors(ctx, tt.namespace)
+			report, err := service.GetNamespaceErrors(ctx, tt.namespace, models.NamespaceErrorOptions{OwnerKinds: tt.ownerKinds})
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -145,6 +189,7 @@ func TestNamespaceService_GetNamespaceErrors(t *testing.T) {
 			assert.Equal(t, tt.namespace, report.Namespace)
 			assert.Equal(t, tt.restartThreshold, report.RestartThresholdUsed)
 			assert.Equal(t, tt.expectedTotalPods, report.TotalPodsAnalyzed)
+			assert.Equal(t, tt.expectedExcluded, report.ExcludedPods)
 			assert.Equal(t, tt.expectedProblematic, report.ProblematicPodsCount)
 			assert.Equal(t, tt.expectedCritical, report.CriticalIssuesCount)
 			assert.Equal(t, tt.expectedWarning, report.WarningIssuesCount)
@@ -154,9 +199,10 @@ func TestNamespaceService_GetNamespaceErrors(t *testing.T) {
 
 func TestNamespaceService_filterPodsByOwner(t *testing.T) {
 	tests := []struct {
-		name     string
-		pods     []v1.Pod
-		expected int
+		name       string
+		pods       []v1.Pod
+		ownerKinds []string
+		expected   int
 	}{
 		{
 			name:     "empty list",
@@ -179,7 +225,36 @@ func TestNamespaceService_filterPodsByOwner(t *testing.T) {
 				*createPod("ns", "job-pod", "job", "Running", 0, 0),
 				*createPod("ns", "daemonset-pod", "daemonset", "Running", 0, 0),
 			},
-			expected: 2,
+			expected: 4,
+		},
+		{
+			name: "selected owner kinds",
+			pods: []v1.Pod{
+				*createPod("ns", "deploy-pod", "deployment", "Running", 0, 0),
+				*createPod("ns", "ss-pod", "statefulset", "Running", 0, 0),
+				*createPod("ns", "job-pod", "job", "Running", 0, 0),
+				*createPod("ns", "daemonset-pod", "daemonset", "Running", 0, 0),
+			},
+			ownerKinds: []string{"DaemonSet", "Job"},
+			expected:   2,
+		},
+		{
+			name: "replicaset selects deployment pods",
+			pods: []v1.Pod{
+				*createPod("ns", "deploy-pod", "deployment", "Running", 0, 0),
+				*createPod("ns", "ss-pod", "statefulset", "Running", 0, 0),
+			},
+			ownerKinds: []string{"ReplicaSet"},
+			expected:   1,
+		},
+		{
+			name: "all overrides other kinds",
+			pods: []v1.Pod{
+				*createPod("ns", "deploy-pod", "deployment", "Running", 0, 0),
+				*createPod("ns", "ss-pod", "statefulset", "Running", 0, 0),
+			},
+			ownerKinds: []string{"Job", "all"},
+			expected:   2,
 		},
 		{
 			name: "no owner references",
@@ -191,21 +266,27 @@ func TestNamespaceService_filterPodsByOwner(t *testing.T) {
 					},
 				},
 			},
-			expected: 0,
+			expected: 1,
+		},
+		{
+			name: "pod kind selects bare pods",
+			pods: []v1.Pod{
+				*createPod("ns", "bare", "", "Running", 0, 0),
+				*createPod("ns", "deploy-pod", "deployment", "Running", 0, 0),
+			},
+			ownerKinds: []string{"Pod"},
+			expected:   1,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &config.Config{PodRestartThreshold: 5}
-			logger := slog.Default()
 			service := &namespaceService{
-				k8sClient:           fake.NewSimpleClientset(),
-				logger:              logger,
-				podRestartThreshold: cfg.PodRestartThreshold,
+				k8sClient: fake.NewSimpleClientset(),
+				logger:    slog.Default(),
 			}
 
-			filtered := service.filterPodsByOwner(tt.pods)
+			filtered := service.filterPodsByOwner(tt.pods, tt.ownerKinds, nil)
 			assert.Len(t, filtered, tt.expected)
 		})
 	}
@@ -245,22 +326,13 @@ func TestNamespaceService_analyzePod(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &config.Config{PodRestartThreshold: 5}
-			logger := slog.Default()
-			fakeClient := fake.NewSimpleClientset()
-
-			fakeClient.PrependReactor("list", "events", func(action ktesting.Action) (bool, runtime.Object, error) {
-				return true, &v1.EventList{Items: []v1.Event{}}, nil
-			})
-
 			service := &namespaceService{
-				k8sClient:           fakeClient,
-				logger:              logger,
-				podRestartThreshold: cfg.PodRestartThreshold,
+				k8sClient: fake.NewSimpleClientset(),
+				logger:    slog.Default(),
 			}
+			policy := models.AnalysisPolicy{RestartThreshold: 5, PendingGracePeriod: 5 * time.Minute}
 
-			ctx := context.Background()
-			result := service.analyzePod(ctx, tt.pod)
+			result := service.analyzePod(tt.pod, nil, newPodEventIndex(nil), policy)
 
 			assert.Equal(t, tt.pod.Name, result.Name)
 			assert.Len(t, result.Issues, tt.expectedIssues)
@@ -300,6 +372,7 @@ func createPod(namespace, name, ownerKind, phase string, _, restartCount int32)
 
 	switch ownerKind {
 	case "deployment":
+		pod.Labels = map[string]string{"pod-template-hash": "abc123"}
 		pod.OwnerReferences = []metav1.OwnerReference{
 			{
 				Kind: "ReplicaSet",
@@ -332,6 +405,28 @@ func createPod(namespace, name, ownerKind, phase string, _, restartCount int32)
 	return pod
 }
 
+func createFailedJobPod(namespace, name, jobName string) *v1.Pod {
+	pod := createPod(namespace, name, "job", "Failed", 0, 0)
+	pod.OwnerReferences[0].Name = jobName
+	pod.Status.ContainerStatuses[0].State = v1.ContainerState{
+		Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+	}
+	return pod
+}
+
+func createJob(namespace, name string, condition batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     batchv1.JobStatus{Failed: 1},
+	}
+	if condition != "" {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: condition, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}
+	}
+	return job
+}
+
 func createPendingPod(namespace, name, ownerKind string, creationTime time.Time) *v1.Pod {
 	pod := createPod(namespace, name, ownerKind, "Pending", 0, 0)
 	pod.CreationTimestamp = metav1.NewTime(creationTime)
@@ -372,3 +467,31 @@ func createMultiIssuePod(namespace, name, ownerKind string) *v1.Pod {
 	pod.Status.ContainerStatuses[0].RestartCount = 10
 	return pod
 }
+
+func TestNamespaceService_GetNamespaceErrors_NamespacePolicy(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "batch",
+			Annotations: map[string]string{
+				models.AnnotationRestartThreshold:   "50",
+				models.AnnotationIgnoreIssueTypes:   "ImagePullError",
+				models.AnnotationPendingGracePeriod: "2h",
+			},
+		}},
+		createPod("batch", "worker", "", "Running", 0, 20),
+		createImagePullErrorPod("batch", "puller", ""),
+		createCrashLoopPod("batch", "crasher", ""),
+	)
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	report, err := service.GetNamespaceErrors(context.Background(), "batch", models.NamespaceErrorOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 50, report.RestartThresholdUsed)
+	assert.Equal(t, []string{"ImagePullError"}, report.Policy.IgnoreIssueTypes)
+	assert.Equal(t, "2h0m0s", report.Policy.PendingGracePeriodString)
+	assert.Len(t, report.Policy.Overrides, 3)
+	require.Len(t, report.ProblematicPods, 1)
+	assert.Equal(t, "crasher", report.ProblematicPods[0].Name)
+	assert.Equal(t, 2, report.HealthyPodsCount)
+}
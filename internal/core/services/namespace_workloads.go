@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
+)
+
+// rollupWorkloads groups problematic pods by their top-level owner. The
+// problematic pods must already be sorted most severe first, so the first
+// pod seen for a workload is its representative.
+func (s *namespaceService) rollupWorkloads(ctx context.Context, namespace string, analyzed []v1.Pod, problematic []models.ProblematicPod, jobs workload.Jobs) []models.WorkloadErrorSummary {
+	workloads := []models.WorkloadErrorSummary{}
+	if len(problematic) == 0 {
+		return workloads
+	}
+
+	analyzedByWorkload := make(map[string]int)
+	for i := range analyzed {
+		kind, name := workload.Of(&analyzed[i], jobs)
+		analyzedByWorkload[kind+"/"+name]++
+	}
+
+	index := make(map[string]int)
+	issueTypes := make(map[string]map[models.PodIssueType]bool)
+	critical := make(map[string]bool)
+
+	for i := range problematic {
+		pod := &problematic[i]
+		kind, name := pod.OwnerKind, pod.OwnerName
+		if kind == "" {
+			kind, name = "Pod", pod.Name
+		}
+		key := kind + "/" + name
+
+		j, ok := index[key]
+		if !ok {
+			j = len(workloads)
+			index[key] = j
+			issueTypes[key] = make(map[models.PodIssueType]bool)
+			workloads = append(workloads, models.WorkloadErrorSummary{
+				Kind:              kind,
+				Name:              name,
+				AnalyzedPods:      analyzedByWorkload[key],
+				IssueTypes:        []models.PodIssueType{},
+				RepresentativePod: pod.Name,
+			})
+		}
+
+		workloads[j].ProblematicPods++
+		for _, issue := range pod.Issues {
+			if !issueTypes[key][issue.Type] {
+				issueTypes[key][issue.Type] = true
+				workloads[j].IssueTypes = append(workloads[j].IssueTypes, issue.Type)
+			}
+			if issue.Severity == "critical" {
+				critical[key] = true
+			}
+		}
+	}
+
+	replicas := s.workloadReplicas(ctx, namespace, workloads, jobs)
+	for i := range workloads {
+		summary := &workloads[i]
+		key := summary.Kind + "/" + summary.Name
+
+		if status, ok := replicas[workload.Ref{Namespace: namespace, Kind: summary.Kind, Name: summary.Name}]; ok {
+			summary.StatusKnown = true
+			summary.DesiredReplicas = status.Desired
+			summary.ReadyReplicas = status.Ready
+			summary.AvailableReplicas = status.Available
+		}
+		summary.Severity = workloadSeverity(summary, critical[key])
+	}
+
+	// Blast radius: the workloads with the most broken pods come first, and
+	// among equals those that are actually losing capacity.
+	sort.SliceStable(workloads, func(i, j int) bool {
+		if workloads[i].ProblematicPods != workloads[j].ProblematicPods {
+			return workloads[i].ProblematicPods > workloads[j].ProblematicPods
+		}
+		if workloads[i].Severity != workloads[j].Severity {
+			return workloads[i].Severity == "critical"
+		}
+		if workloads[i].Kind != workloads[j].Kind {
+			return workloads[i].Kind < workloads[j].Kind
+		}
+		return workloads[i].Name < workloads[j].Name
+	})
+
+	return workloads
+}
+
+// workloadSeverity is critical when a pod has a critical issue and the
+// workload is short of available replicas, or its status is unknown. A
+// workload that still serves all desired replicas is only degraded.
+func workloadSeverity(workload *models.WorkloadErrorSummary, hasCritical bool) string {
+	if !hasCritical {
+		return "warning"
+	}
+	if workload.StatusKnown && workload.AvailableReplicas >= workload.DesiredReplicas {
+		return "warning"
+	}
+	return "critical"
+}
+
+// workloadReplicas reads replica counts for the kinds of the rolled-up
+// workloads. Kinds that fail to list are left unknown.
+func (s *namespaceService) workloadReplicas(ctx context.Context, namespace string, workloads []models.WorkloadErrorSummary, jobs workload.Jobs) map[workload.Ref]workload.Replicas {
+	kinds := make([]string, 0, len(workloads))
+	for i := range workloads {
+		kinds = append(kinds, workloads[i].Kind)
+	}
+
+	replicas, err := workload.ListReplicas(ctx, s.k8sClient, namespace, jobs, kinds...)
+	if err != nil {
+		s.logger.Warn("failed to list workloads for namespace error rollup",
+			"namespace", namespace,
+			"error", err.Error())
+	}
+	return replicas
+}
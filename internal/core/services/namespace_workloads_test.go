@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// withOwner sets the pod's owner. ReplicaSets are named <deployment>-<hash>
+// and get the matching pod-template-hash label, as a Deployment would set.
+func withOwner(pod *v1.Pod, kind, name string) *v1.Pod {
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: kind, Name: name}}
+	delete(pod.Labels, "pod-template-hash")
+	if i := strings.LastIndex(name, "-"); kind == "ReplicaSet" && i > 0 {
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels["pod-template-hash"] = name[i+1:]
+	}
+	return pod
+}
+
+func TestNamespaceService_WorkloadRollup(t *testing.T) {
+	replicas := int32(3)
+	fakeClient := fake.NewSimpleClientset(
+		withOwner(createCrashLoopPod("test-ns", "web-abc123-1", "deployment"), "ReplicaSet", "web-abc123"),
+		withOwner(createCrashLoopPod("test-ns", "web-abc123-2", "deployment"), "ReplicaSet", "web-abc123"),
+		withOwner(createPod("test-ns", "web-abc123-3", "deployment", "Running", 0, 9), "ReplicaSet", "web-abc123"),
+		withOwner(createPod("test-ns", "agent-x1", "daemonset", "Running", 0, 9), "DaemonSet", "agent"),
+		withOwner(createPod("test-ns", "agent-x2", "daemonset", "Running", 0, 0), "DaemonSet", "agent"),
+		createCrashLoopPod("test-ns", "debug", ""),
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test-ns"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 1, AvailableReplicas: 1},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test-ns"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 2, NumberAvailable: 2},
+		},
+	)
+
+	service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+	report, err := service.GetNamespaceErrors(context.Background(), "test-ns", models.NamespaceErrorOptions{})
+	require.NoError(t, err)
+	require.Len(t, report.Workloads, 3)
+
+	web := report.Workloads[0]
+	assert.Equal(t, "Deployment", web.Kind)
+	assert.Equal(t, "web", web.Name)
+	assert.True(t, web.StatusKnown)
+	assert.Equal(t, int32(3), web.DesiredReplicas)
+	assert.Equal(t, int32(1), web.AvailableReplicas)
+	assert.Equal(t, 3, web.AnalyzedPods)
+	assert.Equal(t, 3, web.ProblematicPods)
+	assert.ElementsMatch(t, []models.PodIssueType{models.PodIssueCrashLoop, models.PodIssueHighRestarts}, web.IssueTypes)
+	assert.Equal(t, "critical", web.Severity)
+	assert.Equal(t, "web-abc123-3", web.RepresentativePod)
+
+	bare := report.Workloads[1]
+	assert.Equal(t, "Pod", bare.Kind)
+	assert.Equal(t, "debug", bare.Name)
+	assert.False(t, bare.StatusKnown)
+	assert.Equal(t, "critical", bare.Severity)
+
+	agent := report.Workloads[2]
+	assert.Equal(t, "DaemonSet", agent.Kind)
+	assert.Equal(t, 2, agent.AnalyzedPods)
+	assert.Equal(t, 1, agent.ProblematicPods)
+	assert.Equal(t, "warning", agent.Severity, "all desired replicas are still available")
+}
+
+func TestNamespaceService_WorkloadRollup_CronJob(t *testing.T) {
+	isController := true
+	cronJobRun := func(name string, created time.Time, succeeded int32) *batchv1.Job {
+		job := createJob("test-ns", name, "")
+		if succeeded == 0 {
+			job = createJob("test-ns", name, batchv1.JobFailed)
+		}
+		job.CreationTimestamp = metav1.NewTime(created)
+		job.OwnerReferences = []metav1.OwnerReference{{Kind: "CronJob", Name: "report", Controller: &isController}}
+		job.Status.Succeeded = succeeded
+		return job
+	}
+	now := time.Now()
+
+	// The pod of an earlier, failed run has a critical issue either way.
+	tests := []struct {
+		name             string
+		latestSucceeded  int32
+		expectedSeverity string
+	}{
+		{name: "latest run succeeded", latestSucceeded: 1, expectedSeverity: "warning"},
+		{name: "latest run failed", latestSucceeded: 0, expectedSeverity: "critical"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset(
+				createFailedJobPod("test-ns", "report-100-x1", "report-100"),
+				cronJobRun("report-100", now.Add(-2*time.Hour), 0),
+				cronJobRun("report-200", now.Add(-time.Hour), tt.latestSucceeded),
+			)
+			service := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.Default())
+
+			report, err := service.GetNamespaceErrors(context.Background(), "test-ns", models.NamespaceErrorOptions{})
+			require.NoError(t, err)
+			require.Len(t, report.Workloads, 1)
+
+			cronJob := report.Workloads[0]
+			assert.Equal(t, "CronJob", cronJob.Kind)
+			assert.Equal(t, "report", cronJob.Name)
+			assert.True(t, cronJob.StatusKnown)
+			assert.Equal(t, tt.latestSucceeded, cronJob.AvailableReplicas)
+			assert.Equal(t, tt.expectedSeverity, cronJob.Severity)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// GetCapacity computes how many additional pods of the queried shape fit on
+// each node. Nodes are filtered with the same readiness, taint and selector
+// checks as the scheduling explanation, and free capacity is allocatable
+// minus the requests of the pods already running there.
+func (s *nodeService) GetCapacity(ctx context.Context, query models.CapacityQuery) (*models.CapacityReport, error) {
+	s.logger.Debug("calculating capacity", "requests", query.Requests)
+
+	nodeList, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	podsByNode, err := listActivePodsByNode(ctx, s.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	shapePod := &v1.Pod{
+		Spec: v1.PodSpec{
+			NodeSelector: query.NodeSelector,
+			Tolerations:  query.Tolerations,
+			Containers: []v1.Container{{
+				Name:      "shape",
+				Resources: v1.ResourceRequirements{Requests: query.Requests},
+			}},
+		},
+	}
+
+	report := &models.CapacityReport{
+		Shape:         make(map[string]string, len(query.Requests)),
+		TotalNodes:    len(nodeList.Items),
+		Fragmentation: make(map[string]models.ResourceFragmentation),
+		Nodes:         make([]models.NodeCapacity, 0, len(nodeList.Items)),
+		CalculatedAt:  time.Now(),
+	}
+	for name, quantity := range query.Requests {
+		report.Shape[string(name)] = quantity.String()
+	}
+
+	freeTotals := v1.ResourceList{}
+	usableTotals := v1.ResourceList{}
+	var largestCPU, largestMemory resource.Quantity
+
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		nodeCapacity := models.NodeCapacity{NodeName: node.Name}
+
+		if reason := capacityIneligibility(s.fit, shapePod, node); reason != "" {
+			nodeCapacity.Reason = reason
+			report.Nodes = append(report.Nodes, nodeCapacity)
+			continue
+		}
+
+		nodeCapacity.Eligible = true
+		report.EligibleNodes++
+
+		pods := podsByNode[node.Name]
+		allocated := v1.ResourceList{}
+		for j := range pods {
+			addResourceList(allocated, podRequests(&pods[j]))
+		}
+		allocated[v1.ResourcePods] = *resource.NewQuantity(int64(len(pods)), resource.DecimalSI)
+
+		free := freeResources(node.Status.Allocatable, allocated, query.Requests)
+		fits, limiting := podsThatFit(free, query.Requests)
+
+		nodeCapacity.Fits = fits
+		nodeCapacity.LimitingResource = string(limiting)
+		nodeCapacity.Free = make(map[string]string, len(free))
+		nodeCapacity.Stranded = make(map[string]string)
+		for name, quantity := range free {
+			nodeCapacity.Free[string(name)] = quantity.String()
+		}
+
+		for name, request := range query.Requests {
+			used := *resource.NewMilliQuantity(request.MilliValue()*int64(fits), request.Format)
+			stranded := free[name].DeepCopy()
+			stranded.Sub(used)
+			if stranded.Sign() > 0 {
+				nodeCapacity.Stranded[string(name)] = stranded.String()
+			}
+
+			addResourceList(freeTotals, v1.ResourceList{name: free[name]})
+			addResourceList(usableTotals, v1.ResourceList{name: used})
+		}
+
+		report.TotalFit += fits
+
+		// The largest pod that fits anywhere is bounded by a single
+		// node's free capacity, and that node needs a free pod slot.
+		if podSlots := free[v1.ResourcePods]; podSlots.Value() > 0 {
+			cpu, memory := free[v1.ResourceCPU], free[v1.ResourceMemory]
+			fit := &models.PodFit{NodeName: node.Name, CPU: cpu.String(), Memory: memory.String()}
+			if report.LargestByCPU == nil || cpu.Cmp(largestCPU) > 0 {
+				report.LargestByCPU, largestCPU = fit, cpu
+			}
+			if report.LargestByMemory == nil || memory.Cmp(largestMemory) > 0 {
+				report.LargestByMemory, largestMemory = fit, memory
+			}
+		}
+		report.Nodes = append(report.Nodes, nodeCapacity)
+	}
+
+	for name := range query.Requests {
+		freeTotal := freeTotals[name]
+		usable := usableTotals[name]
+		stranded := freeTotal.DeepCopy()
+		stranded.Sub(usable)
+		report.Fragmentation[string(name)] = models.ResourceFragmentation{
+			Free:               freeTotal.String(),
+			Usable:             usable.String(),
+			Stranded:           stranded.String(),
+			StrandedPercentage: percentOf(stranded, freeTotal),
+		}
+	}
+
+	sort.SliceStable(report.Nodes, func(i, j int) bool {
+		if report.Nodes[i].Fits != report.Nodes[j].Fits {
+			return report.Nodes[i].Fits > report.Nodes[j].Fits
+		}
+		return report.Nodes[i].NodeName < report.Nodes[j].NodeName
+	})
+
+	s.logger.Debug("successfully calculated capacity",
+		"total_fit", report.TotalFit,
+		"eligible_nodes", report.EligibleNodes,
+	)
+
+	return report, nil
+}
+
+// capacityIneligibility returns why the shape can never run on the node, or
+// an empty string if it may.
+func capacityIneligibility(fit *nodeFit, pod *v1.Pod, node *v1.Node) string {
+	if ready, explanation := fit.explainNodeReady(node); !ready {
+		if explanation != nil && len(explanation.Conditions) > 0 {
+			return explanation.Conditions[0]
+		}
+		return "Node is not ready"
+	}
+	if ok, explanation := fit.explainTaints(pod, node); !ok {
+		if explanation != nil && explanation.Details != "" {
+			return explanation.Details
+		}
+		return "Node has untolerated taints"
+	}
+	if ok, explanation := fit.explainAffinity(pod, node); !ok {
+		if explanation != nil && explanation.NodeSelector != nil && explanation.NodeSelector.Details != "" {
+			return explanation.NodeSelector.Details
+		}
+		return "Node does not match the node selector"
+	}
+	return ""
+}
+
+// freeResources returns allocatable minus allocated, floored at zero, for
+// cpu, memory, pods and every requested resource.
+func freeResources(allocatable, allocated, requests v1.ResourceList) v1.ResourceList {
+	names := []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, v1.ResourcePods}
+	for name := range requests {
+		names = append(names, name)
+	}
+
+	free := v1.ResourceList{}
+	for _, name := range names {
+		quantity := allocatable[name].DeepCopy()
+		quantity.Sub(allocated[name])
+		if quantity.Sign() < 0 {
+			quantity = *resource.NewQuantity(0, quantity.Format)
+		}
+		free[name] = quantity
+	}
+	return free
+}
+
+// podsThatFit returns how many pods with the given requests fit into free and
+// which resource runs out first.
+func podsThatFit(free, requests v1.ResourceList) (int, v1.ResourceName) {
+	podSlots := free[v1.ResourcePods]
+	fits := podSlots.Value()
+	limiting := v1.ResourcePods
+
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		request := requests[v1.ResourceName(name)]
+		if request.Sign() <= 0 {
+			continue
+		}
+		available := free[v1.ResourceName(name)]
+		if n := available.MilliValue() / request.MilliValue(); n < fits {
+			fits = n
+			limiting = v1.ResourceName(name)
+		}
+	}
+
+	return int(fits), limiting
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func TestNodeService_GetCapacity(t *testing.T) {
+	general := newDrainTestNode("general-1", "8")
+	general.Labels["pool"] = "general"
+	general.Status.Allocatable[v1.ResourcePods] = resource.MustParse("110")
+
+	small := newDrainTestNode("general-2", "4")
+	small.Labels["pool"] = "general"
+	small.Status.Allocatable[v1.ResourcePods] = resource.MustParse("110")
+
+	gpu := newDrainTestNode("gpu-1", "16")
+	gpu.Labels["pool"] = "gpu"
+	gpu.Status.Allocatable[v1.ResourcePods] = resource.MustParse("110")
+	gpu.Spec.Taints = []v1.Taint{{Key: "nvidia.com/gpu", Value: "present", Effect: v1.TaintEffectNoSchedule}}
+
+	cordoned := newDrainTestNode("general-3", "8")
+	cordoned.Labels["pool"] = "general"
+	cordoned.Spec.Unschedulable = true
+
+	fakeClient := fake.NewSimpleClientset(
+		general, small, gpu, cordoned,
+		newNodeTestPod("default", "existing", "general-1", "3", "1Gi"),
+	)
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	t.Run("general pool", func(t *testing.T) {
+		report, err := svc.GetCapacity(context.Background(), models.CapacityQuery{
+			Requests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			NodeSelector: map[string]string{"pool": "general"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, report.TotalNodes)
+		assert.Equal(t, 2, report.EligibleNodes)
+
+		// general-1: 5 CPUs and 7Gi free fit one pod by memory;
+		// general-2: 4 CPUs and 8Gi free fit two.
+		assert.Equal(t, 3, report.TotalFit)
+		require.Len(t, report.Nodes, 4)
+		assert.Equal(t, "general-2", report.Nodes[0].NodeName)
+		assert.Equal(t, 2, report.Nodes[0].Fits)
+		assert.Equal(t, "general-1", report.Nodes[1].NodeName)
+		assert.Equal(t, string(v1.ResourceMemory), report.Nodes[1].LimitingResource)
+		assert.Equal(t, "3", report.Nodes[1].Stranded[string(v1.ResourceCPU)])
+
+		cpu := report.Fragmentation[string(v1.ResourceCPU)]
+		assert.Equal(t, "9", cpu.Free)
+		assert.Equal(t, "6", cpu.Usable)
+		assert.InDelta(t, 33.33, cpu.StrandedPercentage, 0.01)
+
+		require.NotNil(t, report.LargestByCPU)
+		assert.Equal(t, "general-1", report.LargestByCPU.NodeName)
+		assert.Equal(t, "general-2", report.LargestByMemory.NodeName)
+
+		for _, node := range report.Nodes {
+			if node.NodeName == "general-3" {
+				assert.False(t, node.Eligible)
+				assert.NotEmpty(t, node.Reason)
+			}
+		}
+	})
+
+	t.Run("taints need tolerations", func(t *testing.T) {
+		query := models.CapacityQuery{
+			Requests:     v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			NodeSelector: map[string]string{"pool": "gpu"},
+		}
+
+		report, err := svc.GetCapacity(context.Background(), query)
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.EligibleNodes)
+		assert.Equal(t, 0, report.TotalFit)
+
+		query.Tolerations = []v1.Toleration{{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists}}
+		report, err = svc.GetCapacity(context.Background(), query)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.EligibleNodes)
+		assert.Equal(t, 4, report.TotalFit)
+	})
+}
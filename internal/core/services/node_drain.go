@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// SimulateNodeDrain predicts what `kubectl drain` would do to the node: which
+// pods are skipped, which are evicted or deleted, which evictions a
+// PodDisruptionBudget would block, and whether every evicted pod fits on
+// another node. Evicted pods are placed one after another so that later pods
+// see the capacity taken by earlier ones.
+func (s *nodeService) SimulateNodeDrain(ctx context.Context, nodeName string) (*models.DrainSimulation, error) {
+	s.logger.Debug("simulating node drain", "node", nodeName)
+
+	if _, err := s.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			s.logger.Debug("node not found", "node", nodeName)
+			return nil, core.ErrNodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	pods, err := listActiveNodePods(ctx, s.k8sClient, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	nodeList, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	podsByNode, err := listActivePodsByNode(ctx, s.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	allocatedByNode := make(map[string]v1.ResourceList, len(nodeList.Items))
+	for i := range nodeList.Items {
+		pods := podsByNode[nodeList.Items[i].Name]
+		allocated := v1.ResourceList{}
+		for j := range pods {
+			addResourceList(allocated, podRequests(&pods[j]))
+		}
+		allocated[v1.ResourcePods] = *resource.NewQuantity(int64(len(pods)), resource.DecimalSI)
+		allocatedByNode[nodeList.Items[i].Name] = allocated
+	}
+
+	simulation := &models.DrainSimulation{
+		NodeName:    nodeName,
+		TotalPods:   len(pods),
+		Blockers:    []string{},
+		Warnings:    []string{},
+		Pods:        make([]models.DrainPodResult, 0, len(pods)),
+		SimulatedAt: time.Now(),
+	}
+
+	budgets := s.newDisruptionBudgets(ctx, pods, simulation)
+
+	for i := range pods {
+		pod := &pods[i]
+		result := classifyDrainPod(pod)
+
+		switch {
+		case result.Mirror:
+			simulation.Summary.MirrorPods++
+		case result.DaemonSet:
+			simulation.Summary.DaemonSetPods++
+		}
+
+		if result.Action != models.DrainActionSkip {
+			if result.LocalStorage {
+				simulation.Summary.LocalStoragePods++
+				simulation.Warnings = append(simulation.Warnings,
+					fmt.Sprintf("Pod %s/%s uses emptyDir volumes whose data will be lost (requires --delete-emptydir-data)", pod.Namespace, pod.Name))
+			}
+
+			result.BlockingPDBs = budgets.evict(pod)
+			if len(result.BlockingPDBs) > 0 {
+				simulation.Summary.BlockedByPDB++
+				simulation.Blockers = append(simulation.Blockers,
+					fmt.Sprintf("Eviction of %s/%s is blocked by PodDisruptionBudget %s", pod.Namespace, pod.Name, strings.Join(result.BlockingPDBs, ", ")))
+			}
+		}
+
+		switch result.Action {
+		case models.DrainActionDelete:
+			simulation.Summary.UnmanagedPods++
+			simulation.Warnings = append(simulation.Warnings,
+				fmt.Sprintf("Pod %s/%s has no controller and will be lost (requires --force)", pod.Namespace, pod.Name))
+		case models.DrainActionEvict:
+			// A blocked eviction leaves the pod where it is, so it takes no
+			// capacity on the other nodes.
+			if len(result.BlockingPDBs) > 0 {
+				break
+			}
+			simulation.Summary.Evicted++
+			placeEvictedPod(ctx, s.fit, pod, nodeName, nodeList.Items, podsByNode, allocatedByNode, &result)
+			if !*result.Reschedulable {
+				simulation.Summary.Unreschedulable++
+				simulation.Warnings = append(simulation.Warnings,
+					fmt.Sprintf("Pod %s/%s would stay Pending: %s", pod.Namespace, pod.Name, result.SchedulingIssue))
+			}
+		}
+
+		simulation.Pods = append(simulation.Pods, result)
+	}
+
+	simulation.Drainable = len(simulation.Blockers) == 0
+
+	s.logger.Debug("successfully simulated node drain",
+		"node", nodeName,
+		"pods", simulation.TotalPods,
+		"blockers", len(simulation.Blockers),
+		"warnings", len(simulation.Warnings),
+	)
+
+	return simulation, nil
+}
+
+func classifyDrainPod(pod *v1.Pod) models.DrainPodResult {
+	result := models.DrainPodResult{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Action:    models.DrainActionEvict,
+	}
+
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		result.OwnerKind = owner.Kind
+		result.OwnerName = owner.Name
+		result.DaemonSet = owner.Kind == "DaemonSet"
+	} else {
+		result.Unmanaged = true
+	}
+
+	_, result.Mirror = pod.Annotations[mirrorPodAnnotation]
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			result.LocalStorage = true
+			break
+		}
+	}
+
+	switch {
+	case result.Mirror, result.DaemonSet:
+		result.Action = models.DrainActionSkip
+		result.Unmanaged = false
+	case result.Unmanaged:
+		result.Action = models.DrainActionDelete
+	}
+
+	return result
+}
+
+// placeEvictedPod finds the first other node the pod fits on, reusing the
+// per-node checks of the scheduling explanation, and reserves the pod's
+// requests and a pod slot there. Placed pods are added to podsByNode so that
+// the affinity checks of later pods see them.
+func placeEvictedPod(ctx context.Context, fit *nodeFit, pod *v1.Pod, drainedNode string, nodes []v1.Node, podsByNode map[string][]v1.Pod, allocatedByNode map[string]v1.ResourceList, result *models.DrainPodResult) {
+	reschedulable := false
+	result.Reschedulable = &reschedulable
+
+	issues := map[string]int{}
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Name == drainedNode {
+			continue
+		}
+
+		issue := ""
+		if ready, _ := fit.explainNodeReady(node); !ready {
+			issue = "node not ready"
+		} else if ok, _ := fit.explainTaints(pod, node); !ok {
+			issue = "untolerated taints"
+		} else if ok, _ := fit.explainAffinity(pod, node); !ok {
+			issue = "node selector/affinity mismatch"
+		} else if ok, _ := fit.explainResourceFitWithAllocated(pod, node, allocatedByNode[node.Name]); !ok {
+			issue = "insufficient resources"
+		} else if ok, _ := fit.explainPodAffinityWithPods(pod, podsByNode[node.Name]); !ok {
+			issue = "pod affinity/anti-affinity"
+		} else if fit.checkPodVolumes(pod) {
+			if ok, _ := fit.explainVolumeConstraints(ctx, pod, node); !ok {
+				issue = "volume constraints"
+			}
+		}
+
+		if issue != "" {
+			issues[issue]++
+			continue
+		}
+
+		reschedulable = true
+		result.TargetNode = node.Name
+		addResourceList(allocatedByNode[node.Name], podRequests(pod))
+		addResourceList(allocatedByNode[node.Name], v1.ResourceList{v1.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI)})
+
+		placed := pod.DeepCopy()
+		placed.Spec.NodeName = node.Name
+		podsByNode[node.Name] = append(podsByNode[node.Name], *placed)
+		return
+	}
+
+	if len(issues) == 0 {
+		result.SchedulingIssue = "no other nodes in the cluster"
+		return
+	}
+
+	reasons := make([]string, 0, len(issues))
+	for issue, count := range issues {
+		reasons = append(reasons, fmt.Sprintf("%d node(s) %s", count, issue))
+	}
+	sort.Strings(reasons)
+	result.SchedulingIssue = "no node fits: " + strings.Join(reasons, ", ")
+}
+
+// disruptionBudgets tracks how many more evictions each PodDisruptionBudget
+// allows as the simulation evicts pods.
+type disruptionBudgets struct {
+	budgets []policyv1.PodDisruptionBudget
+	allowed map[string]int32
+}
+
+func (s *nodeService) newDisruptionBudgets(ctx context.Context, pods []v1.Pod, simulation *models.DrainSimulation) *disruptionBudgets {
+	budgets := &disruptionBudgets{allowed: make(map[string]int32)}
+
+	namespaces := make(map[string]bool)
+	for i := range pods {
+		namespaces[pods[i].Namespace] = true
+	}
+
+	for namespace := range namespaces {
+		pdbList, err := s.k8sClient.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			s.logger.Warn("failed to list pod disruption budgets",
+				"namespace", namespace,
+				"error", err.Error(),
+			)
+			simulation.Warnings = append(simulation.Warnings,
+				fmt.Sprintf("PodDisruptionBudgets in namespace %s could not be checked", namespace))
+			continue
+		}
+		for _, pdb := range pdbList.Items {
+			budgets.budgets = append(budgets.budgets, pdb)
+			budgets.allowed[pdb.Namespace+"/"+pdb.Name] = pdb.Status.DisruptionsAllowed
+		}
+	}
+
+	return budgets
+}
+
+// evict consumes one disruption from every budget covering the pod and
+// returns the budgets that have none left.
+func (b *disruptionBudgets) evict(pod *v1.Pod) []string {
+	blocking := []string{}
+	for i := range b.budgets {
+		pdb := &b.budgets[i]
+		if pdb.Namespace != pod.Namespace {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		key := pdb.Namespace + "/" + pdb.Name
+		if b.allowed[key] <= 0 {
+			blocking = append(blocking, pdb.Name)
+			continue
+		}
+		b.allowed[key]--
+	}
+	return blocking
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newDrainTestNode(name, cpu string) *v1.Node {
+	node := newUtilizationTestNode(name, "zone-1", cpu, "8Gi")
+	node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}
+	return node
+}
+
+func withController(pod *v1.Pod, kind, name string) *v1.Pod {
+	isController := true
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &isController}}
+	return pod
+}
+
+func TestNodeService_SimulateNodeDrain(t *testing.T) {
+	web1 := withController(newNodeTestPod("default", "web-1", "node-a", "1", "1Gi"), "ReplicaSet", "web-7d9f")
+	web1.Labels = map[string]string{"app": "web"}
+	web2 := withController(newNodeTestPod("default", "web-2", "node-a", "1", "1Gi"), "ReplicaSet", "web-7d9f")
+	web2.Labels = map[string]string{"app": "web"}
+
+	cache := withController(newNodeTestPod("default", "cache-0", "node-a", "1", "1Gi"), "StatefulSet", "cache")
+	cache.Spec.Volumes = []v1.Volume{{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+
+	big := withController(newNodeTestPod("default", "big-1", "node-a", "3", "1Gi"), "ReplicaSet", "big-5c4b")
+
+	mirror := newNodeTestPod("kube-system", "kube-proxy-node-a", "node-a", "100m", "64Mi")
+	mirror.Annotations = map[string]string{mirrorPodAnnotation: "abc"}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+
+	fakeClient := fake.NewSimpleClientset(
+		newDrainTestNode("node-a", "8"),
+		newDrainTestNode("node-b", "4"),
+		web1, web2, cache, big, mirror,
+		withController(newNodeTestPod("kube-system", "fluentd-abc", "node-a", "100m", "64Mi"), "DaemonSet", "fluentd"),
+		newNodeTestPod("default", "debug", "node-a", "100m", "64Mi"),
+		withController(newNodeTestPod("default", "existing", "node-b", "500m", "1Gi"), "ReplicaSet", "other"),
+		pdb,
+	)
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	simulation, err := svc.SimulateNodeDrain(context.Background(), "node-a")
+	require.NoError(t, err)
+
+	results := map[string]models.DrainPodResult{}
+	for _, pod := range simulation.Pods {
+		results[pod.Name] = pod
+	}
+
+	assert.False(t, simulation.Drainable)
+	assert.Equal(t, 7, simulation.TotalPods)
+	assert.Equal(t, models.DrainSummary{
+		Evicted:          3,
+		DaemonSetPods:    1,
+		MirrorPods:       1,
+		LocalStoragePods: 1,
+		UnmanagedPods:    1,
+		BlockedByPDB:     1,
+		Unreschedulable:  2,
+	}, simulation.Summary)
+
+	assert.Equal(t, models.DrainActionSkip, results["fluentd-abc"].Action)
+	assert.Equal(t, models.DrainActionSkip, results["kube-proxy-node-a"].Action)
+	assert.Equal(t, models.DrainActionDelete, results["debug"].Action)
+	assert.True(t, results["debug"].Unmanaged)
+
+	assert.Empty(t, results["web-1"].BlockingPDBs)
+	assert.Equal(t, []string{"web"}, results["web-2"].BlockingPDBs)
+	assert.True(t, results["cache-0"].LocalStorage)
+
+	// node-b has 3.5 CPUs free: big-1 (3) is placed first, after which
+	// none of the 1 CPU pods fit.
+	require.NotNil(t, results["big-1"].Reschedulable)
+	assert.True(t, *results["big-1"].Reschedulable)
+	assert.Equal(t, "node-b", results["big-1"].TargetNode)
+	require.NotNil(t, results["web-1"].Reschedulable)
+	assert.False(t, *results["web-1"].Reschedulable)
+	assert.Contains(t, results["web-1"].SchedulingIssue, "insufficient resources")
+	// The PDB keeps web-2 on the node, so it is neither evicted nor placed.
+	assert.Nil(t, results["web-2"].Reschedulable)
+	assert.Empty(t, results["web-2"].TargetNode)
+	assert.Nil(t, results["fluentd-abc"].Reschedulable)
+}
+
+func TestNodeService_SimulateNodeDrain_SimulatedPlacements(t *testing.T) {
+	antiAffinity := &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			TopologyKey:   "kubernetes.io/hostname",
+		}},
+	}}
+	newReplica := func(name string) *v1.Pod {
+		pod := withController(newNodeTestPod("default", name, "node-a", "100m", "64Mi"), "ReplicaSet", "api-6f8d")
+		pod.Labels = map[string]string{"app": "api"}
+		pod.Spec.Affinity = antiAffinity
+		return pod
+	}
+
+	// node-c has plenty of CPU and memory but only one free pod slot.
+	nodeC := newDrainTestNode("node-c", "8")
+	nodeC.Status.Allocatable[v1.ResourcePods] = resource.MustParse("2")
+
+	fakeClient := fake.NewSimpleClientset(
+		newDrainTestNode("node-a", "8"),
+		newDrainTestNode("node-b", "8"),
+		nodeC,
+		newReplica("api-1"),
+		newReplica("api-2"),
+		newReplica("api-3"),
+		withController(newNodeTestPod("default", "worker-1", "node-a", "100m", "64Mi"), "ReplicaSet", "worker-5b7c"),
+		withController(newNodeTestPod("default", "existing", "node-c", "100m", "64Mi"), "ReplicaSet", "other"),
+	)
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	simulation, err := svc.SimulateNodeDrain(context.Background(), "node-a")
+	require.NoError(t, err)
+
+	results := map[string]models.DrainPodResult{}
+	for _, pod := range simulation.Pods {
+		results[pod.Name] = pod
+	}
+
+	// Replicas drained together repel each other on their new nodes.
+	assert.Equal(t, "node-b", results["api-1"].TargetNode)
+	assert.Equal(t, "node-c", results["api-2"].TargetNode)
+	require.NotNil(t, results["api-3"].Reschedulable)
+	assert.False(t, *results["api-3"].Reschedulable)
+	// api-2 took node-c's last pod slot, so node-c no longer fits anything.
+	assert.Equal(t, "no node fits: 1 node(s) insufficient resources, 1 node(s) pod affinity/anti-affinity", results["api-3"].SchedulingIssue)
+	assert.Equal(t, "node-b", results["worker-1"].TargetNode)
+}
+
+func TestNodeService_SimulateNodeDrain_NotFound(t *testing.T) {
+	svc := NewNodeService(fake.NewSimpleClientset(), nil, slog.Default())
+
+	_, err := svc.SimulateNodeDrain(context.Background(), "missing")
+	assert.ErrorIs(t, err, core.ErrNodeNotFound)
+}
+
+func TestClassifyDrainPod(t *testing.T) {
+	pod := newNodeTestPod("default", "standalone", "node-a", "100m", "64Mi")
+	pod.Spec.Volumes = []v1.Volume{{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory, SizeLimit: resource.NewQuantity(1, resource.BinarySI)}}}}
+
+	result := classifyDrainPod(pod)
+	assert.Equal(t, models.DrainActionDelete, result.Action)
+	assert.True(t, result.Unmanaged)
+	assert.True(t, result.LocalStorage)
+}
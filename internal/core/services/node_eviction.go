@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// systemCriticalPriority is the priority of the system-cluster-critical
+// class; the kubelet never evicts pods at or above it.
+const systemCriticalPriority = int32(2000000000)
+
+// defaultEvictionHard mirrors the kubelet's built-in evictionHard values on
+// Linux, used when the kubelet configuration cannot be read.
+var defaultEvictionHard = map[string]string{
+	"memory.available":  "100Mi",
+	"nodefs.available":  "10%",
+	"nodefs.inodesFree": "5%",
+	"imagefs.available": "15%",
+}
+
+type evictionCandidate struct {
+	models.EvictionCandidate
+	overshoot int64
+}
+
+func (s *nodeService) GetEvictionRanking(ctx context.Context, nodeName string, signal string) (*models.EvictionRanking, error) {
+	s.logger.Debug("getting eviction ranking", "node", nodeName, "signal", signal)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			s.logger.Debug("node not found", "node", nodeName)
+			return nil, core.ErrNodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	pods, err := listActiveNodePods(ctx, s.k8sClient, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	ranking := &models.EvictionRanking{
+		NodeName:      nodeName,
+		Signal:        signal,
+		UnderPressure: nodeUnderPressure(node, signal),
+		Thresholds:    []models.EvictionThresholdStatus{},
+		Pods:          make([]models.EvictionCandidate, 0, len(pods)),
+		CalculatedAt:  time.Now(),
+	}
+
+	summary, err := s.kubeletStats.Summary(ctx, nodeName)
+	if err != nil {
+		s.logger.Warn("failed to get kubelet stats summary", "node", nodeName, "error", err.Error())
+		ranking.Warnings = append(ranking.Warnings, "kubelet stats unavailable; eviction thresholds are not reported")
+		summary = nil
+	}
+
+	resourceName := v1.ResourceMemory
+	if signal == models.EvictionSignalEphemeralStorage {
+		resourceName = v1.ResourceEphemeralStorage
+	}
+
+	usageByPod, source := s.evictionUsage(ctx, pods, summary, signal)
+	if usageByPod == nil {
+		return nil, core.ErrMetricsNotAvailable
+	}
+	ranking.UsageSource = source
+
+	if summary != nil {
+		evictionHard, err := s.kubeletStats.EvictionHard(ctx, nodeName)
+		ranking.ThresholdSource = "kubelet"
+		if err != nil || len(evictionHard) == 0 {
+			if err != nil {
+				s.logger.Warn("failed to get kubelet eviction thresholds", "node", nodeName, "error", err.Error())
+			}
+			evictionHard = defaultEvictionHard
+			ranking.ThresholdSource = "default"
+		}
+		ranking.Thresholds = evictionThresholds(node, summary, evictionHard, signal)
+	}
+
+	candidates := make([]evictionCandidate, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		usage, ok := usageByPod[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			ranking.Warnings = append(ranking.Warnings, fmt.Sprintf("no usage reported for pod %s/%s", pod.Namespace, pod.Name))
+		}
+		candidates = append(candidates, newEvictionCandidate(pod, usage, podRequests(pod)[resourceName]))
+	}
+
+	sortEvictionCandidates(candidates)
+	for i := range candidates {
+		candidates[i].Rank = i + 1
+		ranking.Pods = append(ranking.Pods, candidates[i].EvictionCandidate)
+	}
+
+	s.logger.Debug("eviction ranking calculated",
+		"node", nodeName,
+		"signal", signal,
+		"pods", len(ranking.Pods),
+		"usage_source", ranking.UsageSource,
+	)
+
+	return ranking, nil
+}
+
+// evictionUsage returns per-pod usage of the signal's resource keyed by
+// namespace/name. Memory prefers metrics-server and falls back to kubelet
+// working set; ephemeral storage is only reported by the kubelet.
+func (s *nodeService) evictionUsage(ctx context.Context, pods []v1.Pod, summary *kubeletSummary, signal string) (map[string]resource.Quantity, string) {
+	usageByPod := make(map[string]resource.Quantity)
+
+	if signal == models.EvictionSignalMemory {
+		if metrics, ok := s.getPodUsage(ctx, pods); ok {
+			for key, usage := range metrics {
+				usageByPod[key] = usage[v1.ResourceMemory]
+			}
+			return usageByPod, "metrics-server"
+		}
+	}
+
+	if summary == nil {
+		return nil, ""
+	}
+
+	for _, podStats := range summary.Pods {
+		var used *uint64
+		if signal == models.EvictionSignalMemory {
+			if podStats.Memory != nil {
+				used = podStats.Memory.WorkingSetBytes
+			}
+		} else if podStats.EphemeralStorage != nil {
+			used = podStats.EphemeralStorage.UsedBytes
+		}
+		if used != nil {
+			usageByPod[podStats.PodRef.Namespace+"/"+podStats.PodRef.Name] = *resource.NewQuantity(int64(*used), resource.BinarySI)
+		}
+	}
+	return usageByPod, "kubelet"
+}
+
+func newEvictionCandidate(pod *v1.Pod, usage, request resource.Quantity) evictionCandidate {
+	var priority int32
+	if pod.Spec.Priority != nil {
+		priority = *pod.Spec.Priority
+	}
+
+	overshoot := usage.Value() - request.Value()
+	_, mirror := pod.Annotations[mirrorPodAnnotation]
+
+	candidate := evictionCandidate{
+		EvictionCandidate: models.EvictionCandidate{
+			Namespace:         pod.Namespace,
+			Name:              pod.Name,
+			QOSClass:          string(pod.Status.QOSClass),
+			Priority:          priority,
+			Usage:             usage.String(),
+			Request:           request.String(),
+			ExceedsRequest:    overshoot > 0,
+			UsageAboveRequest: resource.NewQuantity(overshoot, resource.BinarySI).String(),
+			Critical:          mirror || priority >= systemCriticalPriority,
+		},
+		overshoot: overshoot,
+	}
+
+	switch {
+	case candidate.Critical:
+		candidate.Reason = "critical pod; the kubelet does not evict it"
+	case candidate.ExceedsRequest && request.IsZero():
+		candidate.Reason = "no request set; all usage counts against it"
+	case candidate.ExceedsRequest:
+		candidate.Reason = "usage exceeds request"
+	default:
+		candidate.Reason = "usage within request; evicted only after pods exceeding their requests"
+	}
+
+	return candidate
+}
+
+// sortEvictionCandidates applies the kubelet's ranking: pods exceeding their
+// request first, then by ascending priority, then by how far usage exceeds
+// the request. QoS class follows from this: BestEffort pods have no request
+// and so always exceed it, Guaranteed pods rarely do. Critical pods always
+// sort last.
+func sortEvictionCandidates(candidates []evictionCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Critical != b.Critical {
+			return !a.Critical
+		}
+		if a.ExceedsRequest != b.ExceedsRequest {
+			return a.ExceedsRequest
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.overshoot > b.overshoot
+	})
+}
+
+func nodeUnderPressure(node *v1.Node, signal string) bool {
+	conditionType := v1.NodeMemoryPressure
+	if signal == models.EvictionSignalEphemeralStorage {
+		conditionType = v1.NodeDiskPressure
+	}
+	return nodeConditionTrue(node, conditionType)
+}
+
+// evictionThresholds evaluates the hard thresholds relevant to the signal
+// against the node-level kubelet stats.
+func evictionThresholds(node *v1.Node, summary *kubeletSummary, evictionHard map[string]string, signal string) []models.EvictionThresholdStatus {
+	type observation struct {
+		signal    string
+		available *uint64
+		capacity  int64
+		binary    bool
+	}
+
+	var observations []observation
+	if signal == models.EvictionSignalMemory {
+		if summary.Node.Memory != nil {
+			capacity := node.Status.Capacity[v1.ResourceMemory]
+			observations = append(observations, observation{"memory.available", summary.Node.Memory.AvailableBytes, capacity.Value(), true})
+		}
+	} else {
+		if fs := summary.Node.Fs; fs != nil {
+			observations = append(observations,
+				observation{"nodefs.available", fs.AvailableBytes, uint64Value(fs.CapacityBytes), true},
+				observation{"nodefs.inodesFree", fs.InodesFree, uint64Value(fs.Inodes), false},
+			)
+		}
+		if summary.Node.Runtime != nil && summary.Node.Runtime.ImageFs != nil {
+			fs := summary.Node.Runtime.ImageFs
+			observations = append(observations,
+				observation{"imagefs.available", fs.AvailableBytes, uint64Value(fs.CapacityBytes), true},
+			)
+		}
+	}
+
+	statuses := make([]models.EvictionThresholdStatus, 0, len(observations))
+	for _, obs := range observations {
+		value, ok := evictionHard[obs.signal]
+		if !ok || obs.available == nil || obs.capacity == 0 {
+			continue
+		}
+		threshold, err := parseEvictionThreshold(value, obs.capacity)
+		if err != nil {
+			continue
+		}
+
+		available := int64(*obs.available)
+		headroom := available - threshold
+		statuses = append(statuses, models.EvictionThresholdStatus{
+			Signal:             obs.signal,
+			Threshold:          value,
+			Available:          formatEvictionAmount(available, obs.binary),
+			Capacity:           formatEvictionAmount(obs.capacity, obs.binary),
+			Headroom:           formatEvictionAmount(headroom, obs.binary),
+			HeadroomPercentage: float64(headroom) / float64(obs.capacity) * 100,
+			Breached:           headroom < 0,
+		})
+	}
+	return statuses
+}
+
+// parseEvictionThreshold converts a kubelet threshold such as "100Mi" or
+// "10%" into an absolute amount of the signal's capacity.
+func parseEvictionThreshold(value string, capacity int64) (int64, error) {
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		p, err := strconv.ParseFloat(percent, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid eviction threshold %q: %w", value, err)
+		}
+		return int64(float64(capacity) * p / 100), nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid eviction threshold %q: %w", value, err)
+	}
+	return quantity.Value(), nil
+}
+
+func formatEvictionAmount(value int64, binary bool) string {
+	if binary {
+		return resource.NewQuantity(value, resource.BinarySI).String()
+	}
+	return strconv.FormatInt(value, 10)
+}
+
+func uint64Value(v *uint64) int64 {
+	if v == nil {
+		return 0
+	}
+	return int64(*v)
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+type stubKubeletStats struct {
+	summary      *kubeletSummary
+	evictionHard map[string]string
+	err          error
+}
+
+func (s *stubKubeletStats) Summary(ctx context.Context, nodeName string) (*kubeletSummary, error) {
+	return s.summary, s.err
+}
+
+func (s *stubKubeletStats) EvictionHard(ctx context.Context, nodeName string) (map[string]string, error) {
+	return s.evictionHard, s.err
+}
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func withPriority(pod *v1.Pod, priority int32) *v1.Pod {
+	pod.Spec.Priority = &priority
+	return pod
+}
+
+func TestSortEvictionCandidates(t *testing.T) {
+	pod := func(name string, usage, request string, priority int32) evictionCandidate {
+		return newEvictionCandidate(
+			withPriority(newNodeTestPod("default", name, "node-a", "100m", request), priority),
+			resource.MustParse(usage),
+			resource.MustParse(request),
+		)
+	}
+
+	critical := pod("static", "2Gi", "0", 0)
+	critical.Critical = true
+
+	candidates := []evictionCandidate{
+		critical,
+		pod("within", "100Mi", "1Gi", 0),
+		pod("high-priority-over", "2Gi", "1Gi", 1000),
+		pod("small-over", "1100Mi", "1Gi", 0),
+		pod("large-over", "3Gi", "1Gi", 0),
+	}
+
+	sortEvictionCandidates(candidates)
+
+	var order []string
+	for _, c := range candidates {
+		order = append(order, c.Name)
+	}
+	assert.Equal(t, []string{"large-over", "small-over", "high-priority-over", "within", "static"}, order)
+}
+
+func TestParseEvictionThreshold(t *testing.T) {
+	value, err := parseEvictionThreshold("10%", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), value)
+
+	value, err = parseEvictionThreshold("100Mi", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100*1024*1024), value)
+
+	_, err = parseEvictionThreshold("ten%", 1000)
+	assert.Error(t, err)
+}
+
+func TestNodeService_GetEvictionRanking(t *testing.T) {
+	node := newUtilizationTestNode("node-a", "zone-a", "4", "8Gi")
+	node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue}}
+
+	fakeClient := fake.NewSimpleClientset(
+		node,
+		newNodeTestPod("default", "steady", "node-a", "100m", "1Gi"),
+		withPriority(newNodeTestPod("batch", "greedy", "node-a", "100m", "512Mi"), 0),
+		withPriority(newNodeTestPod("system", "critical", "node-a", "100m", "128Mi"), systemCriticalPriority),
+	)
+
+	metricsClient := metricsfake.NewSimpleClientset()
+	metricsClient.PrependReactor("list", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+		items := map[string][]metricsv1beta1.PodMetrics{
+			"default": {newTestPodMetrics("default", "steady", "100m", "600Mi")},
+			"batch":   {newTestPodMetrics("batch", "greedy", "100m", "2Gi")},
+			"system":  {newTestPodMetrics("system", "critical", "100m", "1Gi")},
+		}
+		return true, &metricsv1beta1.PodMetricsList{Items: items[action.GetNamespace()]}, nil
+	})
+
+	summary := &kubeletSummary{
+		Node: kubeletNodeStats{
+			Memory: &kubeletMemoryStats{AvailableBytes: uint64Ptr(50 * 1024 * 1024)},
+			Fs: &kubeletFsStats{
+				AvailableBytes: uint64Ptr(30),
+				CapacityBytes:  uint64Ptr(100),
+				InodesFree:     uint64Ptr(90),
+				Inodes:         uint64Ptr(100),
+			},
+		},
+	}
+
+	t.Run("memory ranking with kubelet thresholds", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, metricsClient, slog.Default()).(*nodeService)
+		svc.kubeletStats = &stubKubeletStats{summary: summary, evictionHard: map[string]string{"memory.available": "100Mi"}}
+
+		ranking, err := svc.GetEvictionRanking(context.Background(), "node-a", models.EvictionSignalMemory)
+		require.NoError(t, err)
+
+		assert.True(t, ranking.UnderPressure)
+		assert.Equal(t, "metrics-server", ranking.UsageSource)
+		assert.Equal(t, "kubelet", ranking.ThresholdSource)
+
+		require.Len(t, ranking.Pods, 3)
+		assert.Equal(t, "greedy", ranking.Pods[0].Name)
+		assert.True(t, ranking.Pods[0].ExceedsRequest)
+		assert.Equal(t, "1536Mi", ranking.Pods[0].UsageAboveRequest)
+		assert.Equal(t, "steady", ranking.Pods[1].Name)
+		assert.False(t, ranking.Pods[1].ExceedsRequest)
+		assert.Equal(t, "critical", ranking.Pods[2].Name)
+		assert.True(t, ranking.Pods[2].Critical)
+		assert.Equal(t, 3, ranking.Pods[2].Rank)
+
+		require.Len(t, ranking.Thresholds, 1)
+		assert.Equal(t, "memory.available", ranking.Thresholds[0].Signal)
+		assert.Equal(t, "-50Mi", ranking.Thresholds[0].Headroom)
+		assert.True(t, ranking.Thresholds[0].Breached)
+	})
+
+	t.Run("ephemeral storage uses kubelet usage and default thresholds", func(t *testing.T) {
+		storageSummary := *summary
+		storageSummary.Pods = []kubeletPodStats{{EphemeralStorage: &kubeletFsStats{UsedBytes: uint64Ptr(2048)}}}
+		storageSummary.Pods[0].PodRef.Namespace = "default"
+		storageSummary.Pods[0].PodRef.Name = "steady"
+
+		svc := NewNodeService(fakeClient, metricsClient, slog.Default()).(*nodeService)
+		svc.kubeletStats = &stubKubeletStats{summary: &storageSummary}
+
+		ranking, err := svc.GetEvictionRanking(context.Background(), "node-a", models.EvictionSignalEphemeralStorage)
+		require.NoError(t, err)
+
+		assert.False(t, ranking.UnderPressure)
+		assert.Equal(t, "kubelet", ranking.UsageSource)
+		assert.Equal(t, "default", ranking.ThresholdSource)
+		assert.Equal(t, "steady", ranking.Pods[0].Name)
+		assert.Len(t, ranking.Warnings, 2)
+
+		require.Len(t, ranking.Thresholds, 2)
+		assert.Equal(t, "nodefs.available", ranking.Thresholds[0].Signal)
+		assert.Equal(t, "20", ranking.Thresholds[0].Headroom)
+		assert.False(t, ranking.Thresholds[0].Breached)
+		assert.Equal(t, "nodefs.inodesFree", ranking.Thresholds[1].Signal)
+	})
+
+	t.Run("no usage source", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, nil, slog.Default()).(*nodeService)
+		svc.kubeletStats = &stubKubeletStats{err: errors.New("proxy forbidden")}
+
+		_, err := svc.GetEvictionRanking(context.Background(), "node-a", models.EvictionSignalMemory)
+		assert.ErrorIs(t, err, core.ErrMetricsNotAvailable)
+	})
+
+	t.Run("node not found", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, metricsClient, slog.Default())
+
+		_, err := svc.GetEvictionRanking(context.Background(), "missing", models.EvictionSignalMemory)
+		assert.ErrorIs(t, err, core.ErrNodeNotFound)
+	})
+}
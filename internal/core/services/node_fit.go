@@ -0,0 +1,663 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// nodeFit holds the per-node scheduling checks shared by the scheduling
+// explanation, the drain simulation and the capacity report.
+type nodeFit struct {
+	k8sClient kubernetes.Interface
+	logger    *slog.Logger
+}
+
+func newNodeFit(k8sClient kubernetes.Interface, logger *slog.Logger) *nodeFit {
+	return &nodeFit{k8sClient: k8sClient, logger: logger}
+}
+
+func (f *nodeFit) explainNodeReady(node *v1.Node) (bool, *models.NodeReadyExplanation) {
+	explanation := &models.NodeReadyExplanation{
+		Ready:      true,
+		Conditions: []string{},
+	}
+
+	if node.Spec.Unschedulable {
+		explanation.Ready = false
+		explanation.Conditions = append(explanation.Conditions, "Node is marked as unschedulable")
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			if condition.Status != v1.ConditionTrue {
+				explanation.Ready = false
+				explanation.Conditions = append(explanation.Conditions,
+					fmt.Sprintf("NodeReady condition is %s: %s", condition.Status, condition.Message))
+			}
+		} else if condition.Status != v1.ConditionFalse {
+			// Other conditions should be False for a healthy node
+			explanation.Conditions = append(explanation.Conditions,
+				fmt.Sprintf("%s condition is %s: %s", condition.Type, condition.Status, condition.Message))
+		}
+	}
+
+	return explanation.Ready, explanation
+}
+
+func (f *nodeFit) explainTaints(pod *v1.Pod, node *v1.Node) (bool, *models.TaintExplanation) {
+	explanation := &models.TaintExplanation{
+		Tolerated:         true,
+		NodeTaints:        []models.TaintInfo{},
+		PodTolerations:    []string{},
+		UntoleratedTaints: []models.TaintInfo{},
+	}
+
+	// Convert node taints to TaintInfo
+	for _, taint := range node.Spec.Taints {
+		explanation.NodeTaints = append(explanation.NodeTaints, models.TaintInfo{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: string(taint.Effect),
+		})
+	}
+
+	// Convert pod tolerations to strings
+	for _, toleration := range pod.Spec.Tolerations {
+		tolStr := fmt.Sprintf("key=%s", toleration.Key)
+		if toleration.Value != "" {
+			tolStr += fmt.Sprintf(",value=%s", toleration.Value)
+		}
+		if toleration.Effect != "" {
+			tolStr += fmt.Sprintf(",effect=%s", toleration.Effect)
+		}
+		if toleration.Operator != "" {
+			tolStr += fmt.Sprintf(",operator=%s", toleration.Operator)
+		}
+		explanation.PodTolerations = append(explanation.PodTolerations, tolStr)
+	}
+
+	// Check untolerated taints
+	for _, taint := range node.Spec.Taints {
+		tolerated := false
+		for _, toleration := range pod.Spec.Tolerations {
+			if f.tolerationMatchesTaint(toleration, taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated && (taint.Effect == v1.TaintEffectNoSchedule || taint.Effect == v1.TaintEffectNoExecute) {
+			explanation.Tolerated = false
+			explanation.UntoleratedTaints = append(explanation.UntoleratedTaints, models.TaintInfo{
+				Key:    taint.Key,
+				Value:  taint.Value,
+				Effect: string(taint.Effect),
+			})
+		}
+	}
+
+	if !explanation.Tolerated {
+		taintStrs := []string{}
+		for _, taint := range explanation.UntoleratedTaints {
+			taintStrs = append(taintStrs, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		explanation.Details = fmt.Sprintf("Pod does not tolerate taints: %s", strings.Join(taintStrs, ", "))
+	}
+
+	return explanation.Tolerated, explanation
+}
+
+func (f *nodeFit) tolerationMatchesTaint(toleration v1.Toleration, taint v1.Taint) bool {
+	if toleration.Key != "" && toleration.Key != taint.Key {
+		return false
+	}
+
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+
+	switch toleration.Operator {
+	case v1.TolerationOpEqual, "":
+		return toleration.Value == taint.Value
+	case v1.TolerationOpExists:
+		return true
+	}
+
+	return false
+}
+
+func (f *nodeFit) explainAffinity(pod *v1.Pod, node *v1.Node) (bool, *models.AffinityExplanation) {
+	explanation := &models.AffinityExplanation{}
+	matched := true
+
+	// Check node selector
+	if len(pod.Spec.NodeSelector) > 0 {
+		selectorExplanation := &models.SelectorExplanation{
+			Matched:       true,
+			Required:      pod.Spec.NodeSelector,
+			NodeLabels:    node.Labels,
+			MissingLabels: []string{},
+		}
+
+		for key, value := range pod.Spec.NodeSelector {
+			if nodeValue, exists := node.Labels[key]; !exists || nodeValue != value {
+				selectorExplanation.Matched = false
+				matched = false
+				selectorExplanation.MissingLabels = append(selectorExplanation.MissingLabels,
+					fmt.Sprintf("%s=%s", key, value))
+			}
+		}
+
+		if !selectorExplanation.Matched {
+			selectorExplanation.Details = fmt.Sprintf("Node selector requirements not met. Missing labels: %s",
+				strings.Join(selectorExplanation.MissingLabels, ", "))
+		}
+
+		explanation.NodeSelector = selectorExplanation
+	}
+
+	// Check node affinity
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+		affinityDetail := &models.NodeAffinityDetail{
+			RequiredMatched: true,
+			FailedTerms:     []string{},
+		}
+
+		if required := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			affinityDetail.RequiredMatched = false
+			for _, term := range required.NodeSelectorTerms {
+				if f.matchNodeSelectorTerm(node, term) {
+					affinityDetail.RequiredMatched = true
+					break
+				} else {
+					affinityDetail.FailedTerms = append(affinityDetail.FailedTerms,
+						f.explainNodeSelectorTerm(term, node))
+				}
+			}
+
+			if !affinityDetail.RequiredMatched {
+				matched = false
+				affinityDetail.Details = "No required node affinity terms matched this node"
+			}
+		}
+
+		explanation.NodeAffinity = affinityDetail
+	}
+
+	if !matched {
+		explanation.Summary = "Node affinity requirements not satisfied"
+	}
+
+	return matched, explanation
+}
+
+func (f *nodeFit) explainNodeSelectorTerm(term v1.NodeSelectorTerm, node *v1.Node) string {
+	failures := []string{}
+
+	for _, expr := range term.MatchExpressions {
+		if !f.matchNodeSelectorRequirement(node, expr) {
+			failures = append(failures, fmt.Sprintf("label %s %s %v",
+				expr.Key, expr.Operator, expr.Values))
+		}
+	}
+
+	for _, field := range term.MatchFields {
+		if !f.matchNodeFieldSelector(node, field) {
+			failures = append(failures, fmt.Sprintf("field %s %s %v",
+				field.Key, field.Operator, field.Values))
+		}
+	}
+
+	return strings.Join(failures, " AND ")
+}
+
+func (f *nodeFit) matchNodeSelectorTerm(node *v1.Node, term v1.NodeSelectorTerm) bool {
+	for _, expr := range term.MatchExpressions {
+		if !f.matchNodeSelectorRequirement(node, expr) {
+			return false
+		}
+	}
+	for _, field := range term.MatchFields {
+		if !f.matchNodeFieldSelector(node, field) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *nodeFit) matchNodeSelectorRequirement(node *v1.Node, req v1.NodeSelectorRequirement) bool {
+	nodeValue, exists := node.Labels[req.Key]
+
+	switch req.Operator {
+	case v1.NodeSelectorOpIn:
+		if !exists {
+			return false
+		}
+		for _, value := range req.Values {
+			if nodeValue == value {
+				return true
+			}
+		}
+		return false
+	case v1.NodeSelectorOpNotIn:
+		if !exists {
+			return true
+		}
+		for _, value := range req.Values {
+			if nodeValue == value {
+				return false
+			}
+		}
+		return true
+	case v1.NodeSelectorOpExists:
+		return exists
+	case v1.NodeSelectorOpDoesNotExist:
+		return !exists
+	case v1.NodeSelectorOpGt, v1.NodeSelectorOpLt:
+		return true
+	}
+	return false
+}
+
+func (f *nodeFit) matchNodeFieldSelector(node *v1.Node, field v1.NodeSelectorRequirement) bool {
+	var fieldValue string
+	switch field.Key {
+	case "metadata.name":
+		fieldValue = node.Name
+	default:
+		return false
+	}
+
+	switch field.Operator {
+	case v1.NodeSelectorOpIn:
+		for _, value := range field.Values {
+			if fieldValue == value {
+				return true
+			}
+		}
+		return false
+	case v1.NodeSelectorOpNotIn:
+		for _, value := range field.Values {
+			if fieldValue == value {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (f *nodeFit) explainResourceFit(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.ResourceExplanation) {
+	// Calculate currently allocated resources on the node
+	nodeAllocated, err := f.calculateNodeAllocatedResources(ctx, node)
+	if err != nil {
+		f.logger.Warn("failed to calculate node allocated resources",
+			"node", node.Name,
+			"error", err.Error())
+		// Continue with partial analysis
+	}
+
+	return f.explainResourceFitWithAllocated(pod, node, nodeAllocated)
+}
+
+// explainResourceFitWithAllocated compares the pod's requests with what is left on the
+// node given the resources already allocated there.
+func (f *nodeFit) explainResourceFitWithAllocated(pod *v1.Pod, node *v1.Node, nodeAllocated v1.ResourceList) (bool, *models.ResourceExplanation) {
+	// Calculate pod resource requests
+	requests := podRequests(pod)
+	podCPURequest := requests.Cpu()
+	podMemoryRequest := requests.Memory()
+	podStorageRequest := requests.StorageEphemeral()
+
+	// Get node allocatable resources
+	nodeCPUAllocatable := node.Status.Allocatable[v1.ResourceCPU]
+	nodeMemoryAllocatable := node.Status.Allocatable[v1.ResourceMemory]
+	nodeStorageAllocatable := node.Status.Allocatable[v1.ResourceEphemeralStorage]
+
+	explanation := &models.ResourceExplanation{
+		Fits:    true,
+		Details: make(map[string]models.ResourceDetail),
+	}
+
+	// Check CPU
+	cpuDetail := f.analyzeResourceDetail("cpu", *podCPURequest,
+		node.Status.Capacity[v1.ResourceCPU], nodeCPUAllocatable,
+		nodeAllocated[v1.ResourceCPU])
+	if cpuDetail.Shortage != "" {
+		explanation.Fits = false
+	}
+	explanation.Details["cpu"] = cpuDetail
+
+	// Check Memory
+	memoryDetail := f.analyzeResourceDetail("memory", *podMemoryRequest,
+		node.Status.Capacity[v1.ResourceMemory], nodeMemoryAllocatable,
+		nodeAllocated[v1.ResourceMemory])
+	if memoryDetail.Shortage != "" {
+		explanation.Fits = false
+	}
+	explanation.Details["memory"] = memoryDetail
+
+	// Check Storage if requested
+	if !podStorageRequest.IsZero() {
+		storageDetail := f.analyzeResourceDetail("ephemeral-storage", *podStorageRequest,
+			node.Status.Capacity[v1.ResourceEphemeralStorage], nodeStorageAllocatable,
+			nodeAllocated[v1.ResourceEphemeralStorage])
+		if storageDetail.Shortage != "" {
+			explanation.Fits = false
+		}
+		explanation.Details["ephemeral-storage"] = storageDetail
+	}
+
+	// Check extended resources such as GPUs; a node that does not
+	// advertise a requested resource has none of it.
+	extended := make([]string, 0, len(requests))
+	for name, request := range requests {
+		switch name {
+		case v1.ResourceCPU, v1.ResourceMemory, v1.ResourceEphemeralStorage, v1.ResourcePods:
+			continue
+		}
+		if request.Sign() > 0 {
+			extended = append(extended, string(name))
+		}
+	}
+	sort.Strings(extended)
+	for _, name := range extended {
+		resourceName := v1.ResourceName(name)
+		detail := f.analyzeResourceDetail(name, requests[resourceName],
+			node.Status.Capacity[resourceName], node.Status.Allocatable[resourceName],
+			nodeAllocated[resourceName])
+		if detail.Shortage != "" {
+			explanation.Fits = false
+		}
+		explanation.Details[name] = detail
+	}
+
+	// Check the pod slot when the node's pods were counted
+	if allocatedPods, ok := nodeAllocated[v1.ResourcePods]; ok {
+		if allocatablePods, ok := node.Status.Allocatable[v1.ResourcePods]; ok {
+			podsDetail := f.analyzeResourceDetail("pods", *resource.NewQuantity(1, resource.DecimalSI),
+				node.Status.Capacity[v1.ResourcePods], allocatablePods, allocatedPods)
+			if podsDetail.Shortage != "" {
+				explanation.Fits = false
+			}
+			explanation.Details["pods"] = podsDetail
+		}
+	}
+
+	// Generate summary
+	if !explanation.Fits {
+		shortages := []string{}
+		for resource, detail := range explanation.Details {
+			if detail.Shortage != "" {
+				shortages = append(shortages, fmt.Sprintf("%s: %s", resource, detail.Shortage))
+			}
+		}
+		sort.Strings(shortages)
+		explanation.Summary = fmt.Sprintf("Insufficient resources: %s", strings.Join(shortages, ", "))
+	}
+
+	return explanation.Fits, explanation
+}
+
+func (f *nodeFit) calculateNodeAllocatedResources(ctx context.Context, node *v1.Node) (v1.ResourceList, error) {
+	allocated := v1.ResourceList{
+		v1.ResourceCPU:              *resource.NewQuantity(0, resource.DecimalSI),
+		v1.ResourceMemory:           *resource.NewQuantity(0, resource.BinarySI),
+		v1.ResourceEphemeralStorage: *resource.NewQuantity(0, resource.BinarySI),
+	}
+
+	pods, err := listActiveNodePods(ctx, f.k8sClient, node.Name)
+	if err != nil {
+		return allocated, err
+	}
+
+	for i := range pods {
+		addResourceList(allocated, podRequests(&pods[i]))
+	}
+	allocated[v1.ResourcePods] = *resource.NewQuantity(int64(len(pods)), resource.DecimalSI)
+
+	return allocated, nil
+}
+
+func (f *nodeFit) analyzeResourceDetail(resourceName string, podRequest, nodeCapacity, nodeAllocatable, nodeAllocated resource.Quantity) models.ResourceDetail {
+	available := nodeAllocatable.DeepCopy()
+	available.Sub(nodeAllocated)
+
+	detail := models.ResourceDetail{
+		PodRequests:     podRequest.String(),
+		NodeCapacity:    nodeCapacity.String(),
+		NodeAllocatable: nodeAllocatable.String(),
+		NodeAllocated:   nodeAllocated.String(),
+		NodeAvailable:   available.String(),
+	}
+
+	// Calculate percentage used
+	if !nodeAllocatable.IsZero() {
+		percentUsed := float64(nodeAllocated.MilliValue()) / float64(nodeAllocatable.MilliValue()) * 100
+		detail.PercentUsed = math.Round(percentUsed*100) / 100 // Round to 2 decimal places
+	}
+
+	// Check if pod fits
+	if podRequest.Cmp(available) > 0 {
+		shortage := podRequest.DeepCopy()
+		shortage.Sub(available)
+		detail.Shortage = shortage.String()
+		detail.Recommendation = fmt.Sprintf("Pod needs %s more %s than available on this node", shortage.String(), resourceName)
+	}
+
+	return detail
+}
+
+func (f *nodeFit) explainPodAffinity(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.PodAffinityExplanation) {
+	if pod.Spec.Affinity == nil {
+		return true, &models.PodAffinityExplanation{Satisfied: true}
+	}
+
+	// Get all pods on the node
+	podList, err := f.k8sClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
+	})
+	if err != nil {
+		f.logger.Warn("failed to list pods for affinity check",
+			"node", node.Name,
+			"error", err.Error())
+		return true, &models.PodAffinityExplanation{Satisfied: true}
+	}
+
+	return f.explainPodAffinityWithPods(pod, podList.Items)
+}
+
+// explainPodAffinityWithPods checks the pod's required pod affinity and
+// anti-affinity against the given pods of one node, so callers simulating
+// placements can include pods that are not there yet.
+func (f *nodeFit) explainPodAffinityWithPods(pod *v1.Pod, nodePods []v1.Pod) (bool, *models.PodAffinityExplanation) {
+	explanation := &models.PodAffinityExplanation{
+		Satisfied: true,
+	}
+
+	if pod.Spec.Affinity == nil {
+		return true, explanation
+	}
+
+	// Check pod anti-affinity
+	if pod.Spec.Affinity.PodAntiAffinity != nil {
+		for _, term := range pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			for j := range nodePods {
+				existingPod := &nodePods[j]
+				if existingPod.Name == pod.Name && existingPod.Namespace == pod.Namespace {
+					continue // Skip self
+				}
+				if f.podMatchesAntiAffinityTerm(existingPod, term) {
+					explanation.Satisfied = false
+					explanation.AntiAffinityFailed = append(explanation.AntiAffinityFailed,
+						fmt.Sprintf("%s/%s", existingPod.Namespace, existingPod.Name))
+				}
+			}
+		}
+	}
+
+	// Check pod affinity
+	if pod.Spec.Affinity.PodAffinity != nil {
+		for _, term := range pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			matched := false
+			for j := range nodePods {
+				existingPod := &nodePods[j]
+				if f.podMatchesAffinityTerm(existingPod, term) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				explanation.Satisfied = false
+				explanation.RequiredNotMet = append(explanation.RequiredNotMet,
+					"No pods matching required affinity term found on node")
+			}
+		}
+	}
+
+	if !explanation.Satisfied {
+		details := []string{}
+		if len(explanation.AntiAffinityFailed) > 0 {
+			details = append(details, fmt.Sprintf("anti-affinity conflicts with pods: %s",
+				strings.Join(explanation.AntiAffinityFailed, ", ")))
+		}
+		if len(explanation.RequiredNotMet) > 0 {
+			details = append(details, strings.Join(explanation.RequiredNotMet, "; "))
+		}
+		explanation.Details = strings.Join(details, "; ")
+	}
+
+	return explanation.Satisfied, explanation
+}
+
+func (f *nodeFit) podMatchesAffinityTerm(pod *v1.Pod, term v1.PodAffinityTerm) bool {
+	// Same logic as podMatchesAntiAffinityTerm but for affinity
+	return f.podMatchesAntiAffinityTerm(pod, term)
+}
+
+func (f *nodeFit) podMatchesAntiAffinityTerm(pod *v1.Pod, term v1.PodAffinityTerm) bool {
+	if term.NamespaceSelector != nil {
+	}
+
+	namespaceMatch := false
+	if len(term.Namespaces) == 0 {
+		namespaceMatch = true
+	} else {
+		for _, ns := range term.Namespaces {
+			if pod.Namespace == ns {
+				namespaceMatch = true
+				break
+			}
+		}
+	}
+
+	if !namespaceMatch {
+		return false
+	}
+
+	if term.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return false
+		}
+		return selector.Matches(labels.Set(pod.Labels))
+	}
+
+	return false
+}
+
+func (f *nodeFit) checkPodVolumes(pod *v1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *nodeFit) explainVolumeConstraints(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.VolumeExplanation) {
+	explanation := &models.VolumeExplanation{
+		Satisfied: true,
+		Issues:    []string{},
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		pvc, err := f.k8sClient.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(
+			ctx, volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+		if err != nil {
+			explanation.Issues = append(explanation.Issues,
+				fmt.Sprintf("Failed to get PVC %s: %v", volume.PersistentVolumeClaim.ClaimName, err))
+			continue
+		}
+
+		if pvc.Status.Phase != v1.ClaimBound {
+			explanation.Satisfied = false
+			explanation.Issues = append(explanation.Issues,
+				fmt.Sprintf("PVC %s is not bound (status: %s)", pvc.Name, pvc.Status.Phase))
+			continue
+		}
+
+		if pvc.Spec.VolumeName != "" {
+			pv, err := f.k8sClient.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+			if err != nil {
+				explanation.Issues = append(explanation.Issues,
+					fmt.Sprintf("Failed to get PV %s: %v", pvc.Spec.VolumeName, err))
+				continue
+			}
+
+			// Check node affinity for volume
+			if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+				matches := false
+				for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+					if f.matchNodeSelectorTerm(node, term) {
+						matches = true
+						break
+					}
+				}
+				if !matches {
+					explanation.Satisfied = false
+					explanation.Issues = append(explanation.Issues,
+						fmt.Sprintf("PV %s has node affinity that doesn't match node %s", pv.Name, node.Name))
+				}
+			}
+
+			// Check for ReadWriteOnce access mode issues
+			if hasAccessMode(pvc.Status.AccessModes, v1.ReadWriteOnce) {
+				// Could check if volume is already attached to another node
+				explanation.Issues = append(explanation.Issues,
+					fmt.Sprintf("PVC %s has ReadWriteOnce access mode (potential multi-attach issue)", pvc.Name))
+			}
+		}
+	}
+
+	if !explanation.Satisfied {
+		explanation.Details = fmt.Sprintf("Volume constraints not satisfied: %s",
+			strings.Join(explanation.Issues, "; "))
+	}
+
+	return explanation.Satisfied, explanation
+}
+
+func hasAccessMode(modes []v1.PersistentVolumeAccessMode, mode v1.PersistentVolumeAccessMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNodeFit_ExplainResourceFitWithAllocated(t *testing.T) {
+	const gpu = v1.ResourceName("nvidia.com/gpu")
+
+	pod := newNodeTestPod("default", "train-1", "", "1", "1Gi")
+	pod.Spec.Containers[0].Resources.Requests[gpu] = resource.MustParse("2")
+
+	gpuNode := newDrainTestNode("gpu-node", "8")
+	gpuNode.Status.Capacity[gpu] = resource.MustParse("4")
+	gpuNode.Status.Allocatable[gpu] = resource.MustParse("4")
+
+	tests := []struct {
+		name            string
+		node            *v1.Node
+		allocated       v1.ResourceList
+		expectedFits    bool
+		expectedSummary string
+	}{
+		{
+			name:         "enough GPUs",
+			node:         gpuNode,
+			allocated:    v1.ResourceList{gpu: resource.MustParse("2")},
+			expectedFits: true,
+		},
+		{
+			name:            "GPUs taken by other pods",
+			node:            gpuNode,
+			allocated:       v1.ResourceList{gpu: resource.MustParse("3")},
+			expectedSummary: "Insufficient resources: nvidia.com/gpu: 1",
+		},
+		{
+			name:            "node without GPUs",
+			node:            newDrainTestNode("cpu-node", "8"),
+			allocated:       v1.ResourceList{},
+			expectedSummary: "Insufficient resources: nvidia.com/gpu: 2",
+		},
+	}
+
+	fit := newNodeFit(nil, slog.Default())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fits, explanation := fit.explainResourceFitWithAllocated(pod, tt.node, tt.allocated)
+			assert.Equal(t, tt.expectedFits, fits)
+			assert.Equal(t, tt.expectedSummary, explanation.Summary)
+			require.Contains(t, explanation.Details, string(gpu))
+			assert.Equal(t, "2", explanation.Details[string(gpu)].PodRequests)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const (
+	nodeLeaseNamespace = "kube-node-lease"
+
+	// defaultNodeLeaseDurationSeconds matches the kubelet's default
+	// nodeLeaseDurationSeconds.
+	defaultNodeLeaseDurationSeconds = 40
+)
+
+// standardNodeConditions are maintained by the kubelet and the node
+// controller. Any other condition type is treated as custom, e.g. one set
+// by node-problem-detector, and is unhealthy when True.
+var standardNodeConditions = map[v1.NodeConditionType]bool{
+	v1.NodeReady:              true,
+	v1.NodeMemoryPressure:     true,
+	v1.NodeDiskPressure:       true,
+	v1.NodePIDPressure:        true,
+	v1.NodeNetworkUnavailable: true,
+}
+
+// GetNodeHealth scores a node from its conditions, kubelet heartbeat, recent
+// events and schedulability.
+func (s *nodeService) GetNodeHealth(ctx context.Context, nodeName string) (*models.NodeHealth, error) {
+	s.logger.Debug("getting node health", "node", nodeName)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			s.logger.Debug("node not found", "node", nodeName)
+			return nil, core.ErrNodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	events, err := s.eventCollector.CollectForNode(ctx, nodeName)
+	if err != nil {
+		s.logger.Warn("failed to get node events",
+			"node", nodeName,
+			"error", err.Error(),
+		)
+		events = []models.EventInfo{}
+	}
+
+	health := &models.NodeHealth{
+		NodeName:     nodeName,
+		Components:   make(map[string]models.HealthComponent),
+		CalculatedAt: time.Now(),
+		Details: models.NodeHealthDetails{
+			Conditions:              []models.NodeConditionHealth{},
+			Unschedulable:           node.Spec.Unschedulable,
+			KubeletVersion:          node.Status.NodeInfo.KubeletVersion,
+			ContainerRuntimeVersion: node.Status.NodeInfo.ContainerRuntimeVersion,
+			KernelVersion:           node.Status.NodeInfo.KernelVersion,
+			OSImage:                 node.Status.NodeInfo.OSImage,
+			RecentEvents:            []models.EventSummary{},
+			Problems:                []string{},
+		},
+	}
+
+	s.scoreNodeConditions(health, node)
+	s.scoreNodeHeartbeat(ctx, health)
+	scoreNodeEvents(health, events)
+	scoreNodeSchedulability(health, node)
+
+	health.OverallScore = models.WeightedScore(health.Components)
+	health.Status = health.GetHealthStatus()
+
+	s.logger.Debug("successfully calculated node health",
+		"node", nodeName,
+		"score", health.OverallScore,
+		"problems", len(health.Details.Problems),
+	)
+
+	return health, nil
+}
+
+func (s *nodeService) scoreNodeConditions(health *models.NodeHealth, node *v1.Node) {
+	conditionScore := 100
+	unhealthy := 0
+
+	for _, condition := range node.Status.Conditions {
+		custom := !standardNodeConditions[condition.Type]
+		healthy := true
+		limit := 100
+
+		switch {
+		case condition.Type == v1.NodeReady && condition.Status == v1.ConditionUnknown:
+			healthy, limit = false, 10
+			health.Details.Problems = append(health.Details.Problems,
+				"Kubelet stopped posting node status (Ready is Unknown)")
+		case condition.Type == v1.NodeReady && condition.Status != v1.ConditionTrue:
+			healthy, limit = false, 0
+			health.Details.Problems = append(health.Details.Problems,
+				fmt.Sprintf("Node is NotReady: %s", condition.Message))
+		case condition.Type == v1.NodeNetworkUnavailable && condition.Status == v1.ConditionTrue:
+			healthy, limit = false, 20
+			health.Details.Problems = append(health.Details.Problems, "Node network is unavailable")
+		case condition.Type != v1.NodeReady && !custom && condition.Status == v1.ConditionTrue:
+			healthy, limit = false, 40
+			health.Details.Problems = append(health.Details.Problems,
+				fmt.Sprintf("Node reports %s", condition.Type))
+		case custom && condition.Status == v1.ConditionTrue:
+			healthy, limit = false, 60
+			health.Details.Problems = append(health.Details.Problems,
+				fmt.Sprintf("Node problem detected: %s (%s)", condition.Type, condition.Reason))
+		}
+
+		if !healthy {
+			unhealthy++
+			conditionScore = int(math.Min(float64(conditionScore), float64(limit)))
+		}
+
+		health.Details.Conditions = append(health.Details.Conditions, models.NodeConditionHealth{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+			Healthy:            healthy,
+			Custom:             custom,
+		})
+	}
+
+	health.Components["conditions"] = models.HealthComponent{
+		Name:        "Node Conditions",
+		Score:       conditionScore,
+		Weight:      0.40,
+		Status:      models.ComponentStatus(conditionScore),
+		Description: fmt.Sprintf("%d/%d conditions healthy", len(node.Status.Conditions)-unhealthy, len(node.Status.Conditions)),
+	}
+}
+
+// scoreNodeHeartbeat checks how recently the kubelet renewed its Lease. A
+// Lease older than its duration means the node controller will soon mark the
+// node NotReady, often before the Ready condition reflects it.
+func (s *nodeService) scoreNodeHeartbeat(ctx context.Context, health *models.NodeHealth) {
+	component := models.HealthComponent{
+		Name:   "Kubelet Heartbeat",
+		Weight: 0.25,
+	}
+
+	lease, err := s.k8sClient.CoordinationV1().Leases(nodeLeaseNamespace).Get(ctx, health.NodeName, metav1.GetOptions{})
+	switch {
+	case err != nil && !errors.IsNotFound(err):
+		s.logger.Warn("failed to get node lease",
+			"node", health.NodeName,
+			"error", err.Error(),
+		)
+		component.Score = 50
+		component.Description = "Heartbeat unknown: failed to read node lease"
+	case err != nil || lease.Spec.RenewTime == nil:
+		component.Score = 50
+		component.Description = "Heartbeat unknown: node lease not found"
+		health.Details.Problems = append(health.Details.Problems, "Kubelet node lease not found")
+	default:
+		duration := int32(defaultNodeLeaseDurationSeconds)
+		if lease.Spec.LeaseDurationSeconds != nil {
+			duration = *lease.Spec.LeaseDurationSeconds
+		}
+		age := time.Since(lease.Spec.RenewTime.Time)
+
+		heartbeat := &models.NodeHeartbeat{
+			RenewTime:            lease.Spec.RenewTime.Time,
+			AgeSeconds:           math.Round(age.Seconds()),
+			LeaseDurationSeconds: duration,
+			Stale:                age > time.Duration(duration)*time.Second,
+		}
+		health.Details.Heartbeat = heartbeat
+
+		switch {
+		case !heartbeat.Stale:
+			component.Score = 100
+		case age <= 2*time.Duration(duration)*time.Second:
+			component.Score = 60
+		default:
+			component.Score = 10
+		}
+		if heartbeat.Stale {
+			health.Details.Problems = append(health.Details.Problems,
+				fmt.Sprintf("Kubelet heartbeat is stale: last renewed %s ago", age.Round(time.Second)))
+		}
+		component.Description = fmt.Sprintf("Lease renewed %s ago", age.Round(time.Second))
+	}
+
+	component.Status = models.ComponentStatus(component.Score)
+	health.Components["heartbeat"] = component
+}
+
+func scoreNodeEvents(health *models.NodeHealth, events []models.EventInfo) {
+	eventScore := 100
+	problemEvents := 0
+	summaries := make(map[string]*models.EventSummary)
+	order := []string{}
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	for _, event := range events {
+		if event.LastTimestamp.Time.Before(cutoff) {
+			continue
+		}
+
+		key := event.Type + ":" + event.Reason
+		if summary, exists := summaries[key]; exists {
+			summary.Count += event.Count
+			if event.LastTimestamp.Time.After(summary.LastSeen) {
+				summary.LastSeen = event.LastTimestamp.Time
+			}
+		} else {
+			summaries[key] = &models.EventSummary{
+				Type:         event.Type,
+				Reason:       event.Reason,
+				Message:      event.Message,
+				Count:        event.Count,
+				LastSeen:     event.LastTimestamp.Time,
+				Relationship: event.Relationship,
+			}
+			order = append(order, key)
+		}
+
+		// NodeNotReady is recorded as a Normal event by the node
+		// controller, so reasons are matched regardless of type.
+		limit := 100
+		switch event.Reason {
+		case "NodeNotReady":
+			limit = 30
+		case "Rebooted", "SystemOOM":
+			limit = 40
+		case "EvictionThresholdMet", "FreeDiskSpaceFailed", "ImageGCFailed":
+			limit = 50
+		default:
+			if event.Type == v1.EventTypeWarning {
+				limit = 70
+			}
+		}
+		if limit < 100 {
+			problemEvents++
+			eventScore = int(math.Min(float64(eventScore), float64(limit)))
+		}
+	}
+
+	for _, key := range order {
+		health.Details.RecentEvents = append(health.Details.RecentEvents, *summaries[key])
+	}
+	sort.SliceStable(health.Details.RecentEvents, func(i, j int) bool {
+		return health.Details.RecentEvents[i].LastSeen.After(health.Details.RecentEvents[j].LastSeen)
+	})
+
+	health.Components["events"] = models.HealthComponent{
+		Name:        "Recent Events",
+		Score:       eventScore,
+		Weight:      0.20,
+		Status:      models.ComponentStatus(eventScore),
+		Description: fmt.Sprintf("%d problem events in last 24h", problemEvents),
+	}
+}
+
+func scoreNodeSchedulability(health *models.NodeHealth, node *v1.Node) {
+	component := models.HealthComponent{
+		Name:        "Schedulability",
+		Score:       100,
+		Weight:      0.15,
+		Description: "Node accepts new pods",
+	}
+
+	if node.Spec.Unschedulable {
+		component.Score = 50
+		component.Description = "Node is cordoned"
+		health.Details.Problems = append(health.Details.Problems, "Node is cordoned and does not accept new pods")
+	}
+
+	component.Status = models.ComponentStatus(component.Score)
+	health.Components["schedulability"] = component
+}
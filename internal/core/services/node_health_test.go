@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newHealthTestNode(name string, conditions ...v1.NodeCondition) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Conditions: conditions,
+			NodeInfo: v1.NodeSystemInfo{
+				KubeletVersion:          "v1.28.4",
+				ContainerRuntimeVersion: "containerd://1.7.2",
+			},
+		},
+	}
+}
+
+func newNodeLease(name string, renewed time.Time) *coordinationv1.Lease {
+	duration := int32(40)
+	renewTime := metav1.NewMicroTime(renewed)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nodeLeaseNamespace},
+		Spec: coordinationv1.LeaseSpec{
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &renewTime,
+		},
+	}
+}
+
+func TestNodeService_GetNodeHealth(t *testing.T) {
+	ready := v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionTrue}
+
+	tests := []struct {
+		name              string
+		node              *v1.Node
+		lease             *coordinationv1.Lease
+		events            []*v1.Event
+		expectedStatus    string
+		expectedScores    map[string]int
+		expectedProblems  int
+		expectStale       bool
+		expectedCustomBad string
+	}{
+		{
+			name:           "healthy node",
+			node:           newHealthTestNode("node-a", ready),
+			lease:          newNodeLease("node-a", time.Now()),
+			expectedStatus: "Healthy",
+			expectedScores: map[string]int{"conditions": 100, "heartbeat": 100, "events": 100, "schedulability": 100},
+		},
+		{
+			name: "pressure and custom condition",
+			node: newHealthTestNode("node-a", ready,
+				v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue},
+				v1.NodeCondition{Type: "KernelDeadlock", Status: v1.ConditionTrue, Reason: "DockerHung"},
+			),
+			lease:             newNodeLease("node-a", time.Now()),
+			expectedScores:    map[string]int{"conditions": 40},
+			expectedProblems:  2,
+			expectedCustomBad: "KernelDeadlock",
+		},
+		{
+			name:             "stale heartbeat",
+			node:             newHealthTestNode("node-a", v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionUnknown}),
+			lease:            newNodeLease("node-a", time.Now().Add(-5*time.Minute)),
+			expectedScores:   map[string]int{"conditions": 10, "heartbeat": 10},
+			expectedProblems: 2,
+			expectStale:      true,
+		},
+		{
+			name: "rebooted and cordoned",
+			node: func() *v1.Node {
+				node := newHealthTestNode("node-a", ready)
+				node.Spec.Unschedulable = true
+				return node
+			}(),
+			lease: newNodeLease("node-a", time.Now()),
+			events: []*v1.Event{{
+				ObjectMeta:     metav1.ObjectMeta{Name: "node-a-rebooted", Namespace: "default"},
+				InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "node-a"},
+				Type:           v1.EventTypeWarning,
+				Reason:         "Rebooted",
+				Count:          1,
+				LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Hour)),
+			}},
+			expectedScores:   map[string]int{"events": 40, "schedulability": 50},
+			expectedProblems: 1,
+		},
+		{
+			name:  "newest of many events decides",
+			node:  newHealthTestNode("node-a", ready),
+			lease: newNodeLease("node-a", time.Now()),
+			events: func() []*v1.Event {
+				events := []*v1.Event{}
+				for i := 0; i < 25; i++ {
+					events = append(events, &v1.Event{
+						ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("node-a-starting-%02d", i), Namespace: "default"},
+						InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "node-a"},
+						Type:           v1.EventTypeNormal,
+						Reason:         "Starting",
+						Count:          1,
+						LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Duration(i+2) * time.Hour)),
+					})
+				}
+				// Stored last, so it only counts when events are ranked by time.
+				return append(events, &v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "node-a-z-not-ready", Namespace: "kube-system"},
+					InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "node-a"},
+					Type:           v1.EventTypeNormal,
+					Reason:         "NodeNotReady",
+					Count:          1,
+					LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+				})
+			}(),
+			expectedScores: map[string]int{"events": 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset(tt.node, tt.lease)
+			for _, event := range tt.events {
+				_, err := fakeClient.CoreV1().Events(event.Namespace).Create(context.Background(), event, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			svc := NewNodeService(fakeClient, nil, slog.Default())
+
+			health, err := svc.GetNodeHealth(context.Background(), "node-a")
+			require.NoError(t, err)
+
+			if tt.expectedStatus != "" {
+				assert.Equal(t, tt.expectedStatus, health.Status)
+			}
+			for component, score := range tt.expectedScores {
+				assert.Equal(t, score, health.Components[component].Score, component)
+			}
+			assert.Len(t, health.Details.Problems, tt.expectedProblems)
+			assert.Equal(t, "v1.28.4", health.Details.KubeletVersion)
+			require.NotNil(t, health.Details.Heartbeat)
+			assert.Equal(t, tt.expectStale, health.Details.Heartbeat.Stale)
+
+			if tt.expectedCustomBad != "" {
+				var found *models.NodeConditionHealth
+				for i := range health.Details.Conditions {
+					if health.Details.Conditions[i].Type == tt.expectedCustomBad {
+						found = &health.Details.Conditions[i]
+					}
+				}
+				require.NotNil(t, found)
+				assert.True(t, found.Custom)
+				assert.False(t, found.Healthy)
+			}
+		})
+	}
+}
+
+func TestNodeService_GetNodeHealth_MissingLease(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(newHealthTestNode("node-a", v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionTrue}))
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	health, err := svc.GetNodeHealth(context.Background(), "node-a")
+	require.NoError(t, err)
+	assert.Nil(t, health.Details.Heartbeat)
+	assert.Equal(t, 50, health.Components["heartbeat"].Score)
+
+	_, err = svc.GetNodeHealth(context.Background(), "missing")
+	assert.ErrorIs(t, err, core.ErrNodeNotFound)
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// maxKubeletMinorSkew is how many minor versions a kubelet may trail the
+// API server under the Kubernetes version skew policy (1.28 and later).
+const maxKubeletMinorSkew = 3
+
+// poolLabels are the node pool labels set by common managed offerings and
+// autoscalers, in order of preference.
+var poolLabels = []string{
+	"cloud.google.com/gke-nodepool",
+	"eks.amazonaws.com/nodegroup",
+	"alpha.eksctl.io/nodegroup-name",
+	"kubernetes.azure.com/agentpool",
+	"agentpool",
+	"karpenter.sh/nodepool",
+	"karpenter.sh/provisioner-name",
+	"doks.digitalocean.com/node-pool",
+	"node-pool",
+}
+
+// ListNodes returns every node matching the selector with its platform
+// details, grouped by region, zone and pool, and flags kubelet version skew
+// against the control plane.
+func (s *nodeService) ListNodes(ctx context.Context, opts models.NodeInventoryOptions) (*models.NodeInventory, error) {
+	s.logger.Debug("listing node inventory",
+		"label_selector", opts.LabelSelector,
+		"pool_label", opts.PoolLabel,
+	)
+
+	nodes, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: opts.LabelSelector})
+	if err != nil {
+		s.logger.Error("failed to list nodes from kubernetes API", "error", err.Error())
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	inventory := &models.NodeInventory{
+		TotalNodes:  len(nodes.Items),
+		Nodes:       make([]models.NodeInventoryItem, 0, len(nodes.Items)),
+		VersionSkew: []models.NodeVersionSkew{},
+		CollectedAt: time.Now(),
+	}
+
+	for i := range nodes.Items {
+		inventory.Nodes = append(inventory.Nodes, nodeInventoryItem(&nodes.Items[i], opts.PoolLabel))
+	}
+	sort.Slice(inventory.Nodes, func(i, j int) bool {
+		return inventory.Nodes[i].Name < inventory.Nodes[j].Name
+	})
+
+	inventory.Regions = groupNodes(inventory.Nodes, func(item models.NodeInventoryItem) string { return item.Region })
+	inventory.Zones = groupNodes(inventory.Nodes, func(item models.NodeInventoryItem) string { return item.Zone })
+	inventory.Pools = nodePools(inventory.Nodes)
+
+	serverVersion, err := s.k8sClient.Discovery().ServerVersion()
+	if err != nil {
+		s.logger.Warn("failed to get control plane version", "error", err.Error())
+		inventory.Warnings = append(inventory.Warnings, "control plane version unavailable; version skew not evaluated")
+	} else {
+		inventory.ControlPlaneVersion = serverVersion.GitVersion
+		skew, warnings := kubeletVersionSkew(serverVersion.GitVersion, inventory.Nodes)
+		inventory.VersionSkew = skew
+		inventory.Warnings = append(inventory.Warnings, warnings...)
+	}
+
+	s.logger.Debug("node inventory listed",
+		"nodes", inventory.TotalNodes,
+		"pools", len(inventory.Pools),
+		"skewed", len(inventory.VersionSkew),
+	)
+
+	return inventory, nil
+}
+
+func nodeInventoryItem(node *v1.Node, poolLabel string) models.NodeInventoryItem {
+	info := node.Status.NodeInfo
+
+	item := models.NodeInventoryItem{
+		Name:             node.Name,
+		Region:           firstLabel(node.Labels, v1.LabelTopologyRegion, v1.LabelFailureDomainBetaRegion),
+		Zone:             firstLabel(node.Labels, v1.LabelTopologyZone, v1.LabelFailureDomainBetaZone),
+		InstanceType:     firstLabel(node.Labels, v1.LabelInstanceTypeStable, v1.LabelInstanceType),
+		KubeletVersion:   info.KubeletVersion,
+		ContainerRuntime: info.ContainerRuntimeVersion,
+		OSImage:          info.OSImage,
+		KernelVersion:    info.KernelVersion,
+		OperatingSystem:  info.OperatingSystem,
+		Architecture:     info.Architecture,
+		Taints:           make([]string, 0, len(node.Spec.Taints)),
+		Allocatable:      make(map[string]string, len(node.Status.Allocatable)),
+		Ready:            nodeConditionTrue(node, v1.NodeReady),
+		Unschedulable:    node.Spec.Unschedulable,
+		CreatedAt:        node.CreationTimestamp.Time,
+	}
+
+	if poolLabel != "" {
+		item.Pool = node.Labels[poolLabel]
+	} else {
+		item.Pool = firstLabel(node.Labels, poolLabels...)
+	}
+
+	for _, label := range []*string{&item.Region, &item.Zone, &item.Pool} {
+		if *label == "" {
+			*label = models.NodeTopologyUnknown
+		}
+	}
+
+	for i := range node.Spec.Taints {
+		item.Taints = append(item.Taints, node.Spec.Taints[i].ToString())
+	}
+	for name, quantity := range node.Status.Allocatable {
+		item.Allocatable[string(name)] = quantity.String()
+	}
+
+	return item
+}
+
+func firstLabel(nodeLabels map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := nodeLabels[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func nodeConditionTrue(node *v1.Node, conditionType v1.NodeConditionType) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// groupNodes buckets nodes by key; nodes are already sorted by name, so
+// each group's members are too.
+func groupNodes(items []models.NodeInventoryItem, key func(models.NodeInventoryItem) string) []models.NodeGroup {
+	index := make(map[string]int)
+	groups := []models.NodeGroup{}
+
+	for _, item := range items {
+		name := key(item)
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, models.NodeGroup{Name: name})
+		}
+		groups[i].NodeCount++
+		groups[i].Nodes = append(groups[i].Nodes, item.Name)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
+func nodePools(items []models.NodeInventoryItem) []models.NodePool {
+	byName := make(map[string]models.NodeInventoryItem, len(items))
+	for _, item := range items {
+		byName[item.Name] = item
+	}
+
+	fields := []struct {
+		name  string
+		value func(models.NodeInventoryItem) string
+	}{
+		{"instanceType", func(item models.NodeInventoryItem) string { return item.InstanceType }},
+		{"architecture", func(item models.NodeInventoryItem) string { return item.Architecture }},
+		{"osImage", func(item models.NodeInventoryItem) string { return item.OSImage }},
+		{"containerRuntime", func(item models.NodeInventoryItem) string { return item.ContainerRuntime }},
+		{"kubeletVersion", func(item models.NodeInventoryItem) string { return item.KubeletVersion }},
+		{"allocatableCPU", func(item models.NodeInventoryItem) string { return item.Allocatable[string(v1.ResourceCPU)] }},
+		{"allocatableMemory", func(item models.NodeInventoryItem) string { return item.Allocatable[string(v1.ResourceMemory)] }},
+	}
+
+	groups := groupNodes(items, func(item models.NodeInventoryItem) string { return item.Pool })
+	pools := make([]models.NodePool, 0, len(groups))
+	for _, group := range groups {
+		pool := models.NodePool{NodeGroup: group}
+
+		// Nodes without a pool label are not expected to match each other.
+		if group.Name != models.NodeTopologyUnknown {
+			for _, field := range fields {
+				seen := make(map[string]bool)
+				var values []string
+				for _, name := range group.Nodes {
+					value := field.value(byName[name])
+					if !seen[value] {
+						seen[value] = true
+						values = append(values, value)
+					}
+				}
+				if len(values) > 1 {
+					sort.Strings(values)
+					pool.Differences = append(pool.Differences,
+						fmt.Sprintf("%s: %s", field.name, strings.Join(values, ", ")))
+				}
+			}
+		}
+
+		pool.Heterogeneous = len(pool.Differences) > 0
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// kubeletVersionSkew compares each kubelet's minor version with the API
+// server's and reports every node that differs.
+func kubeletVersionSkew(serverVersion string, items []models.NodeInventoryItem) ([]models.NodeVersionSkew, []string) {
+	skew := []models.NodeVersionSkew{}
+
+	server, err := version.ParseGeneric(serverVersion)
+	if err != nil {
+		return skew, []string{fmt.Sprintf("cannot parse control plane version %q; version skew not evaluated", serverVersion)}
+	}
+
+	var warnings []string
+	for _, item := range items {
+		kubelet, err := version.ParseGeneric(item.KubeletVersion)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("cannot parse kubelet version %q on node %s", item.KubeletVersion, item.Name))
+			continue
+		}
+		if kubelet.Major() != server.Major() {
+			skew = append(skew, models.NodeVersionSkew{
+				NodeName:       item.Name,
+				KubeletVersion: item.KubeletVersion,
+				Supported:      false,
+				Message:        fmt.Sprintf("kubelet major version differs from control plane %s", serverVersion),
+			})
+			continue
+		}
+
+		behind := int(server.Minor()) - int(kubelet.Minor())
+		if behind == 0 {
+			continue
+		}
+
+		entry := models.NodeVersionSkew{
+			NodeName:       item.Name,
+			KubeletVersion: item.KubeletVersion,
+			MinorVersions:  behind,
+		}
+		switch {
+		case behind < 0:
+			entry.Message = fmt.Sprintf("kubelet is %d minor version(s) newer than control plane %s, which is not supported", -behind, serverVersion)
+		case behind > maxKubeletMinorSkew:
+			entry.Message = fmt.Sprintf("kubelet is %d minor versions behind control plane %s, beyond the supported %d", behind, serverVersion, maxKubeletMinorSkew)
+		default:
+			entry.Supported = true
+			entry.Message = fmt.Sprintf("kubelet is %d minor version(s) behind control plane %s", behind, serverVersion)
+		}
+		skew = append(skew, entry)
+	}
+
+	return skew, warnings
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newInventoryTestNode(name, zone, pool, instanceType, kubeletVersion string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1.LabelTopologyRegion:        "us-east-1",
+				v1.LabelTopologyZone:          zone,
+				"eks.amazonaws.com/nodegroup": pool,
+				v1.LabelInstanceTypeStable:    instanceType,
+			},
+		},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			NodeInfo: v1.NodeSystemInfo{
+				KubeletVersion:          kubeletVersion,
+				ContainerRuntimeVersion: "containerd://1.7.2",
+				OSImage:                 "Amazon Linux 2",
+				OperatingSystem:         "linux",
+				Architecture:            "amd64",
+			},
+		},
+	}
+}
+
+func TestNodeService_ListNodes(t *testing.T) {
+	tainted := newInventoryTestNode("node-c", "us-east-1b", "gpu", "g5.xlarge", "v1.28.4-eks-1")
+	tainted.Spec.Taints = []v1.Taint{{Key: "nvidia.com/gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}}
+	tainted.Spec.Unschedulable = true
+
+	unlabeled := newInventoryTestNode("node-d", "", "", "m5.large", "v1.23.0")
+	unlabeled.Labels = nil
+
+	fakeClient := fake.NewSimpleClientset(
+		newInventoryTestNode("node-a", "us-east-1a", "general", "m5.large", "v1.28.4-eks-1"),
+		newInventoryTestNode("node-b", "us-east-1b", "general", "m5.xlarge", "v1.27.8-eks-1"),
+		tainted,
+		unlabeled,
+	)
+	fakeClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{
+		Major: "1", Minor: "28", GitVersion: "v1.28.4-eks-1",
+	}
+
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	inventory, err := svc.ListNodes(context.Background(), models.NodeInventoryOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, inventory.TotalNodes)
+	assert.Equal(t, "v1.28.4-eks-1", inventory.ControlPlaneVersion)
+	require.Len(t, inventory.Nodes, 4)
+
+	gpuNode := inventory.Nodes[2]
+	assert.Equal(t, "node-c", gpuNode.Name)
+	assert.Equal(t, []string{"nvidia.com/gpu=true:NoSchedule"}, gpuNode.Taints)
+	assert.True(t, gpuNode.Unschedulable)
+	assert.True(t, gpuNode.Ready)
+	assert.Equal(t, "16Gi", gpuNode.Allocatable["memory"])
+
+	assert.Equal(t, models.NodeTopologyUnknown, inventory.Nodes[3].Zone)
+	assert.Equal(t, models.NodeTopologyUnknown, inventory.Nodes[3].Pool)
+
+	require.Len(t, inventory.Zones, 3)
+	assert.Equal(t, models.NodeGroup{Name: "unknown", NodeCount: 1, Nodes: []string{"node-d"}}, inventory.Zones[0])
+	assert.Equal(t, models.NodeGroup{Name: "us-east-1b", NodeCount: 2, Nodes: []string{"node-b", "node-c"}}, inventory.Zones[2])
+	require.Len(t, inventory.Regions, 2)
+
+	require.Len(t, inventory.Pools, 3)
+	general := inventory.Pools[0]
+	assert.Equal(t, "general", general.Name)
+	assert.True(t, general.Heterogeneous)
+	assert.Equal(t, []string{
+		"instanceType: m5.large, m5.xlarge",
+		"kubeletVersion: v1.27.8-eks-1, v1.28.4-eks-1",
+	}, general.Differences)
+	assert.False(t, inventory.Pools[1].Heterogeneous)
+	assert.Equal(t, "unknown", inventory.Pools[2].Name)
+
+	require.Len(t, inventory.VersionSkew, 2)
+	assert.Equal(t, "node-b", inventory.VersionSkew[0].NodeName)
+	assert.Equal(t, 1, inventory.VersionSkew[0].MinorVersions)
+	assert.True(t, inventory.VersionSkew[0].Supported)
+	assert.Equal(t, "node-d", inventory.VersionSkew[1].NodeName)
+	assert.Equal(t, 5, inventory.VersionSkew[1].MinorVersions)
+	assert.False(t, inventory.VersionSkew[1].Supported)
+
+	t.Run("label selector and pool label", func(t *testing.T) {
+		inventory, err := svc.ListNodes(context.Background(), models.NodeInventoryOptions{
+			LabelSelector: v1.LabelTopologyZone + "=us-east-1b",
+			PoolLabel:     v1.LabelInstanceTypeStable,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, inventory.TotalNodes)
+		require.Len(t, inventory.Pools, 2)
+		assert.Equal(t, "g5.xlarge", inventory.Pools[0].Name)
+		assert.Equal(t, "m5.xlarge", inventory.Pools[1].Name)
+	})
+}
+
+func TestKubeletVersionSkew(t *testing.T) {
+	items := []models.NodeInventoryItem{
+		{Name: "newer", KubeletVersion: "v1.29.0"},
+		{Name: "same", KubeletVersion: "v1.28.1"},
+		{Name: "garbage", KubeletVersion: "unknown"},
+	}
+
+	skew, warnings := kubeletVersionSkew("v1.28.4", items)
+	require.Len(t, skew, 1)
+	assert.Equal(t, "newer", skew[0].NodeName)
+	assert.Equal(t, -1, skew[0].MinorVersions)
+	assert.False(t, skew[0].Supported)
+	assert.Len(t, warnings, 1)
+
+	skew, warnings = kubeletVersionSkew("not-a-version", items)
+	assert.Empty(t, skew)
+	assert.Len(t, warnings, 1)
+}
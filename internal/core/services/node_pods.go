@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// GetNodePods breaks a node's load down by pod: requests, limits, QoS class
+// and live usage, with totals against allocatable. Pod metrics are best
+// effort; without them the breakdown is ranked by requests.
+func (s *nodeService) GetNodePods(ctx context.Context, nodeName string, sortBy string) (*models.NodePodBreakdown, error) {
+	s.logger.Debug("getting node pod breakdown", "node", nodeName, "sort_by", sortBy)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			s.logger.Debug("node not found", "node", nodeName)
+			return nil, core.ErrNodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	pods, err := listActiveNodePods(ctx, s.k8sClient, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	usageByPod, metricsAvailable := s.getPodUsage(ctx, pods)
+
+	allocatableCPU := node.Status.Allocatable[v1.ResourceCPU]
+	allocatableMemory := node.Status.Allocatable[v1.ResourceMemory]
+
+	totalRequests := v1.ResourceList{}
+	totalLimits := v1.ResourceList{}
+	totalUsage := v1.ResourceList{}
+
+	breakdown := &models.NodePodBreakdown{
+		NodeName:         nodeName,
+		PodCount:         len(pods),
+		MetricsAvailable: metricsAvailable,
+		Pods:             make([]models.NodePodUsage, 0, len(pods)),
+		Timestamp:        time.Now(),
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+		requests := podRequests(pod)
+		limits := podLimits(pod)
+		addResourceList(totalRequests, requests)
+		addResourceList(totalLimits, limits)
+
+		podUsage := models.NodePodUsage{
+			Namespace:               pod.Namespace,
+			Name:                    pod.Name,
+			Phase:                   string(pod.Status.Phase),
+			QOSClass:                string(pod.Status.QOSClass),
+			CPURequest:              quantityString(requests, v1.ResourceCPU),
+			CPULimit:                quantityString(limits, v1.ResourceCPU),
+			CPURequestPercentage:    percentOf(requests[v1.ResourceCPU], allocatableCPU),
+			MemoryRequest:           quantityString(requests, v1.ResourceMemory),
+			MemoryLimit:             quantityString(limits, v1.ResourceMemory),
+			MemoryRequestPercentage: percentOf(requests[v1.ResourceMemory], allocatableMemory),
+		}
+
+		if usage, ok := usageByPod[pod.Namespace+"/"+pod.Name]; ok {
+			addResourceList(totalUsage, usage)
+			podUsage.CPUUsage = quantityString(usage, v1.ResourceCPU)
+			podUsage.CPUUsagePercentage = percentOf(usage[v1.ResourceCPU], allocatableCPU)
+			podUsage.MemoryUsage = quantityString(usage, v1.ResourceMemory)
+			podUsage.MemoryUsagePercentage = percentOf(usage[v1.ResourceMemory], allocatableMemory)
+		}
+
+		breakdown.Pods = append(breakdown.Pods, podUsage)
+	}
+
+	breakdown.CPU = nodeResourceTotals(v1.ResourceCPU, allocatableCPU, totalRequests, totalLimits, totalUsage, metricsAvailable)
+	breakdown.Memory = nodeResourceTotals(v1.ResourceMemory, allocatableMemory, totalRequests, totalLimits, totalUsage, metricsAvailable)
+
+	sortNodePods(breakdown.Pods, sortBy, metricsAvailable)
+
+	s.logger.Debug("successfully built node pod breakdown",
+		"node", nodeName,
+		"pods", breakdown.PodCount,
+		"metrics_available", metricsAvailable,
+	)
+
+	return breakdown, nil
+}
+
+// getPodUsage returns the summed container usage of each pod keyed by
+// namespace/name. Metrics are listed per namespace rather than cluster-wide
+// since a node usually hosts pods from only a few namespaces.
+func (s *nodeService) getPodUsage(ctx context.Context, pods []v1.Pod) (map[string]v1.ResourceList, bool) {
+	if s.metricsClient == nil {
+		return nil, false
+	}
+
+	namespaces := make(map[string]bool)
+	for i := range pods {
+		namespaces[pods[i].Namespace] = true
+	}
+
+	usageByPod := make(map[string]v1.ResourceList)
+	for namespace := range namespaces {
+		metricsList, err := s.metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			s.logger.Warn("failed to list pod metrics",
+				"namespace", namespace,
+				"error", err.Error(),
+			)
+			return nil, false
+		}
+
+		for _, podMetrics := range metricsList.Items {
+			usage := v1.ResourceList{}
+			for _, container := range podMetrics.Containers {
+				addResourceList(usage, container.Usage)
+			}
+			usageByPod[podMetrics.Namespace+"/"+podMetrics.Name] = usage
+		}
+	}
+
+	return usageByPod, true
+}
+
+func nodeResourceTotals(name v1.ResourceName, allocatable resource.Quantity, requests, limits, usage v1.ResourceList, metricsAvailable bool) models.NodeResourceTotals {
+	totals := models.NodeResourceTotals{
+		Allocatable:        allocatable.String(),
+		Requests:           quantityString(requests, name),
+		Limits:             quantityString(limits, name),
+		RequestsPercentage: percentOf(requests[name], allocatable),
+		LimitsPercentage:   percentOf(limits[name], allocatable),
+	}
+	if metricsAvailable {
+		totals.Usage = quantityString(usage, name)
+		totals.UsagePercentage = percentOf(usage[name], allocatable)
+	}
+	return totals
+}
+
+// sortNodePods ranks pods by their share of the node, top consumers first.
+// Usage is used when metrics are available, otherwise requests.
+func sortNodePods(pods []models.NodePodUsage, sortBy string, metricsAvailable bool) {
+	share := func(pod models.NodePodUsage) float64 {
+		switch {
+		case sortBy == models.NodeUtilizationSortMemory && metricsAvailable:
+			return pod.MemoryUsagePercentage
+		case sortBy == models.NodeUtilizationSortMemory:
+			return pod.MemoryRequestPercentage
+		case metricsAvailable:
+			return pod.CPUUsagePercentage
+		default:
+			return pod.CPURequestPercentage
+		}
+	}
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		if sortBy != models.NodeUtilizationSortName {
+			if a, b := share(pods[i]), share(pods[j]); a != b {
+				return a > b
+			}
+		}
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -15,19 +16,26 @@ import (
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
 )
 
 type nodeService struct {
-	k8sClient     kubernetes.Interface
-	metricsClient metricsclientset.Interface
-	logger        *slog.Logger
+	k8sClient      kubernetes.Interface
+	metricsClient  metricsclientset.Interface
+	eventCollector *relatedevents.Collector
+	kubeletStats   kubeletStatsSource
+	fit            *nodeFit
+	logger         *slog.Logger
 }
 
 func NewNodeService(k8sClient kubernetes.Interface, metricsClient metricsclientset.Interface, logger *slog.Logger) core.NodeService {
 	return &nodeService{
-		k8sClient:     k8sClient,
-		metricsClient: metricsClient,
-		logger:        logger,
+		k8sClient:      k8sClient,
+		metricsClient:  metricsClient,
+		eventCollector: relatedevents.NewCollector(k8sClient, logger),
+		kubeletStats:   &proxyKubeletStats{k8sClient: k8sClient},
+		fit:            newNodeFit(k8sClient, logger),
+		logger:         logger,
 	}
 }
 
@@ -65,30 +73,129 @@ func (s *nodeService) GetNodeUtilization(ctx context.Context, nodeName string) (
 		return nil, fmt.Errorf("failed to get metrics for node %s: %w", nodeName, err)
 	}
 
-	cpuCapacity := node.Status.Capacity[v1.ResourceCPU]
-	memoryCapacity := node.Status.Capacity[v1.ResourceMemory]
-
-	cpuUsage := nodeMetrics.Usage[v1.ResourceCPU]
-	memoryUsage := nodeMetrics.Usage[v1.ResourceMemory]
-
-	cpuPercentage := calculatePercentage(&cpuUsage, &cpuCapacity)
-	memoryPercentage := calculatePercentage(&memoryUsage, &memoryCapacity)
+	result := nodeUtilizationFrom(node, nodeMetrics.Usage)
 
-	result := &models.NodeUtilization{
-		NodeName:         nodeName,
-		CPUUsage:         cpuUsage.String(),
-		CPUCapacity:      cpuCapacity.String(),
-		CPUPercentage:    cpuPercentage,
-		MemoryUsage:      memoryUsage.String(),
-		MemoryCapacity:   memoryCapacity.String(),
-		MemoryPercentage: memoryPercentage,
-		Timestamp:        time.Now(),
+	pods, err := listActiveNodePods(ctx, s.k8sClient, nodeName)
+	if err != nil {
+		s.logger.Warn("failed to list pods for node allocation",
+			"node", nodeName,
+			"error", err.Error(),
+		)
+	} else {
+		result.Allocation = nodeAllocation(node, pods)
 	}
 
 	s.logger.Debug("successfully retrieved node utilization",
 		"node", nodeName,
-		"cpu_percentage", cpuPercentage,
-		"memory_percentage", memoryPercentage,
+		"cpu_percentage", result.CPUPercentage,
+		"memory_percentage", result.MemoryPercentage,
+	)
+
+	return &result, nil
+}
+
+func (s *nodeService) ListNodeUtilization(ctx context.Context, opts models.NodeUtilizationListOptions) (*models.NodeUtilizationList, error) {
+	s.logger.Debug("listing node utilization",
+		"label_selector", opts.LabelSelector,
+		"sort_by", opts.SortBy,
+	)
+
+	nodes, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: opts.LabelSelector})
+	if err != nil {
+		s.logger.Error("failed to list nodes from kubernetes API", "error", err.Error())
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	if s.metricsClient == nil {
+		s.logger.Warn("metrics server not available")
+		return nil, core.ErrMetricsNotAvailable
+	}
+
+	// A single list call returns metrics for every node, which avoids one
+	// round trip per node on large clusters.
+	metricsList, err := s.metricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		s.logger.Warn("metrics server not available", "error", err.Error())
+		return nil, core.ErrMetricsNotAvailable
+	}
+
+	usageByNode := make(map[string]v1.ResourceList, len(metricsList.Items))
+	for _, nodeMetrics := range metricsList.Items {
+		usageByNode[nodeMetrics.Name] = nodeMetrics.Usage
+	}
+
+	podsByNode, err := listActivePodsByNode(ctx, s.k8sClient)
+	if err != nil {
+		s.logger.Warn("failed to list pods for node allocation", "error", err.Error())
+	}
+
+	result := &models.NodeUtilizationList{
+		TotalNodes: len(nodes.Items),
+		Nodes:      []models.NodeUtilization{},
+		Timestamp:  time.Now(),
+	}
+
+	var cpuUsage, cpuCapacity, cpuAllocatable, memoryUsage, memoryCapacity, memoryAllocatable resource.Quantity
+	requested := v1.ResourceList{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		usage, ok := usageByNode[node.Name]
+		if !ok {
+			result.NodesWithoutMetrics = append(result.NodesWithoutMetrics, node.Name)
+			continue
+		}
+
+		utilization := nodeUtilizationFrom(node, usage)
+		if podsByNode != nil {
+			pods := podsByNode[node.Name]
+			utilization.Allocation = nodeAllocation(node, pods)
+			for j := range pods {
+				addResourceList(requested, podRequests(&pods[j]))
+			}
+		}
+
+		cpuUsage.Add(usage[v1.ResourceCPU])
+		cpuCapacity.Add(node.Status.Capacity[v1.ResourceCPU])
+		cpuAllocatable.Add(node.Status.Allocatable[v1.ResourceCPU])
+		memoryUsage.Add(usage[v1.ResourceMemory])
+		memoryCapacity.Add(node.Status.Capacity[v1.ResourceMemory])
+		memoryAllocatable.Add(node.Status.Allocatable[v1.ResourceMemory])
+
+		if opts.CPUAbove > 0 && utilization.CPUPercentage <= opts.CPUAbove {
+			continue
+		}
+		if opts.MemoryAbove > 0 && utilization.MemoryPercentage <= opts.MemoryAbove {
+			continue
+		}
+
+		result.Nodes = append(result.Nodes, utilization)
+	}
+
+	result.MatchingNodes = len(result.Nodes)
+	cpuRequested := requested[v1.ResourceCPU]
+	memoryRequested := requested[v1.ResourceMemory]
+	result.Totals = models.NodeUtilizationTotals{
+		CPUUsage:                  cpuUsage.String(),
+		CPUCapacity:               cpuCapacity.String(),
+		CPUAllocatable:            cpuAllocatable.String(),
+		CPURequested:              cpuRequested.String(),
+		CPUPercentage:             calculatePercentage(&cpuUsage, &cpuAllocatable),
+		CPURequestedPercentage:    percentOf(cpuRequested, cpuAllocatable),
+		MemoryUsage:               memoryUsage.String(),
+		MemoryCapacity:            memoryCapacity.String(),
+		MemoryAllocatable:         memoryAllocatable.String(),
+		MemoryRequested:           memoryRequested.String(),
+		MemoryPercentage:          calculatePercentage(&memoryUsage, &memoryAllocatable),
+		MemoryRequestedPercentage: percentOf(memoryRequested, memoryAllocatable),
+	}
+
+	sortNodeUtilization(result.Nodes, opts.SortBy)
+
+	s.logger.Debug("successfully listed node utilization",
+		"total_nodes", result.TotalNodes,
+		"matching_nodes", result.MatchingNodes,
+		"nodes_without_metrics", len(result.NodesWithoutMetrics),
 	)
 
 	return result, nil
@@ -109,6 +216,47 @@ func (s *nodeService) checkMetricsAvailable(ctx context.Context) bool {
 	return true
 }
 
+func nodeUtilizationFrom(node *v1.Node, usage v1.ResourceList) models.NodeUtilization {
+	cpuCapacity := node.Status.Capacity[v1.ResourceCPU]
+	memoryCapacity := node.Status.Capacity[v1.ResourceMemory]
+	cpuAllocatable := node.Status.Allocatable[v1.ResourceCPU]
+	memoryAllocatable := node.Status.Allocatable[v1.ResourceMemory]
+
+	cpuUsage := usage[v1.ResourceCPU]
+	memoryUsage := usage[v1.ResourceMemory]
+
+	return models.NodeUtilization{
+		NodeName:          node.Name,
+		CPUUsage:          cpuUsage.String(),
+		CPUCapacity:       cpuCapacity.String(),
+		CPUAllocatable:    cpuAllocatable.String(),
+		CPUPercentage:     calculatePercentage(&cpuUsage, &cpuAllocatable),
+		MemoryUsage:       memoryUsage.String(),
+		MemoryCapacity:    memoryCapacity.String(),
+		MemoryAllocatable: memoryAllocatable.String(),
+		MemoryPercentage:  calculatePercentage(&memoryUsage, &memoryAllocatable),
+		Timestamp:         time.Now(),
+	}
+}
+
+// sortNodeUtilization orders nodes by the requested metric, busiest first,
+// falling back to the node name so the output is stable.
+func sortNodeUtilization(nodes []models.NodeUtilization, sortBy string) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		switch sortBy {
+		case models.NodeUtilizationSortCPU:
+			if nodes[i].CPUPercentage != nodes[j].CPUPercentage {
+				return nodes[i].CPUPercentage > nodes[j].CPUPercentage
+			}
+		case models.NodeUtilizationSortMemory:
+			if nodes[i].MemoryPercentage != nodes[j].MemoryPercentage {
+				return nodes[i].MemoryPercentage > nodes[j].MemoryPercentage
+			}
+		}
+		return nodes[i].NodeName < nodes[j].NodeName
+	})
+}
+
 func calculatePercentage(usage, capacity *resource.Quantity) float64 {
 	if usage == nil || capacity == nil {
 		return 0
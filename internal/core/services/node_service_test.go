@@ -5,14 +5,94 @@ import (
 	"log/slog"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
 )
 
+func newUtilizationTestNode(name, zone, cpu, memory string) *v1.Node {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpu),
+		v1.ResourceMemory: resource.MustParse(memory),
+	}
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"topology.kubernetes.io/zone": zone},
+		},
+		Status: v1.NodeStatus{
+			Capacity:    resources,
+			Allocatable: resources.DeepCopy(),
+		},
+	}
+}
+
+func newTestNodeMetrics(name, cpu, memory string) *metricsv1beta1.NodeMetrics {
+	return &metricsv1beta1.NodeMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Usage: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		},
+	}
+}
+
+// newNodeMetricsClient returns a fake metrics clientset that serves the given
+// node metrics. The generated fake tracks NodeMetrics under a guessed resource
+// name that list calls never query, so lists are answered by a reactor.
+func newNodeMetricsClient(items ...metricsv1beta1.NodeMetrics) *metricsfake.Clientset {
+	client := metricsfake.NewSimpleClientset()
+	client.PrependReactor("list", "nodes", func(action ktesting.Action) (bool, runtime.Object, error) {
+		return true, &metricsv1beta1.NodeMetricsList{Items: items}, nil
+	})
+	return client
+}
+
+func newNodeTestPod(namespace, name, nodeName, cpuRequest, memoryRequest string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpuRequest),
+						v1.ResourceMemory: resource.MustParse(memoryRequest),
+					},
+					Limits: v1.ResourceList{
+						v1.ResourceCPU: resource.MustParse("2"),
+					},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning, QOSClass: v1.PodQOSBurstable},
+	}
+}
+
+func newTestPodMetrics(namespace, name, cpu, memory string) metricsv1beta1.PodMetrics {
+	return metricsv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: "app",
+			Usage: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(memory),
+			},
+		}},
+	}
+}
+
 func TestNodeService_GetNodeUtilization_NoMetrics(t *testing.T) {
 	testNode := &v1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -77,3 +157,191 @@ func TestCalculatePercentage(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeService_ListNodeUtilization(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		newUtilizationTestNode("node-a", "zone-1", "4", "8Gi"),
+		newUtilizationTestNode("node-b", "zone-1", "4", "8Gi"),
+		newUtilizationTestNode("node-c", "zone-1", "4", "8Gi"),
+		newUtilizationTestNode("node-d", "zone-2", "4", "8Gi"),
+	)
+	metricsClient := newNodeMetricsClient(
+		*newTestNodeMetrics("node-a", "1", "6Gi"),
+		*newTestNodeMetrics("node-b", "3600m", "2Gi"),
+		*newTestNodeMetrics("node-d", "4", "8Gi"),
+	)
+	svc := NewNodeService(fakeClient, metricsClient, slog.Default())
+
+	tests := []struct {
+		name             string
+		opts             models.NodeUtilizationListOptions
+		expectedNodes    []string
+		expectedTotal    int
+		expectedCPUTotal float64
+	}{
+		{
+			name:             "sorted by cpu within zone",
+			opts:             models.NodeUtilizationListOptions{SortBy: models.NodeUtilizationSortCPU, LabelSelector: "topology.kubernetes.io/zone=zone-1"},
+			expectedNodes:    []string{"node-b", "node-a"},
+			expectedTotal:    3,
+			expectedCPUTotal: 57.5,
+		},
+		{
+			name:             "sorted by memory",
+			opts:             models.NodeUtilizationListOptions{SortBy: models.NodeUtilizationSortMemory},
+			expectedNodes:    []string{"node-d", "node-a", "node-b"},
+			expectedTotal:    4,
+			expectedCPUTotal: 71.666,
+		},
+		{
+			name:             "cpu threshold does not affect totals",
+			opts:             models.NodeUtilizationListOptions{SortBy: models.NodeUtilizationSortName, CPUAbove: 80},
+			expectedNodes:    []string{"node-b", "node-d"},
+			expectedTotal:    4,
+			expectedCPUTotal: 71.666,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := svc.ListNodeUtilization(context.Background(), tt.opts)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, node := range list.Nodes {
+				names = append(names, node.NodeName)
+			}
+			assert.Equal(t, tt.expectedNodes, names)
+			assert.Equal(t, tt.expectedTotal, list.TotalNodes)
+			assert.Equal(t, len(tt.expectedNodes), list.MatchingNodes)
+			assert.Equal(t, []string{"node-c"}, list.NodesWithoutMetrics)
+			assert.InDelta(t, tt.expectedCPUTotal, list.Totals.CPUPercentage, 0.01)
+			for _, node := range list.Nodes {
+				assert.Contains(t, node.Allocation, string(v1.ResourcePods))
+			}
+		})
+	}
+}
+
+func TestNodeService_ListNodeUtilization_NoMetrics(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(newUtilizationTestNode("node-a", "zone-1", "4", "8Gi"))
+	svc := NewNodeService(fakeClient, nil, slog.Default())
+
+	_, err := svc.ListNodeUtilization(context.Background(), models.NodeUtilizationListOptions{})
+	assert.ErrorIs(t, err, core.ErrMetricsNotAvailable)
+}
+
+func TestNodeService_GetNodePods(t *testing.T) {
+	node := newUtilizationTestNode("node-a", "zone-1", "4", "8Gi")
+	node.Status.Allocatable = v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("4"),
+		v1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+
+	completed := newNodeTestPod("batch", "done", "node-a", "1", "1Gi")
+	completed.Status.Phase = v1.PodSucceeded
+
+	fakeClient := fake.NewSimpleClientset(
+		node,
+		newNodeTestPod("default", "quiet", "node-a", "2", "1Gi"),
+		newNodeTestPod("batch", "noisy", "node-a", "500m", "2Gi"),
+		newNodeTestPod("default", "elsewhere", "node-b", "1", "1Gi"),
+		completed,
+	)
+
+	metricsClient := metricsfake.NewSimpleClientset()
+	metricsClient.PrependReactor("list", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+		items := map[string][]metricsv1beta1.PodMetrics{
+			"default": {newTestPodMetrics("default", "quiet", "100m", "512Mi")},
+			"batch":   {newTestPodMetrics("batch", "noisy", "3", "2Gi")},
+		}
+		return true, &metricsv1beta1.PodMetricsList{Items: items[action.GetNamespace()]}, nil
+	})
+
+	t.Run("with metrics", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, metricsClient, slog.Default())
+
+		breakdown, err := svc.GetNodePods(context.Background(), "node-a", models.NodeUtilizationSortCPU)
+		require.NoError(t, err)
+
+		assert.True(t, breakdown.MetricsAvailable)
+		assert.Equal(t, 2, breakdown.PodCount)
+		require.Len(t, breakdown.Pods, 2)
+		assert.Equal(t, "noisy", breakdown.Pods[0].Name)
+		assert.Equal(t, "3", breakdown.Pods[0].CPUUsage)
+		assert.InDelta(t, 75, breakdown.Pods[0].CPUUsagePercentage, 0.001)
+
+		assert.Equal(t, "2500m", breakdown.CPU.Requests)
+		assert.InDelta(t, 62.5, breakdown.CPU.RequestsPercentage, 0.001)
+		assert.InDelta(t, 100, breakdown.CPU.LimitsPercentage, 0.001)
+		assert.InDelta(t, 77.5, breakdown.CPU.UsagePercentage, 0.001)
+	})
+
+	t.Run("without metrics ranks by requests", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, nil, slog.Default())
+
+		breakdown, err := svc.GetNodePods(context.Background(), "node-a", models.NodeUtilizationSortCPU)
+		require.NoError(t, err)
+
+		assert.False(t, breakdown.MetricsAvailable)
+		require.Len(t, breakdown.Pods, 2)
+		assert.Equal(t, "quiet", breakdown.Pods[0].Name)
+		assert.Empty(t, breakdown.Pods[0].CPUUsage)
+		assert.Empty(t, breakdown.CPU.Usage)
+	})
+
+	t.Run("node not found", func(t *testing.T) {
+		svc := NewNodeService(fakeClient, nil, slog.Default())
+
+		_, err := svc.GetNodePods(context.Background(), "missing", models.NodeUtilizationSortCPU)
+		assert.ErrorIs(t, err, core.ErrNodeNotFound)
+	})
+}
+
+func TestNodeAllocation(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+		Status: v1.NodeStatus{
+			Capacity: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("8"),
+				v1.ResourceMemory: resource.MustParse("32Gi"),
+				v1.ResourcePods:   resource.MustParse("110"),
+				"nvidia.com/gpu":  resource.MustParse("4"),
+			},
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:              resource.MustParse("7500m"),
+				v1.ResourceMemory:           resource.MustParse("30Gi"),
+				v1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+				v1.ResourcePods:             resource.MustParse("110"),
+				"nvidia.com/gpu":            resource.MustParse("4"),
+			},
+		},
+	}
+
+	trainer := newNodeTestPod("ml", "trainer", "gpu-node", "3", "12Gi")
+	trainer.Spec.Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("2")
+	trainer.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"] = resource.MustParse("2")
+	trainer.Spec.Containers[0].Resources.Limits[v1.ResourceCPU] = resource.MustParse("6")
+
+	pods := []v1.Pod{*trainer, *newNodeTestPod("default", "web", "gpu-node", "750m", "3Gi")}
+
+	allocation := nodeAllocation(node, pods)
+
+	cpu := allocation[string(v1.ResourceCPU)]
+	assert.Equal(t, "7500m", cpu.Allocatable)
+	assert.Equal(t, "3750m", cpu.Requested)
+	assert.InDelta(t, 50, cpu.RequestedPercentage, 0.001)
+	assert.InDelta(t, 106.666, cpu.LimitsPercentage, 0.01, "limits above allocatable report overcommit")
+
+	gpu := allocation["nvidia.com/gpu"]
+	assert.Equal(t, "2", gpu.Requested)
+	assert.InDelta(t, 50, gpu.RequestedPercentage, 0.001)
+
+	podCount := allocation[string(v1.ResourcePods)]
+	assert.Equal(t, "2", podCount.Requested)
+	assert.Empty(t, podCount.Limits)
+
+	storage := allocation[string(v1.ResourceEphemeralStorage)]
+	assert.Equal(t, "100Gi", storage.Allocatable)
+	assert.Equal(t, "0", storage.Requested)
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// Labels that always differ between nodes and carry no diagnostic value.
+var noisyNodeLabels = map[string]bool{
+	"kubernetes.io/hostname": true,
+}
+
+var generatedVolumeNameRegex = regexp.MustCompile(`^(kube-api-access)-[a-z0-9]{5}$`)
+
+type podDiffer struct {
+	differences []models.PodDifference
+}
+
+func (d *podDiffer) add(category, container, field, a, b string) {
+	if a == b {
+		return
+	}
+	d.differences = append(d.differences, models.PodDifference{
+		Category:  category,
+		Container: container,
+		Field:     field,
+		A:         a,
+		B:         b,
+	})
+}
+
+func (d *podDiffer) addMaps(category, container string, a, b map[string]string) {
+	for _, key := range unionKeys(a, b) {
+		d.add(category, container, key, a[key], b[key])
+	}
+}
+
+func (s *podService) ComparePods(ctx context.Context, a, b models.PodReference) (*models.PodComparison, error) {
+	s.logger.Debug("comparing pods",
+		"pod_a", fmt.Sprintf("%s/%s", a.Namespace, a.Name),
+		"pod_b", fmt.Sprintf("%s/%s", b.Namespace, b.Name))
+
+	podA, err := s.GetPod(ctx, a.Namespace, a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("pod %s/%s: %w", a.Namespace, a.Name, err)
+	}
+	podB, err := s.GetPod(ctx, b.Namespace, b.Name)
+	if err != nil {
+		return nil, fmt.Errorf("pod %s/%s: %w", b.Namespace, b.Name, err)
+	}
+
+	descA := s.buildPodDescription(podA, nil)
+	descB := s.buildPodDescription(podB, nil)
+
+	differ := &podDiffer{differences: []models.PodDifference{}}
+
+	s.compareContainers(differ, descA, descB)
+
+	differ.add(models.PodDiffCategoryNode, "", "nodeName", descA.Node, descB.Node)
+	if descA.Node != descB.Node {
+		nodeA := s.getComparisonNode(ctx, descA.Node)
+		nodeB := s.getComparisonNode(ctx, descB.Node)
+		if nodeA != nil && nodeB != nil {
+			differ.addMaps(models.PodDiffCategoryNodeLabels, "", filterNodeLabels(nodeA.Labels), filterNodeLabels(nodeB.Labels))
+			differ.addMaps(models.PodDiffCategoryNodeTaints, "", taintMap(nodeA.Spec.Taints), taintMap(nodeB.Spec.Taints))
+		}
+	}
+
+	differ.addMaps(models.PodDiffCategoryVolumes, "", volumeMap(descA.Volumes), volumeMap(descB.Volumes))
+
+	differ.add(models.PodDiffCategoryQOS, "", "qosClass", descA.QOSClass, descB.QOSClass)
+
+	differ.add(models.PodDiffCategoryOwner, "", "controller", controllerOf(podA), controllerOf(podB))
+	differ.add(models.PodDiffCategoryOwner, "", "revision", ownerRevision(podA), ownerRevision(podB))
+
+	comparison := &models.PodComparison{
+		PodA:                  a,
+		PodB:                  b,
+		Identical:             len(differ.differences) == 0,
+		Differences:           differ.differences,
+		DifferencesByCategory: make(map[string]int),
+	}
+	for _, difference := range comparison.Differences {
+		comparison.DifferencesByCategory[difference.Category]++
+	}
+
+	s.logger.Debug("successfully compared pods",
+		"pod_a", fmt.Sprintf("%s/%s", a.Namespace, a.Name),
+		"pod_b", fmt.Sprintf("%s/%s", b.Namespace, b.Name),
+		"differences", len(comparison.Differences))
+
+	return comparison, nil
+}
+
+func (s *podService) getComparisonNode(ctx context.Context, nodeName string) *v1.Node {
+	if nodeName == "" {
+		return nil
+	}
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.logger.Warn("failed to get node for pod comparison",
+			"node", nodeName,
+			"error", err.Error())
+		return nil
+	}
+	return node
+}
+
+func (s *podService) compareContainers(differ *podDiffer, a, b *models.PodDescription) {
+	containersA := containersByName(a)
+	containersB := containersByName(b)
+
+	names := make([]string, 0, len(containersA)+len(containersB))
+	for _, source := range []*models.PodDescription{a, b} {
+		for _, group := range [][]models.ContainerInfo{source.InitContainers, source.Containers} {
+			for i := range group {
+				if !contains(names, group[i].Name) {
+					names = append(names, group[i].Name)
+				}
+			}
+		}
+	}
+
+	for _, name := range names {
+		containerA, inA := containersA[name]
+		containerB, inB := containersB[name]
+		if !inA || !inB {
+			differ.add(models.PodDiffCategoryContainer, name, "defined", fmt.Sprintf("%t", inA), fmt.Sprintf("%t", inB))
+			continue
+		}
+
+		differ.add(models.PodDiffCategoryImage, name, "image", containerA.Image, containerB.Image)
+		differ.add(models.PodDiffCategoryImage, name, "digest", imageDigest(containerA.ImageID), imageDigest(containerB.ImageID))
+
+		differ.addMaps(models.PodDiffCategoryEnv, name, envMap(containerA.Environment), envMap(containerB.Environment))
+
+		differ.addMaps(models.PodDiffCategoryResources, name,
+			resourceMap(containerA.Resources), resourceMap(containerB.Resources))
+
+		differ.add(models.PodDiffCategoryContainerState, name, "state",
+			describeContainerState(containerA.State), describeContainerState(containerB.State))
+		differ.add(models.PodDiffCategoryContainerState, name, "ready",
+			fmt.Sprintf("%t", containerA.Ready), fmt.Sprintf("%t", containerB.Ready))
+		differ.add(models.PodDiffCategoryContainerState, name, "restartCount",
+			fmt.Sprintf("%d", containerA.RestartCount), fmt.Sprintf("%d", containerB.RestartCount))
+	}
+}
+
+func containersByName(description *models.PodDescription) map[string]models.ContainerInfo {
+	containers := make(map[string]models.ContainerInfo, len(description.Containers)+len(description.InitContainers))
+	for _, container := range description.InitContainers {
+		containers[container.Name] = container
+	}
+	for _, container := range description.Containers {
+		containers[container.Name] = container
+	}
+	return containers
+}
+
+func unionKeys(a, b map[string]string) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, exists := a[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// imageDigest reduces a container status imageID to its digest so that
+// runtime-specific prefixes and registry aliases do not show up as a diff.
+func imageDigest(imageID string) string {
+	if idx := strings.LastIndex(imageID, "@"); idx >= 0 {
+		return imageID[idx+1:]
+	}
+	if idx := strings.Index(imageID, "://"); idx >= 0 {
+		return imageID[idx+3:]
+	}
+	return imageID
+}
+
+func envMap(env []v1.EnvVar) map[string]string {
+	values := make(map[string]string, len(env))
+	for _, envVar := range env {
+		value := envVar.Value
+		if source := envVar.ValueFrom; source != nil {
+			switch {
+			case source.SecretKeyRef != nil:
+				value = fmt.Sprintf("secretKeyRef(%s/%s)", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+			case source.ConfigMapKeyRef != nil:
+				value = fmt.Sprintf("configMapKeyRef(%s/%s)", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+			case source.FieldRef != nil:
+				value = fmt.Sprintf("fieldRef(%s)", source.FieldRef.FieldPath)
+			case source.ResourceFieldRef != nil:
+				value = fmt.Sprintf("resourceFieldRef(%s)", source.ResourceFieldRef.Resource)
+			}
+		}
+		values[envVar.Name] = value
+	}
+	return values
+}
+
+func resourceMap(requirements v1.ResourceRequirements) map[string]string {
+	values := make(map[string]string, len(requirements.Requests)+len(requirements.Limits))
+	for name, quantity := range requirements.Requests {
+		values["requests."+string(name)] = quantity.String()
+	}
+	for name, quantity := range requirements.Limits {
+		values["limits."+string(name)] = quantity.String()
+	}
+	return values
+}
+
+func describeContainerState(state v1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "Running"
+	case state.Waiting != nil:
+		return fmt.Sprintf("Waiting: %s", state.Waiting.Reason)
+	case state.Terminated != nil:
+		return fmt.Sprintf("Terminated: %s (exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	default:
+		return ""
+	}
+}
+
+func filterNodeLabels(labels map[string]string) map[string]string {
+	filtered := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if !noisyNodeLabels[key] {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+func taintMap(taints []v1.Taint) map[string]string {
+	values := make(map[string]string, len(taints))
+	for _, taint := range taints {
+		values[fmt.Sprintf("%s:%s", taint.Key, taint.Effect)] = fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)
+	}
+	return values
+}
+
+func volumeMap(volumes []models.VolumeInfo) map[string]string {
+	values := make(map[string]string, len(volumes))
+	for _, volume := range volumes {
+		name := generatedVolumeNameRegex.ReplaceAllString(volume.Name, "$1")
+
+		value := volume.Type
+		switch source := volume.Source; {
+		case source.PersistentVolumeClaim != nil:
+			value = fmt.Sprintf("%s(%s)", volume.Type, source.PersistentVolumeClaim.ClaimName)
+		case source.ConfigMap != nil:
+			value = fmt.Sprintf("%s(%s)", volume.Type, source.ConfigMap.Name)
+		case source.Secret != nil:
+			value = fmt.Sprintf("%s(%s)", volume.Type, source.Secret.SecretName)
+		case source.HostPath != nil:
+			value = fmt.Sprintf("%s(%s)", volume.Type, source.HostPath.Path)
+		}
+		values[name] = value
+	}
+	return values
+}
+
+func controllerOf(pod *v1.Pod) string {
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		return fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+	return ""
+}
+
+func ownerRevision(pod *v1.Pod) string {
+	for _, label := range []string{"pod-template-hash", "controller-revision-hash"} {
+		if revision, exists := pod.Labels[label]; exists {
+			return revision
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newReplicaPod(name, node, uid, hash string, memoryLimit string, state v1.ContainerState, restarts int32) *v1.Pod {
+	isController := true
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(uid),
+			Labels:    map[string]string{"app": "web", "pod-template-hash": hash},
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "ReplicaSet",
+				Name:       "web-" + hash,
+				Controller: &isController,
+			}},
+		},
+		Spec: v1.PodSpec{
+			NodeName: node,
+			Containers: []v1.Container{{
+				Name:  "app",
+				Image: "web:1.0",
+				Env:   []v1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse(memoryLimit)},
+				},
+			}},
+			Volumes: []v1.Volume{{
+				Name:         "kube-api-access-" + uid[:5],
+				VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{}},
+			}},
+		},
+		Status: v1.PodStatus{
+			PodIP:    "10.0.0." + uid[:1],
+			QOSClass: v1.PodQOSBurstable,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:         "app",
+				ImageID:      "docker.io/library/web@sha256:aaa",
+				State:        state,
+				RestartCount: restarts,
+			}},
+		},
+	}
+}
+
+func TestPodService_ComparePods(t *testing.T) {
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	crashing := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}
+
+	healthy := newReplicaPod("web-1", "node-a", "1abcdef", "abc123", "512Mi", running, 0)
+	failing := newReplicaPod("web-2", "node-b", "2bcdefg", "abc123", "256Mi", crashing, 7)
+
+	nodeA := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-a",
+		Labels: map[string]string{"kubernetes.io/hostname": "node-a", "node.kubernetes.io/instance-type": "m5.large"},
+	}}
+	nodeB := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-b",
+			Labels: map[string]string{"kubernetes.io/hostname": "node-b", "node.kubernetes.io/instance-type": "t3.small"},
+		},
+		Spec: v1.NodeSpec{Taints: []v1.Taint{{Key: "dedicated", Value: "batch", Effect: v1.TaintEffectPreferNoSchedule}}},
+	}
+
+	fakeClient := fake.NewSimpleClientset(healthy, failing, nodeA, nodeB)
+	svc := NewPodService(fakeClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	comparison, err := svc.ComparePods(context.Background(),
+		models.PodReference{Namespace: "default", Name: "web-1"},
+		models.PodReference{Namespace: "default", Name: "web-2"})
+	require.NoError(t, err)
+
+	assert.False(t, comparison.Identical)
+
+	fields := make(map[string]models.PodDifference)
+	for _, difference := range comparison.Differences {
+		fields[difference.Category+"/"+difference.Field] = difference
+	}
+
+	assert.Equal(t, "512Mi", fields["resources/limits.memory"].A)
+	assert.Equal(t, "256Mi", fields["resources/limits.memory"].B)
+	assert.Equal(t, "Waiting: CrashLoopBackOff", fields["containerState/state"].B)
+	assert.Equal(t, "7", fields["containerState/restartCount"].B)
+	assert.Equal(t, "node-b", fields["node/nodeName"].B)
+	assert.Equal(t, "t3.small", fields["nodeLabels/node.kubernetes.io/instance-type"].B)
+	assert.Equal(t, "dedicated=batch:PreferNoSchedule", fields["nodeTaints/dedicated:PreferNoSchedule"].B)
+
+	assert.NotContains(t, fields, "nodeLabels/kubernetes.io/hostname", "hostname label is noise")
+	assert.Zero(t, comparison.DifferencesByCategory[models.PodDiffCategoryVolumes], "generated volume names are normalized")
+	assert.Zero(t, comparison.DifferencesByCategory[models.PodDiffCategoryOwner])
+	assert.Zero(t, comparison.DifferencesByCategory[models.PodDiffCategoryImage])
+	assert.Zero(t, comparison.DifferencesByCategory[models.PodDiffCategoryEnv])
+}
+
+func TestPodService_ComparePods_Identical(t *testing.T) {
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	first := newReplicaPod("web-1", "node-a", "1abcdef", "abc123", "512Mi", running, 0)
+	second := newReplicaPod("web-2", "node-a", "2bcdefg", "abc123", "512Mi", running, 0)
+
+	fakeClient := fake.NewSimpleClientset(first, second)
+	svc := NewPodService(fakeClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	comparison, err := svc.ComparePods(context.Background(),
+		models.PodReference{Namespace: "default", Name: "web-1"},
+		models.PodReference{Namespace: "default", Name: "web-2"})
+	require.NoError(t, err)
+
+	assert.True(t, comparison.Identical)
+	assert.Empty(t, comparison.Differences)
+}
+
+func TestPodService_ComparePods_NotFound(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	svc := NewPodService(fakeClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := svc.ComparePods(context.Background(),
+		models.PodReference{Namespace: "default", Name: "web-1"},
+		models.PodReference{Namespace: "default", Name: "web-2"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, core.ErrPodNotFound))
+	assert.Contains(t, err.Error(), "default/web-1")
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"math"
 	"regexp"
 	"sort"
 	"strconv"
@@ -16,11 +15,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
 )
 
 const (
@@ -30,14 +29,18 @@ const (
 )
 
 type podService struct {
-	k8sClient kubernetes.Interface
-	logger    *slog.Logger
+	*nodeFit
+	k8sClient      kubernetes.Interface
+	eventCollector *relatedevents.Collector
+	logger         *slog.Logger
 }
 
 func NewPodService(k8sClient kubernetes.Interface, logger *slog.Logger) core.PodService {
 	return &podService{
-		k8sClient: k8sClient,
-		logger:    logger,
+		nodeFit:        newNodeFit(k8sClient, logger),
+		k8sClient:      k8sClient,
+		eventCollector: relatedevents.NewCollector(k8sClient, logger),
+		logger:         logger,
 	}
 }
 
@@ -247,7 +250,7 @@ func (s *podService) GetPodDescription(ctx context.Context, namespace, name stri
 		return nil, err
 	}
 
-	events, err := s.getPodEvents(ctx, namespace, name)
+	events, err := s.eventCollector.CollectForPod(ctx, pod)
 	if err != nil {
 		s.logger.Warn("failed to get pod events",
 			"namespace", namespace,
@@ -256,6 +259,19 @@ func (s *podService) GetPodDescription(ctx context.Context, namespace, name stri
 		events = []models.EventInfo{}
 	}
 
+	description := s.buildPodDescription(pod, events)
+
+	s.logger.Debug("successfully built pod description",
+		"namespace", namespace,
+		"pod", name,
+		"containers", len(description.Containers),
+		"volumes", len(description.Volumes),
+		"events", len(description.Events))
+
+	return description, nil
+}
+
+func (s *podService) buildPodDescription(pod *v1.Pod, events []models.EventInfo) *models.PodDescription {
 	description := &models.PodDescription{
 		Name:        pod.Name,
 		Namespace:   pod.Namespace,
@@ -293,50 +309,7 @@ func (s *podService) GetPodDescription(ctx context.Context, namespace, name stri
 
 	description.Volumes = s.buildVolumeInfo(pod.Spec.Volumes)
 
-	s.logger.Debug("successfully built pod description",
-		"namespace", namespace,
-		"pod", name,
-		"containers", len(description.Containers),
-		"volumes", len(description.Volumes),
-		"events", len(description.Events))
-
-	return description, nil
-}
-
-func (s *podService) getPodEvents(ctx context.Context, namespace, podName string) ([]models.EventInfo, error) {
-	fieldSelector := fields.AndSelectors(
-		fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
-		fields.OneTermEqualSelector("involvedObject.name", podName),
-		fields.OneTermEqualSelector("involvedObject.namespace", namespace),
-	).String()
-
-	eventList, err := s.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fieldSelector,
-		Limit:         20,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get events for pod %s/%s: %w", namespace, podName, err)
-	}
-
-	sort.Slice(eventList.Items, func(i, j int) bool {
-		return eventList.Items[i].LastTimestamp.After(eventList.Items[j].LastTimestamp.Time)
-	})
-
-	events := make([]models.EventInfo, 0, len(eventList.Items))
-	for i := range eventList.Items {
-		event := &eventList.Items[i]
-		events = append(events, models.EventInfo{
-			Type:           event.Type,
-			Reason:         event.Reason,
-			Message:        event.Message,
-			FirstTimestamp: event.FirstTimestamp,
-			LastTimestamp:  event.LastTimestamp,
-			Count:          event.Count,
-			Source:         fmt.Sprintf("%s/%s", event.Source.Component, event.Source.Host),
-		})
-	}
-
-	return events, nil
+	return description
 }
 
 func (s *podService) buildContainerInfo(containers []v1.Container, statuses []v1.ContainerStatus) []models.ContainerInfo {
@@ -479,82 +452,6 @@ func (s *podService) evaluateNodeAffinity(pod *v1.Pod, node *v1.Node) (bool, []s
 	return true, reasons
 }
 
-func (s *podService) matchNodeSelectorTerm(node *v1.Node, term v1.NodeSelectorTerm) bool {
-	for _, expr := range term.MatchExpressions {
-		if !s.matchNodeSelectorRequirement(node, expr) {
-			return false
-		}
-	}
-	for _, field := range term.MatchFields {
-		if !s.matchNodeFieldSelector(node, field) {
-			return false
-		}
-	}
-	return true
-}
-
-func (s *podService) matchNodeSelectorRequirement(node *v1.Node, req v1.NodeSelectorRequirement) bool {
-	nodeValue, exists := node.Labels[req.Key]
-
-	switch req.Operator {
-	case v1.NodeSelectorOpIn:
-		if !exists {
-			return false
-		}
-		for _, value := range req.Values {
-			if nodeValue == value {
-				return true
-			}
-		}
-		return false
-	case v1.NodeSelectorOpNotIn:
-		if !exists {
-			return true
-		}
-		for _, value := range req.Values {
-			if nodeValue == value {
-				return false
-			}
-		}
-		return true
-	case v1.NodeSelectorOpExists:
-		return exists
-	case v1.NodeSelectorOpDoesNotExist:
-		return !exists
-	case v1.NodeSelectorOpGt, v1.NodeSelectorOpLt:
-		return true
-	}
-	return false
-}
-
-func (s *podService) matchNodeFieldSelector(node *v1.Node, field v1.NodeSelectorRequirement) bool {
-	var fieldValue string
-	switch field.Key {
-	case "metadata.name":
-		fieldValue = node.Name
-	default:
-		return false
-	}
-
-	switch field.Operator {
-	case v1.NodeSelectorOpIn:
-		for _, value := range field.Values {
-			if fieldValue == value {
-				return true
-			}
-		}
-		return false
-	case v1.NodeSelectorOpNotIn:
-		for _, value := range field.Values {
-			if fieldValue == value {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
 func (s *podService) evaluateTaintsAndTolerations(pod *v1.Pod, node *v1.Node) (bool, []models.TaintInfo, []string) {
 	untoleratedTaints := []models.TaintInfo{}
 	toleratedTaints := []string{}
@@ -580,25 +477,6 @@ func (s *podService) evaluateTaintsAndTolerations(pod *v1.Pod, node *v1.Node) (b
 	return len(untoleratedTaints) == 0, untoleratedTaints, toleratedTaints
 }
 
-func (s *podService) tolerationMatchesTaint(toleration v1.Toleration, taint v1.Taint) bool {
-	if toleration.Key != "" && toleration.Key != taint.Key {
-		return false
-	}
-
-	if toleration.Effect != "" && toleration.Effect != taint.Effect {
-		return false
-	}
-
-	switch toleration.Operator {
-	case v1.TolerationOpEqual, "":
-		return toleration.Value == taint.Value
-	case v1.TolerationOpExists:
-		return true
-	}
-
-	return false
-}
-
 func (s *podService) evaluateResourceFit(pod *v1.Pod, node *v1.Node) (models.ResourceFitDetails, []string) {
 	insufficientResources := []string{}
 
@@ -673,37 +551,6 @@ func (s *podService) evaluatePodAntiAffinity(ctx context.Context, pod *v1.Pod, n
 	return len(conflicts) == 0, conflicts
 }
 
-func (s *podService) podMatchesAntiAffinityTerm(pod *v1.Pod, term v1.PodAffinityTerm) bool {
-	if term.NamespaceSelector != nil {
-	}
-
-	namespaceMatch := false
-	if len(term.Namespaces) == 0 {
-		namespaceMatch = true
-	} else {
-		for _, ns := range term.Namespaces {
-			if pod.Namespace == ns {
-				namespaceMatch = true
-				break
-			}
-		}
-	}
-
-	if !namespaceMatch {
-		return false
-	}
-
-	if term.LabelSelector != nil {
-		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
-		if err != nil {
-			return false
-		}
-		return selector.Matches(labels.Set(pod.Labels))
-	}
-
-	return false
-}
-
 func (s *podService) getSchedulingEvents(ctx context.Context, namespace, podName string) ([]models.SchedulingEvent, error) {
 	fieldSelector := fields.AndSelectors(
 		fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
@@ -1031,15 +878,6 @@ func getCategoryDescription(category models.SchedulingFailureCategory) string {
 	return "Unknown scheduling failure"
 }
 
-func (s *podService) checkPodVolumes(pod *v1.Pod) bool {
-	for _, volume := range pod.Spec.Volumes {
-		if volume.PersistentVolumeClaim != nil {
-			return true
-		}
-	}
-	return false
-}
-
 func (s *podService) analyzeVolumeConstraints(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, []string) {
 	volumeIssues := []string{}
 
@@ -1109,7 +947,7 @@ func (s *podService) GetPodFailureEvents(ctx context.Context, namespace, name st
 		return nil, err
 	}
 
-	events, err := s.getPodEvents(ctx, namespace, name)
+	events, err := s.eventCollector.CollectForPod(ctx, pod)
 	if err != nil {
 		s.logger.Warn("failed to get pod events for failure analysis",
 			"namespace", namespace,
@@ -1168,6 +1006,7 @@ func (s *podService) analyzeFailureEvents(events []models.EventInfo, pod *v1.Pod
 		severity        string
 		possibleCauses  []string
 		suggestedAction string
+		includeNormal   bool
 	}{
 		"FailedScheduling": {
 			category:        models.FailureEventCategoryScheduling,
@@ -1235,24 +1074,62 @@ func (s *podService) analyzeFailureEvents(events []models.EventInfo, pod *v1.Pod
 			possibleCauses:  []string{"CNI plugin issues", "Network policy blocking", "Service mesh problems"},
 			suggestedAction: "Check network plugin status and network policies",
 		},
+		"FailedBinding": {
+			category:        models.FailureEventCategoryVolume,
+			severity:        "critical",
+			possibleCauses:  []string{"No matching PersistentVolume", "StorageClass missing", "Access mode or capacity mismatch"},
+			suggestedAction: "Check the PVC's StorageClass and available PersistentVolumes",
+		},
+		"ProvisioningFailed": {
+			category:        models.FailureEventCategoryVolume,
+			severity:        "critical",
+			possibleCauses:  []string{"Storage provisioner error", "Cloud quota exceeded", "Invalid StorageClass parameters"},
+			suggestedAction: "Check the storage provisioner logs and StorageClass parameters",
+		},
+		"FailedCreate": {
+			category:        models.FailureEventCategoryController,
+			severity:        "critical",
+			possibleCauses:  []string{"Resource quota exceeded", "Admission webhook rejection", "Pod security violation"},
+			suggestedAction: "Check the owner's events and namespace quotas",
+		},
+		"NodeNotReady": {
+			category:        models.FailureEventCategoryNode,
+			severity:        "critical",
+			possibleCauses:  []string{"Kubelet stopped posting status", "Node network partition", "Node resource exhaustion"},
+			suggestedAction: "Check the node's conditions and kubelet health",
+			includeNormal:   true,
+		},
+		"Rebooted": {
+			category:        models.FailureEventCategoryNode,
+			severity:        "warning",
+			possibleCauses:  []string{"Node restarted", "Kernel panic", "Maintenance reboot"},
+			suggestedAction: "Check node uptime and why it was rebooted",
+		},
 	}
 
 	for _, event := range events {
-		if event.Type == "Normal" && event.Count < 5 {
+		// Prefer the longest matching pattern so that e.g. ImagePullBackOff
+		// is not classified by the shorter BackOff pattern.
+		matched := ""
+		for pattern := range failurePatterns {
+			if strings.Contains(event.Reason, pattern) && len(pattern) > len(matched) {
+				matched = pattern
+			}
+		}
+
+		if event.Type == "Normal" && event.Count < 5 && (matched == "" || !failurePatterns[matched].includeNormal) {
 			continue
 		}
 
 		var failureEvent *models.FailureEvent
-		for pattern, config := range failurePatterns {
-			if strings.Contains(event.Reason, pattern) {
-				failureEvent = &models.FailureEvent{
-					EventInfo:       event,
-					Category:        config.category,
-					Severity:        config.severity,
-					PossibleCauses:  config.possibleCauses,
-					SuggestedAction: config.suggestedAction,
-				}
-				break
+		if matched != "" {
+			config := failurePatterns[matched]
+			failureEvent = &models.FailureEvent{
+				EventInfo:       event,
+				Category:        config.category,
+				Severity:        config.severity,
+				PossibleCauses:  config.possibleCauses,
+				SuggestedAction: config.suggestedAction,
 			}
 		}
 
@@ -1321,6 +1198,11 @@ func (s *podService) enhanceFailureEventContext(event *models.FailureEvent, pod
 			event.PossibleCauses = append(event.PossibleCauses,
 				fmt.Sprintf("Pod QoS class is %s - consider setting guaranteed QoS", pod.Status.QOSClass))
 		}
+	case models.FailureEventCategoryNode:
+		if pod.Spec.NodeName != "" {
+			event.PossibleCauses = append(event.PossibleCauses,
+				fmt.Sprintf("Pod is running on affected node %s", pod.Spec.NodeName))
+		}
 	}
 }
 
@@ -1607,489 +1489,6 @@ func (s *podService) analyzeNodeForSchedulingExplanation(ctx context.Context, po
 	}
 }
 
-func (s *podService) explainNodeReady(node *v1.Node) (bool, *models.NodeReadyExplanation) {
-	explanation := &models.NodeReadyExplanation{
-		Ready:      true,
-		Conditions: []string{},
-	}
-
-	if node.Spec.Unschedulable {
-		explanation.Ready = false
-		explanation.Conditions = append(explanation.Conditions, "Node is marked as unschedulable")
-	}
-
-	for _, condition := range node.Status.Conditions {
-		if condition.Type == v1.NodeReady {
-			if condition.Status != v1.ConditionTrue {
-				explanation.Ready = false
-				explanation.Conditions = append(explanation.Conditions,
-					fmt.Sprintf("NodeReady condition is %s: %s", condition.Status, condition.Message))
-			}
-		} else if condition.Status != v1.ConditionFalse {
-			// Other conditions should be False for a healthy node
-			explanation.Conditions = append(explanation.Conditions,
-				fmt.Sprintf("%s condition is %s: %s", condition.Type, condition.Status, condition.Message))
-		}
-	}
-
-	return explanation.Ready, explanation
-}
-
-func (s *podService) explainResourceFit(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.ResourceExplanation) {
-	// Calculate pod resource requests
-	podCPURequest := resource.NewQuantity(0, resource.DecimalSI)
-	podMemoryRequest := resource.NewQuantity(0, resource.BinarySI)
-	podStorageRequest := resource.NewQuantity(0, resource.BinarySI)
-
-	for i := range pod.Spec.Containers {
-		container := &pod.Spec.Containers[i]
-		if cpuReq, ok := container.Resources.Requests[v1.ResourceCPU]; ok {
-			podCPURequest.Add(cpuReq)
-		}
-		if memReq, ok := container.Resources.Requests[v1.ResourceMemory]; ok {
-			podMemoryRequest.Add(memReq)
-		}
-		if storageReq, ok := container.Resources.Requests[v1.ResourceEphemeralStorage]; ok {
-			podStorageRequest.Add(storageReq)
-		}
-	}
-
-	// Get node allocatable resources
-	nodeCPUAllocatable := node.Status.Allocatable[v1.ResourceCPU]
-	nodeMemoryAllocatable := node.Status.Allocatable[v1.ResourceMemory]
-	nodeStorageAllocatable := node.Status.Allocatable[v1.ResourceEphemeralStorage]
-
-	// Calculate currently allocated resources on the node
-	nodeAllocated, err := s.calculateNodeAllocatedResources(ctx, node)
-	if err != nil {
-		s.logger.Warn("failed to calculate node allocated resources",
-			"node", node.Name,
-			"error", err.Error())
-		// Continue with partial analysis
-	}
-
-	explanation := &models.ResourceExplanation{
-		Fits:    true,
-		Details: make(map[string]models.ResourceDetail),
-	}
-
-	// Check CPU
-	cpuDetail := s.analyzeResourceDetail("cpu", *podCPURequest,
-		node.Status.Capacity[v1.ResourceCPU], nodeCPUAllocatable,
-		nodeAllocated[v1.ResourceCPU])
-	if cpuDetail.Shortage != "" {
-		explanation.Fits = false
-	}
-	explanation.Details["cpu"] = cpuDetail
-
-	// Check Memory
-	memoryDetail := s.analyzeResourceDetail("memory", *podMemoryRequest,
-		node.Status.Capacity[v1.ResourceMemory], nodeMemoryAllocatable,
-		nodeAllocated[v1.ResourceMemory])
-	if memoryDetail.Shortage != "" {
-		explanation.Fits = false
-	}
-	explanation.Details["memory"] = memoryDetail
-
-	// Check Storage if requested
-	if !podStorageRequest.IsZero() {
-		storageDetail := s.analyzeResourceDetail("ephemeral-storage", *podStorageRequest,
-			node.Status.Capacity[v1.ResourceEphemeralStorage], nodeStorageAllocatable,
-			nodeAllocated[v1.ResourceEphemeralStorage])
-		if storageDetail.Shortage != "" {
-			explanation.Fits = false
-		}
-		explanation.Details["ephemeral-storage"] = storageDetail
-	}
-
-	// Generate summary
-	if !explanation.Fits {
-		shortages := []string{}
-		for resource, detail := range explanation.Details {
-			if detail.Shortage != "" {
-				shortages = append(shortages, fmt.Sprintf("%s: %s", resource, detail.Shortage))
-			}
-		}
-		explanation.Summary = fmt.Sprintf("Insufficient resources: %s", strings.Join(shortages, ", "))
-	}
-
-	return explanation.Fits, explanation
-}
-
-func (s *podService) calculateNodeAllocatedResources(ctx context.Context, node *v1.Node) (v1.ResourceList, error) {
-	allocated := v1.ResourceList{
-		v1.ResourceCPU:              *resource.NewQuantity(0, resource.DecimalSI),
-		v1.ResourceMemory:           *resource.NewQuantity(0, resource.BinarySI),
-		v1.ResourceEphemeralStorage: *resource.NewQuantity(0, resource.BinarySI),
-	}
-
-	// List all pods on the node
-	podList, err := s.k8sClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
-	})
-	if err != nil {
-		return allocated, fmt.Errorf("failed to list pods on node %s: %w", node.Name, err)
-	}
-
-	// Sum up resource requests from all pods
-	for i := range podList.Items {
-		pod := &podList.Items[i]
-		// Skip terminated pods
-		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
-			continue
-		}
-
-		for j := range pod.Spec.Containers {
-			container := &pod.Spec.Containers[j]
-			if cpuReq, ok := container.Resources.Requests[v1.ResourceCPU]; ok {
-				cpuQty := allocated[v1.ResourceCPU]
-				cpuQty.Add(cpuReq)
-				allocated[v1.ResourceCPU] = cpuQty
-			}
-			if memReq, ok := container.Resources.Requests[v1.ResourceMemory]; ok {
-				memQty := allocated[v1.ResourceMemory]
-				memQty.Add(memReq)
-				allocated[v1.ResourceMemory] = memQty
-			}
-			if storageReq, ok := container.Resources.Requests[v1.ResourceEphemeralStorage]; ok {
-				storageQty := allocated[v1.ResourceEphemeralStorage]
-				storageQty.Add(storageReq)
-				allocated[v1.ResourceEphemeralStorage] = storageQty
-			}
-		}
-	}
-
-	return allocated, nil
-}
-
-func (s *podService) analyzeResourceDetail(resourceName string, podRequest, nodeCapacity, nodeAllocatable, nodeAllocated resource.Quantity) models.ResourceDetail {
-	available := nodeAllocatable.DeepCopy()
-	available.Sub(nodeAllocated)
-
-	detail := models.ResourceDetail{
-		PodRequests:     podRequest.String(),
-		NodeCapacity:    nodeCapacity.String(),
-		NodeAllocatable: nodeAllocatable.String(),
-		NodeAllocated:   nodeAllocated.String(),
-		NodeAvailable:   available.String(),
-	}
-
-	// Calculate percentage used
-	if !nodeAllocatable.IsZero() {
-		percentUsed := float64(nodeAllocated.MilliValue()) / float64(nodeAllocatable.MilliValue()) * 100
-		detail.PercentUsed = math.Round(percentUsed*100) / 100 // Round to 2 decimal places
-	}
-
-	// Check if pod fits
-	if podRequest.Cmp(available) > 0 {
-		shortage := podRequest.DeepCopy()
-		shortage.Sub(available)
-		detail.Shortage = shortage.String()
-		detail.Recommendation = fmt.Sprintf("Pod needs %s more %s than available on this node", shortage.String(), resourceName)
-	}
-
-	return detail
-}
-
-func (s *podService) explainAffinity(pod *v1.Pod, node *v1.Node) (bool, *models.AffinityExplanation) {
-	explanation := &models.AffinityExplanation{}
-	matched := true
-
-	// Check node selector
-	if len(pod.Spec.NodeSelector) > 0 {
-		selectorExplanation := &models.SelectorExplanation{
-			Matched:       true,
-			Required:      pod.Spec.NodeSelector,
-			NodeLabels:    node.Labels,
-			MissingLabels: []string{},
-		}
-
-		for key, value := range pod.Spec.NodeSelector {
-			if nodeValue, exists := node.Labels[key]; !exists || nodeValue != value {
-				selectorExplanation.Matched = false
-				matched = false
-				selectorExplanation.MissingLabels = append(selectorExplanation.MissingLabels,
-					fmt.Sprintf("%s=%s", key, value))
-			}
-		}
-
-		if !selectorExplanation.Matched {
-			selectorExplanation.Details = fmt.Sprintf("Node selector requirements not met. Missing labels: %s",
-				strings.Join(selectorExplanation.MissingLabels, ", "))
-		}
-
-		explanation.NodeSelector = selectorExplanation
-	}
-
-	// Check node affinity
-	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
-		affinityDetail := &models.NodeAffinityDetail{
-			RequiredMatched: true,
-			FailedTerms:     []string{},
-		}
-
-		if required := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
-			affinityDetail.RequiredMatched = false
-			for _, term := range required.NodeSelectorTerms {
-				if s.matchNodeSelectorTerm(node, term) {
-					affinityDetail.RequiredMatched = true
-					break
-				} else {
-					affinityDetail.FailedTerms = append(affinityDetail.FailedTerms,
-						s.explainNodeSelectorTerm(term, node))
-				}
-			}
-
-			if !affinityDetail.RequiredMatched {
-				matched = false
-				affinityDetail.Details = "No required node affinity terms matched this node"
-			}
-		}
-
-		explanation.NodeAffinity = affinityDetail
-	}
-
-	if !matched {
-		explanation.Summary = "Node affinity requirements not satisfied"
-	}
-
-	return matched, explanation
-}
-
-func (s *podService) explainNodeSelectorTerm(term v1.NodeSelectorTerm, node *v1.Node) string {
-	failures := []string{}
-
-	for _, expr := range term.MatchExpressions {
-		if !s.matchNodeSelectorRequirement(node, expr) {
-			failures = append(failures, fmt.Sprintf("label %s %s %v",
-				expr.Key, expr.Operator, expr.Values))
-		}
-	}
-
-	for _, field := range term.MatchFields {
-		if !s.matchNodeFieldSelector(node, field) {
-			failures = append(failures, fmt.Sprintf("field %s %s %v",
-				field.Key, field.Operator, field.Values))
-		}
-	}
-
-	return strings.Join(failures, " AND ")
-}
-
-func (s *podService) explainTaints(pod *v1.Pod, node *v1.Node) (bool, *models.TaintExplanation) {
-	explanation := &models.TaintExplanation{
-		Tolerated:         true,
-		NodeTaints:        []models.TaintInfo{},
-		PodTolerations:    []string{},
-		UntoleratedTaints: []models.TaintInfo{},
-	}
-
-	// Convert node taints to TaintInfo
-	for _, taint := range node.Spec.Taints {
-		explanation.NodeTaints = append(explanation.NodeTaints, models.TaintInfo{
-			Key:    taint.Key,
-			Value:  taint.Value,
-			Effect: string(taint.Effect),
-		})
-	}
-
-	// Convert pod tolerations to strings
-	for _, toleration := range pod.Spec.Tolerations {
-		tolStr := fmt.Sprintf("key=%s", toleration.Key)
-		if toleration.Value != "" {
-			tolStr += fmt.Sprintf(",value=%s", toleration.Value)
-		}
-		if toleration.Effect != "" {
-			tolStr += fmt.Sprintf(",effect=%s", toleration.Effect)
-		}
-		if toleration.Operator != "" {
-			tolStr += fmt.Sprintf(",operator=%s", toleration.Operator)
-		}
-		explanation.PodTolerations = append(explanation.PodTolerations, tolStr)
-	}
-
-	// Check untolerated taints
-	for _, taint := range node.Spec.Taints {
-		tolerated := false
-		for _, toleration := range pod.Spec.Tolerations {
-			if s.tolerationMatchesTaint(toleration, taint) {
-				tolerated = true
-				break
-			}
-		}
-		if !tolerated && (taint.Effect == v1.TaintEffectNoSchedule || taint.Effect == v1.TaintEffectNoExecute) {
-			explanation.Tolerated = false
-			explanation.UntoleratedTaints = append(explanation.UntoleratedTaints, models.TaintInfo{
-				Key:    taint.Key,
-				Value:  taint.Value,
-				Effect: string(taint.Effect),
-			})
-		}
-	}
-
-	if !explanation.Tolerated {
-		taintStrs := []string{}
-		for _, taint := range explanation.UntoleratedTaints {
-			taintStrs = append(taintStrs, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
-		}
-		explanation.Details = fmt.Sprintf("Pod does not tolerate taints: %s", strings.Join(taintStrs, ", "))
-	}
-
-	return explanation.Tolerated, explanation
-}
-
-func (s *podService) explainPodAffinity(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.PodAffinityExplanation) {
-	explanation := &models.PodAffinityExplanation{
-		Satisfied: true,
-	}
-
-	if pod.Spec.Affinity == nil {
-		return true, explanation
-	}
-
-	// Get all pods on the node
-	podList, err := s.k8sClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
-	})
-	if err != nil {
-		s.logger.Warn("failed to list pods for affinity check",
-			"node", node.Name,
-			"error", err.Error())
-		return true, explanation
-	}
-
-	// Check pod anti-affinity
-	if pod.Spec.Affinity.PodAntiAffinity != nil {
-		for _, term := range pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
-			for j := range podList.Items {
-				existingPod := &podList.Items[j]
-				if existingPod.Name == pod.Name && existingPod.Namespace == pod.Namespace {
-					continue // Skip self
-				}
-				if s.podMatchesAntiAffinityTerm(existingPod, term) {
-					explanation.Satisfied = false
-					explanation.AntiAffinityFailed = append(explanation.AntiAffinityFailed,
-						fmt.Sprintf("%s/%s", existingPod.Namespace, existingPod.Name))
-				}
-			}
-		}
-	}
-
-	// Check pod affinity
-	if pod.Spec.Affinity.PodAffinity != nil {
-		for _, term := range pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
-			matched := false
-			for j := range podList.Items {
-				existingPod := &podList.Items[j]
-				if s.podMatchesAffinityTerm(existingPod, term) {
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				explanation.Satisfied = false
-				explanation.RequiredNotMet = append(explanation.RequiredNotMet,
-					"No pods matching required affinity term found on node")
-			}
-		}
-	}
-
-	if !explanation.Satisfied {
-		details := []string{}
-		if len(explanation.AntiAffinityFailed) > 0 {
-			details = append(details, fmt.Sprintf("anti-affinity conflicts with pods: %s",
-				strings.Join(explanation.AntiAffinityFailed, ", ")))
-		}
-		if len(explanation.RequiredNotMet) > 0 {
-			details = append(details, strings.Join(explanation.RequiredNotMet, "; "))
-		}
-		explanation.Details = strings.Join(details, "; ")
-	}
-
-	return explanation.Satisfied, explanation
-}
-
-func (s *podService) podMatchesAffinityTerm(pod *v1.Pod, term v1.PodAffinityTerm) bool {
-	// Same logic as podMatchesAntiAffinityTerm but for affinity
-	return s.podMatchesAntiAffinityTerm(pod, term)
-}
-
-func (s *podService) explainVolumeConstraints(ctx context.Context, pod *v1.Pod, node *v1.Node) (bool, *models.VolumeExplanation) {
-	explanation := &models.VolumeExplanation{
-		Satisfied: true,
-		Issues:    []string{},
-	}
-
-	for _, volume := range pod.Spec.Volumes {
-		if volume.PersistentVolumeClaim == nil {
-			continue
-		}
-
-		pvc, err := s.k8sClient.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(
-			ctx, volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
-		if err != nil {
-			explanation.Issues = append(explanation.Issues,
-				fmt.Sprintf("Failed to get PVC %s: %v", volume.PersistentVolumeClaim.ClaimName, err))
-			continue
-		}
-
-		if pvc.Status.Phase != v1.ClaimBound {
-			explanation.Satisfied = false
-			explanation.Issues = append(explanation.Issues,
-				fmt.Sprintf("PVC %s is not bound (status: %s)", pvc.Name, pvc.Status.Phase))
-			continue
-		}
-
-		if pvc.Spec.VolumeName != "" {
-			pv, err := s.k8sClient.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
-			if err != nil {
-				explanation.Issues = append(explanation.Issues,
-					fmt.Sprintf("Failed to get PV %s: %v", pvc.Spec.VolumeName, err))
-				continue
-			}
-
-			// Check node affinity for volume
-			if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
-				matches := false
-				for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
-					if s.matchNodeSelectorTerm(node, term) {
-						matches = true
-						break
-					}
-				}
-				if !matches {
-					explanation.Satisfied = false
-					explanation.Issues = append(explanation.Issues,
-						fmt.Sprintf("PV %s has node affinity that doesn't match node %s", pv.Name, node.Name))
-				}
-			}
-
-			// Check for ReadWriteOnce access mode issues
-			if hasAccessMode(pvc.Status.AccessModes, v1.ReadWriteOnce) {
-				// Could check if volume is already attached to another node
-				explanation.Issues = append(explanation.Issues,
-					fmt.Sprintf("PVC %s has ReadWriteOnce access mode (potential multi-attach issue)", pvc.Name))
-			}
-		}
-	}
-
-	if !explanation.Satisfied {
-		explanation.Details = fmt.Sprintf("Volume constraints not satisfied: %s",
-			strings.Join(explanation.Issues, "; "))
-	}
-
-	return explanation.Satisfied, explanation
-}
-
-func hasAccessMode(modes []v1.PersistentVolumeAccessMode, mode v1.PersistentVolumeAccessMode) bool {
-	for _, m := range modes {
-		if m == mode {
-			return true
-		}
-	}
-	return false
-}
-
 func (s *podService) generateNodeRecommendation(node *v1.Node, reasons models.NodeSchedulingReasons, recommendations []string) string {
 	if len(recommendations) > 0 {
 		return strings.Join(recommendations, "; ")
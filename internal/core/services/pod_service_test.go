@@ -546,3 +546,48 @@ func TestAnalyzeFailureEvents(t *testing.T) {
 	assert.Equal(t, "BackOff", results[1].Reason)
 	assert.Equal(t, models.FailureEventCategoryCrash, results[1].Category)
 }
+
+func TestAnalyzeFailureEvents_RelatedObjects(t *testing.T) {
+	svc := &podService{
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	now := time.Now()
+
+	events := []models.EventInfo{
+		{
+			Type:           "Normal",
+			Reason:         "NodeNotReady",
+			Message:        "Node node-a status is now: NodeNotReady",
+			FirstTimestamp: metav1.Time{Time: now.Add(-10 * time.Minute)},
+			LastTimestamp:  metav1.Time{Time: now.Add(-10 * time.Minute)},
+			Count:          1,
+			InvolvedObject: "Node/node-a",
+			Relationship:   models.EventRelationshipNode,
+		},
+		{
+			Type:           "Warning",
+			Reason:         "FailedBinding",
+			Message:        "no persistent volumes available for this claim and no storage class is set",
+			FirstTimestamp: metav1.Time{Time: now.Add(-20 * time.Minute)},
+			LastTimestamp:  metav1.Time{Time: now.Add(-20 * time.Minute)},
+			Count:          1,
+			InvolvedObject: "PersistentVolumeClaim/data",
+			Relationship:   models.EventRelationshipPVC,
+		},
+	}
+
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "node-a"}}
+
+	results := svc.analyzeFailureEvents(events, pod)
+
+	assert.Equal(t, 2, len(results))
+
+	assert.Equal(t, "NodeNotReady", results[0].Reason)
+	assert.Equal(t, models.FailureEventCategoryNode, results[0].Category)
+	assert.Equal(t, models.EventRelationshipNode, results[0].Relationship)
+	assert.Contains(t, results[0].PossibleCauses, "Pod is running on affected node node-a")
+
+	assert.Equal(t, "FailedBinding", results[1].Reason)
+	assert.Equal(t, models.FailureEventCategoryVolume, results[1].Category)
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
+)
+
+func (s *podService) GetPodStartupLatency(ctx context.Context, namespace, name string) (*models.PodStartupLatency, error) {
+	s.logger.Debug("getting pod startup latency", "namespace", namespace, "pod", name)
+
+	pod, err := s.GetPod(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.listPodTimelineEvents(ctx, namespace, name)
+	if err != nil {
+		s.logger.Warn("failed to get pod events for startup latency",
+			"namespace", namespace,
+			"pod", name,
+			"error", err.Error())
+		events = []v1.Event{}
+	}
+
+	latency := computeStartupLatency(pod, events, s.podJobs(ctx, pod))
+
+	s.logger.Debug("successfully computed pod startup latency",
+		"namespace", namespace,
+		"pod", name,
+		"ready", latency.Ready,
+		"time_to_ready_seconds", latency.TimeToReadySeconds,
+		"bottleneck", latency.Bottleneck)
+
+	return latency, nil
+}
+
+// computeStartupLatency derives the startup phases from pod conditions,
+// container statuses and the pod's Scheduled/Pulling/Pulled events. jobs
+// attributes Job pods to their CronJob.
+func computeStartupLatency(pod *v1.Pod, events []v1.Event, jobs workload.Jobs) *models.PodStartupLatency {
+	kind, name := workload.Of(pod, jobs)
+	created := pod.CreationTimestamp.Time
+
+	latency := &models.PodStartupLatency{
+		PodName:      pod.Name,
+		Namespace:    pod.Namespace,
+		WorkloadKind: kind,
+		WorkloadName: name,
+		CreatedAt:    pod.CreationTimestamp,
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		latency.RestartCount += status.RestartCount
+	}
+
+	for i := range events {
+		if events[i].Reason == "Unhealthy" && strings.HasPrefix(events[i].Message, "Readiness probe failed") {
+			latency.ReadinessProbeFailures += max(events[i].Count, 1)
+		}
+	}
+
+	scheduledAt := conditionTrueTime(pod, v1.PodScheduled)
+	if scheduledAt.IsZero() {
+		for i := range events {
+			if events[i].Reason == "Scheduled" {
+				scheduledAt = relatedevents.Timestamp(&events[i]).Time
+			}
+		}
+	}
+	if !scheduledAt.IsZero() {
+		latency.SchedulingSeconds = secondsBetween(created, scheduledAt)
+	}
+
+	var initStart, initEnd time.Time
+	for _, status := range pod.Status.InitContainerStatuses {
+		terminated := status.State.Terminated
+		if terminated == nil || terminated.StartedAt.IsZero() || terminated.FinishedAt.IsZero() {
+			continue
+		}
+		if initStart.IsZero() || terminated.StartedAt.Time.Before(initStart) {
+			initStart = terminated.StartedAt.Time
+		}
+		if terminated.FinishedAt.Time.After(initEnd) {
+			initEnd = terminated.FinishedAt.Time
+		}
+	}
+	if !initStart.IsZero() {
+		latency.InitContainersSeconds = secondsBetween(initStart, initEnd)
+	}
+
+	pulling := make(map[string]*v1.Event)
+	for i := range events {
+		if events[i].Reason == "Pulling" {
+			pulling[eventContainerName(&events[i])] = &events[i]
+		}
+	}
+	for i := range events {
+		event := &events[i]
+		if event.Reason != "Pulled" {
+			continue
+		}
+
+		container := eventContainerName(event)
+		pull := models.ImagePullLatency{
+			Container: container,
+			Message:   event.Message,
+			Cached:    strings.Contains(event.Message, "already present"),
+		}
+		if !pull.Cached {
+			if duration, ok := imagePullDuration(event, pulling[container]); ok {
+				pull.Seconds = duration.Seconds()
+				latency.ImagePullSeconds += pull.Seconds
+			}
+		}
+		latency.ImagePulls = append(latency.ImagePulls, pull)
+	}
+
+	if readyAt := conditionTrueTime(pod, v1.PodReady); !readyAt.IsZero() {
+		latency.Ready = true
+		latency.TimeToReadySeconds = secondsBetween(created, readyAt)
+
+		var lastStarted time.Time
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Running != nil && status.State.Running.StartedAt.Time.After(lastStarted) {
+				lastStarted = status.State.Running.StartedAt.Time
+			}
+		}
+		if !lastStarted.IsZero() {
+			latency.AppReadinessSeconds = secondsBetween(lastStarted, readyAt)
+		}
+	}
+
+	latency.Bottleneck = startupBottleneck(map[string]float64{
+		models.StartupPhaseScheduling:     latency.SchedulingSeconds,
+		models.StartupPhaseImagePull:      latency.ImagePullSeconds,
+		models.StartupPhaseInitContainers: latency.InitContainersSeconds,
+		models.StartupPhaseAppReadiness:   latency.AppReadinessSeconds,
+	})
+
+	return latency
+}
+
+func conditionTrueTime(pod *v1.Pod, conditionType v1.PodConditionType) time.Time {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
+
+func secondsBetween(start, end time.Time) float64 {
+	if end.Before(start) {
+		return 0
+	}
+	return end.Sub(start).Seconds()
+}
+
+// startupBottleneck returns the phase with the largest duration, or "" when
+// no phase could be measured. Ties are broken by phase name for stable output.
+func startupBottleneck(phases map[string]float64) string {
+	bottleneck := ""
+	for phase, seconds := range phases {
+		if seconds <= 0 {
+			continue
+		}
+		if bottleneck == "" || seconds > phases[bottleneck] || (seconds == phases[bottleneck] && phase < bottleneck) {
+			bottleneck = phase
+		}
+	}
+	return bottleneck
+}
+
+// podJobs returns the Job owning the pod, or nil when the pod is not
+// Job-owned or the Job cannot be read.
+func (s *podService) podJobs(ctx context.Context, pod *v1.Pod) workload.Jobs {
+	owner := workload.Owner(pod)
+	if owner == nil || owner.Kind != "Job" {
+		return nil
+	}
+
+	job, err := s.k8sClient.BatchV1().Jobs(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	if err != nil {
+		s.logger.Warn("failed to get job for pod workload",
+			"namespace", pod.Namespace,
+			"pod", pod.Name,
+			"job", owner.Name,
+			"error", err.Error())
+		return nil
+	}
+	return workload.NewJobs([]batchv1.Job{*job})
+}
+
+// startupPercentiles computes nearest-rank percentiles of the given values.
+func startupPercentiles(values []float64) models.StartupPercentiles {
+	if len(values) == 0 {
+		return models.StartupPercentiles{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	rank := func(p float64) float64 {
+		index := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		if index < 0 {
+			index = 0
+		}
+		return sorted[index]
+	}
+
+	return models.StartupPercentiles{
+		P50: rank(50),
+		P95: rank(95),
+		Max: sorted[len(sorted)-1],
+	}
+}
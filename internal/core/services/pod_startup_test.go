@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newStartupPod(name, replicaSet string, created time.Time, scheduled, initDone, started, ready int) *v1.Pod {
+	at := func(seconds int) metav1.Time {
+		return metav1.NewTime(created.Add(time.Duration(seconds) * time.Second))
+	}
+	isController := true
+	hash := replicaSet[strings.LastIndex(replicaSet, "-")+1:]
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels:            map[string]string{"pod-template-hash": hash},
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "ReplicaSet",
+				Name:       replicaSet,
+				Controller: &isController,
+			}},
+		},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodScheduled, Status: v1.ConditionTrue, LastTransitionTime: at(scheduled)},
+				{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: at(ready)},
+			},
+			InitContainerStatuses: []v1.ContainerStatus{{
+				Name: "migrate",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+					StartedAt:  at(scheduled + 1),
+					FinishedAt: at(initDone),
+				}},
+			}},
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "app",
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: at(started)}},
+			}},
+		},
+	}
+	return pod
+}
+
+func newPullEvent(pod, name, reason, message string, at time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Name:      pod,
+			Namespace: "default",
+			FieldPath: "spec.containers{app}",
+		},
+		Reason:         reason,
+		Message:        message,
+		FirstTimestamp: metav1.NewTime(at),
+		LastTimestamp:  metav1.NewTime(at),
+		Count:          1,
+	}
+}
+
+func TestComputeStartupLatency(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	pod := newStartupPod("web-7d9f-abcde", "web-7d9f", created, 5, 15, 200, 230)
+
+	events := []v1.Event{
+		*newPullEvent("web-7d9f-abcde", "pulling", "Pulling", `Pulling image "web:2.0"`, created.Add(16*time.Second)),
+		*newPullEvent("web-7d9f-abcde", "pulled", "Pulled", `Successfully pulled image "web:2.0" in 3m2s (3m2s including waiting)`, created.Add(198*time.Second)),
+	}
+
+	latency := computeStartupLatency(pod, events, nil)
+
+	assert.Zero(t, latency.ReadinessProbeFailures)
+	assert.Equal(t, "Deployment", latency.WorkloadKind)
+	assert.Equal(t, "web", latency.WorkloadName)
+	assert.True(t, latency.Ready)
+	assert.InDelta(t, 5, latency.SchedulingSeconds, 0.001)
+	assert.InDelta(t, 9, latency.InitContainersSeconds, 0.001)
+	assert.InDelta(t, 182, latency.ImagePullSeconds, 0.001)
+	assert.InDelta(t, 30, latency.AppReadinessSeconds, 0.001)
+	assert.InDelta(t, 230, latency.TimeToReadySeconds, 0.001)
+	assert.Equal(t, models.StartupPhaseImagePull, latency.Bottleneck)
+	require.Len(t, latency.ImagePulls, 1)
+	assert.False(t, latency.ImagePulls[0].Cached)
+}
+
+func TestComputeStartupLatency_CachedImageNotReady(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+	}
+	events := []v1.Event{
+		*newPullEvent("standalone", "scheduled", "Scheduled", "Successfully assigned default/standalone to node-a", created.Add(2*time.Second)),
+		*newPullEvent("standalone", "pulled", "Pulled", `Container image "web:2.0" already present on machine`, created.Add(3*time.Second)),
+	}
+
+	latency := computeStartupLatency(pod, events, nil)
+
+	assert.Equal(t, "Pod", latency.WorkloadKind)
+	assert.Equal(t, "standalone", latency.WorkloadName)
+	assert.False(t, latency.Ready)
+	assert.InDelta(t, 2, latency.SchedulingSeconds, 0.001)
+	assert.Zero(t, latency.ImagePullSeconds)
+	require.Len(t, latency.ImagePulls, 1)
+	assert.True(t, latency.ImagePulls[0].Cached)
+	assert.Equal(t, models.StartupPhaseScheduling, latency.Bottleneck)
+}
+
+func TestStartupPercentiles(t *testing.T) {
+	values := []float64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}
+
+	percentiles := startupPercentiles(values)
+
+	assert.Equal(t, 5.0, percentiles.P50)
+	assert.Equal(t, 10.0, percentiles.P95)
+	assert.Equal(t, 10.0, percentiles.Max)
+	assert.Equal(t, []float64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}, values, "input must not be reordered")
+	assert.Equal(t, models.StartupPercentiles{}, startupPercentiles(nil))
+}
+
+func TestNamespaceService_GetNamespaceStartupStats(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+
+	restarted := newStartupPod("web-7d9f-restarted", "web-7d9f", created, 1, 2, 500, 900)
+	restarted.Status.ContainerStatuses[0].RestartCount = 3
+
+	pending := newStartupPod("api-5c4b-pending", "api-5c4b", created, 1, 2, 3, 4)
+	pending.Status.Conditions = pending.Status.Conditions[:1]
+
+	isController := true
+	report := newStartupPod("report-28901-x7k2p", "report-28901", created, 1, 2, 3, 5)
+	report.Labels = nil
+	report.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: "report-28901", Controller: &isController}}
+	reportJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name:            "report-28901",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob", Name: "report", Controller: &isController}},
+	}}
+
+	// Its Ready transition may come from a later flap, not the startup.
+	flapped := newStartupPod("web-7d9f-flapped", "web-7d9f", created, 1, 2, 5, 600)
+	flapped.UID = "flapped-uid"
+	flappedEvent := newPullEvent(flapped.Name, "flapped-unhealthy", "Unhealthy", "Readiness probe failed: HTTP probe failed with statuscode: 503", created.Add(300*time.Second))
+	flappedEvent.InvolvedObject.UID = flapped.UID
+
+	// An earlier pod of the same name failed its probe; the current one did not.
+	recreated := newStartupPod("web-7d9f-aaaaa", "web-7d9f", created, 1, 2, 10, 20)
+	recreated.UID = "current-uid"
+	staleEvent := newPullEvent(recreated.Name, "stale-unhealthy", "Unhealthy", "Readiness probe failed: connection refused", created.Add(-time.Hour))
+	staleEvent.InvolvedObject.UID = "previous-uid"
+
+	objects := []runtime.Object{
+		recreated,
+		newStartupPod("web-7d9f-bbbbb", "web-7d9f", created, 2, 3, 30, 40),
+		newStartupPod("web-7d9f-ccccc", "web-7d9f", created, 3, 4, 110, 120),
+		newStartupPod("worker-6b8c-aaaaa", "worker-6b8c", created, 1, 2, 5, 10),
+		restarted,
+		pending,
+		report,
+		reportJob,
+		flapped,
+		flappedEvent,
+		staleEvent,
+	}
+
+	fakeClient := fake.NewSimpleClientset(objects...)
+	svc := NewNamespaceService(fakeClient, &config.Config{PodRestartThreshold: 5}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	stats, err := svc.GetNamespaceStartupStats(context.Background(), "default")
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, stats.TotalPods)
+	assert.Equal(t, 5, stats.ReadyPods)
+	require.Len(t, stats.Workloads, 3)
+
+	web := stats.Workloads[0]
+	assert.Equal(t, "web", web.WorkloadName)
+	assert.Equal(t, 3, web.ReadyPods)
+	assert.InDelta(t, 40, web.TimeToReady.P50, 0.001)
+	assert.InDelta(t, 120, web.TimeToReady.P95, 0.001)
+	assert.Equal(t, "web-7d9f-ccccc", web.SlowestPod)
+
+	assert.Equal(t, "worker", stats.Workloads[1].WorkloadName)
+
+	// Job pods are attributed to their CronJob, as in the namespace rollup.
+	assert.Equal(t, "CronJob", stats.Workloads[2].WorkloadKind)
+	assert.Equal(t, "report", stats.Workloads[2].WorkloadName)
+}
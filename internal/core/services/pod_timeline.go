@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
+)
+
+var (
+	eventFieldPathContainerRegex = regexp.MustCompile(`\{([^}]+)\}`)
+	pulledDurationRegex          = regexp.MustCompile(`pulled image "[^"]*" in ([0-9][0-9.a-zµ]*)`)
+
+	timelineConditionTypes = map[v1.PodConditionType]bool{
+		v1.PodScheduled:    true,
+		v1.PodInitialized:  true,
+		v1.ContainersReady: true,
+		v1.PodReady:        true,
+	}
+)
+
+func (s *podService) GetPodTimeline(ctx context.Context, namespace, name string) (*models.PodTimeline, error) {
+	s.logger.Debug("getting pod timeline", "namespace", namespace, "pod", name)
+
+	pod, err := s.GetPod(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.listPodTimelineEvents(ctx, namespace, name)
+	if err != nil {
+		s.logger.Warn("failed to get pod events for timeline",
+			"namespace", namespace,
+			"pod", name,
+			"error", err.Error())
+		events = []v1.Event{}
+	}
+
+	entries := []models.TimelineEntry{{
+		Timestamp: pod.CreationTimestamp,
+		Type:      models.TimelineEntryCreated,
+		Reason:    "Created",
+		Message:   "Pod object created",
+	}}
+	entries = append(entries, s.conditionTimelineEntries(pod)...)
+	entries = append(entries, s.containerTimelineEntries(pod.Status.InitContainerStatuses)...)
+	entries = append(entries, s.containerTimelineEntries(pod.Status.ContainerStatuses)...)
+	entries = append(entries, s.eventTimelineEntries(events)...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(&entries[j].Timestamp)
+	})
+
+	timeline := &models.PodTimeline{
+		PodName:   pod.Name,
+		Namespace: pod.Namespace,
+		CreatedAt: pod.CreationTimestamp,
+		Entries:   entries,
+	}
+
+	longestGap := -1
+	for i := range entries {
+		entry := &entries[i]
+
+		sinceCreation := entry.Timestamp.Sub(pod.CreationTimestamp.Time)
+		if sinceCreation < 0 {
+			sinceCreation = 0
+		}
+		entry.SinceCreation = s.formatDuration(sinceCreation)
+		entry.SinceCreationSeconds = sinceCreation.Seconds()
+
+		var sincePrevious time.Duration
+		if i > 0 {
+			sincePrevious = entry.Timestamp.Sub(entries[i-1].Timestamp.Time)
+		}
+		entry.SincePrevious = s.formatDuration(sincePrevious)
+		entry.SincePreviousSeconds = sincePrevious.Seconds()
+
+		if sincePrevious > 0 && (longestGap < 0 || entry.SincePreviousSeconds > entries[longestGap].SincePreviousSeconds) {
+			longestGap = i
+		}
+
+		if entry.Type == models.TimelineEntryImagePull {
+			timeline.ImagePullSeconds += entry.DurationSeconds
+		}
+	}
+
+	if longestGap >= 0 {
+		gap := entries[longestGap]
+		timeline.LongestGap = &gap
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue && !condition.LastTransitionTime.IsZero() {
+			startup := condition.LastTransitionTime.Sub(pod.CreationTimestamp.Time)
+			timeline.StartupDuration = s.formatDuration(startup)
+			timeline.StartupDurationSeconds = startup.Seconds()
+		}
+	}
+
+	s.logger.Debug("successfully built pod timeline",
+		"namespace", namespace,
+		"pod", name,
+		"entries", len(timeline.Entries),
+		"events", len(events))
+
+	return timeline, nil
+}
+
+func (s *podService) listPodTimelineEvents(ctx context.Context, namespace, podName string) ([]v1.Event, error) {
+	fieldSelector := fields.AndSelectors(
+		fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+		fields.OneTermEqualSelector("involvedObject.name", podName),
+		fields.OneTermEqualSelector("involvedObject.namespace", namespace),
+	).String()
+
+	eventList, err := s.k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for pod %s/%s: %w", namespace, podName, err)
+	}
+
+	return eventList.Items, nil
+}
+
+func (s *podService) conditionTimelineEntries(pod *v1.Pod) []models.TimelineEntry {
+	entries := []models.TimelineEntry{}
+
+	for _, condition := range pod.Status.Conditions {
+		if !timelineConditionTypes[condition.Type] || condition.LastTransitionTime.IsZero() {
+			continue
+		}
+
+		message := fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+		if condition.Reason != "" {
+			message = fmt.Sprintf("%s (%s)", message, condition.Reason)
+		}
+		if condition.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, condition.Message)
+		}
+
+		entries = append(entries, models.TimelineEntry{
+			Timestamp: condition.LastTransitionTime,
+			Type:      models.TimelineEntryCondition,
+			Reason:    string(condition.Type),
+			Message:   message,
+		})
+	}
+
+	return entries
+}
+
+func (s *podService) containerTimelineEntries(statuses []v1.ContainerStatus) []models.TimelineEntry {
+	entries := []models.TimelineEntry{}
+
+	for _, status := range statuses {
+		if running := status.State.Running; running != nil && !running.StartedAt.IsZero() {
+			entries = append(entries, models.TimelineEntry{
+				Timestamp: running.StartedAt,
+				Type:      models.TimelineEntryContainerStarted,
+				Reason:    "Started",
+				Container: status.Name,
+				Message:   fmt.Sprintf("Container %s started (restart count %d)", status.Name, status.RestartCount),
+			})
+		}
+
+		entries = append(entries, terminatedTimelineEntries(status.Name, status.State.Terminated, "")...)
+		entries = append(entries, terminatedTimelineEntries(status.Name, status.LastTerminationState.Terminated, "previous instance ")...)
+	}
+
+	return entries
+}
+
+func terminatedTimelineEntries(container string, terminated *v1.ContainerStateTerminated, qualifier string) []models.TimelineEntry {
+	if terminated == nil {
+		return nil
+	}
+
+	entries := []models.TimelineEntry{}
+	if !terminated.StartedAt.IsZero() {
+		entries = append(entries, models.TimelineEntry{
+			Timestamp: terminated.StartedAt,
+			Type:      models.TimelineEntryContainerStarted,
+			Reason:    "Started",
+			Container: container,
+			Message:   fmt.Sprintf("Container %s %sstarted", container, qualifier),
+		})
+	}
+
+	if !terminated.FinishedAt.IsZero() {
+		message := fmt.Sprintf("Container %s %sterminated with exit code %d", container, qualifier, terminated.ExitCode)
+		if terminated.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, terminated.Message)
+		}
+
+		entry := models.TimelineEntry{
+			Timestamp: terminated.FinishedAt,
+			Type:      models.TimelineEntryContainerTerminated,
+			Reason:    terminated.Reason,
+			Container: container,
+			Message:   message,
+		}
+		if !terminated.StartedAt.IsZero() {
+			ran := terminated.FinishedAt.Sub(terminated.StartedAt.Time)
+			entry.DurationSeconds = ran.Seconds()
+			entry.Duration = ran.String()
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func (s *podService) eventTimelineEntries(events []v1.Event) []models.TimelineEntry {
+	entries := []models.TimelineEntry{}
+	pulling := make(map[string]*v1.Event)
+
+	for i := range events {
+		event := &events[i]
+		if event.Reason == "Pulling" {
+			pulling[eventContainerName(event)] = event
+		}
+	}
+
+	pulled := make(map[string]bool)
+	for i := range events {
+		event := &events[i]
+		container := eventContainerName(event)
+
+		switch event.Reason {
+		case "Pulled":
+			pulled[container] = true
+			entry := models.TimelineEntry{
+				Timestamp: relatedevents.Timestamp(event),
+				Type:      models.TimelineEntryImagePull,
+				Reason:    event.Reason,
+				Container: container,
+				Message:   event.Message,
+				Count:     event.Count,
+			}
+			if duration, ok := imagePullDuration(event, pulling[container]); ok {
+				entry.Duration = duration.String()
+				entry.DurationSeconds = duration.Seconds()
+			}
+			entries = append(entries, entry)
+		case "Unhealthy":
+			entries = append(entries, models.TimelineEntry{
+				Timestamp: relatedevents.Timestamp(event),
+				Type:      models.TimelineEntryProbeFailure,
+				Reason:    event.Reason,
+				Container: container,
+				Message:   event.Message,
+				Count:     event.Count,
+			})
+		case "Killing":
+			entries = append(entries, models.TimelineEntry{
+				Timestamp: relatedevents.Timestamp(event),
+				Type:      models.TimelineEntryContainerKilled,
+				Reason:    event.Reason,
+				Container: container,
+				Message:   event.Message,
+				Count:     event.Count,
+			})
+		}
+	}
+
+	// A Pulling event without a matching Pulled event is a pull still in
+	// progress (or one that failed); keep it so the gap is visible.
+	for i := range events {
+		event := &events[i]
+		container := eventContainerName(event)
+		if event.Reason != "Pulling" || pulled[container] || pulling[container] != event {
+			continue
+		}
+		entries = append(entries, models.TimelineEntry{
+			Timestamp: relatedevents.Timestamp(event),
+			Type:      models.TimelineEntryImagePull,
+			Reason:    event.Reason,
+			Container: container,
+			Message:   event.Message,
+			Count:     event.Count,
+		})
+	}
+
+	return entries
+}
+
+// imagePullDuration prefers the duration the kubelet reports in the Pulled
+// message and falls back to the gap between the Pulling and Pulled events.
+func imagePullDuration(pulledEvent, pullingEvent *v1.Event) (time.Duration, bool) {
+	if matches := pulledDurationRegex.FindStringSubmatch(pulledEvent.Message); len(matches) > 1 {
+		if duration, err := time.ParseDuration(matches[1]); err == nil {
+			return duration, true
+		}
+	}
+
+	if pullingEvent == nil {
+		return 0, false
+	}
+
+	start := relatedevents.Timestamp(pullingEvent)
+	end := relatedevents.Timestamp(pulledEvent)
+	if end.Before(&start) {
+		return 0, false
+	}
+	return end.Sub(start.Time), true
+}
+
+func eventContainerName(event *v1.Event) string {
+	matches := eventFieldPathContainerRegex.FindStringSubmatch(event.InvolvedObject.FieldPath)
+	if len(matches) > 1 {
+		return matches[1]
+	}
+	return ""
+}
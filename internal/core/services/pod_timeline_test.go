@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newTimelineEvent(name, reason, fieldPath, message string, at time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "web",
+			Namespace: "default",
+			FieldPath: fieldPath,
+		},
+		Reason:         reason,
+		Message:        message,
+		FirstTimestamp: metav1.NewTime(at),
+		LastTimestamp:  metav1.NewTime(at),
+		Count:          1,
+	}
+}
+
+func TestPodService_GetPodTimeline(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return created.Add(time.Duration(seconds) * time.Second) }
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodScheduled, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(at(1))},
+				{Type: v1.PodInitialized, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(at(2))},
+				{Type: v1.ContainersReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(at(240))},
+				{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(at(240))},
+			},
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:         "app",
+				RestartCount: 1,
+				State: v1.ContainerState{
+					Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(at(200))},
+				},
+				LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{
+						Reason:     "Error",
+						ExitCode:   1,
+						StartedAt:  metav1.NewTime(at(150)),
+						FinishedAt: metav1.NewTime(at(190)),
+					},
+				},
+			}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(
+		pod,
+		newTimelineEvent("pulling", "Pulling", "spec.containers{app}", `Pulling image "app:1.0"`, at(3)),
+		newTimelineEvent("pulled", "Pulled", "spec.containers{app}", `Successfully pulled image "app:1.0" in 2m25.5s (2m25.5s including waiting)`, at(148)),
+		newTimelineEvent("unhealthy", "Unhealthy", "spec.containers{app}", "Liveness probe failed: connection refused", at(185)),
+		newTimelineEvent("killing", "Killing", "spec.containers{app}", "Container app failed liveness probe, will be restarted", at(186)),
+	)
+
+	svc := NewPodService(fakeClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	timeline, err := svc.GetPodTimeline(context.Background(), "default", "web")
+	require.NoError(t, err)
+
+	reasons := make([]string, 0, len(timeline.Entries))
+	for _, entry := range timeline.Entries {
+		reasons = append(reasons, entry.Reason)
+	}
+	assert.Equal(t, []string{
+		"Created", "PodScheduled", "Initialized", "Pulled", "Started",
+		"Unhealthy", "Killing", "Error", "Started", "ContainersReady", "Ready",
+	}, reasons)
+
+	for i := 1; i < len(timeline.Entries); i++ {
+		assert.False(t, timeline.Entries[i].Timestamp.Before(&timeline.Entries[i-1].Timestamp), "entries must be chronological")
+	}
+
+	pull := timeline.Entries[3]
+	assert.Equal(t, models.TimelineEntryImagePull, pull.Type)
+	assert.Equal(t, "app", pull.Container)
+	assert.InDelta(t, 145.5, pull.DurationSeconds, 0.001)
+	assert.InDelta(t, 145.5, timeline.ImagePullSeconds, 0.001)
+	assert.InDelta(t, 146, pull.SincePreviousSeconds, 0.001)
+
+	assert.InDelta(t, 240, timeline.StartupDurationSeconds, 0.001)
+	require.NotNil(t, timeline.LongestGap)
+	assert.Equal(t, "Pulled", timeline.LongestGap.Reason)
+}
+
+func TestPodService_GetPodTimeline_PullFallsBackToEventGap(t *testing.T) {
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+	}
+
+	fakeClient := fake.NewSimpleClientset(
+		pod,
+		newTimelineEvent("pulling-app", "Pulling", "spec.containers{app}", `Pulling image "app:1.0"`, created.Add(5*time.Second)),
+		newTimelineEvent("pulled-app", "Pulled", "spec.containers{app}", `Successfully pulled image "app:1.0"`, created.Add(35*time.Second)),
+		newTimelineEvent("pulling-sidecar", "Pulling", "spec.containers{sidecar}", `Pulling image "sidecar:1.0"`, created.Add(6*time.Second)),
+	)
+
+	svc := NewPodService(fakeClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	timeline, err := svc.GetPodTimeline(context.Background(), "default", "web")
+	require.NoError(t, err)
+
+	require.Len(t, timeline.Entries, 3)
+	assert.Equal(t, "Pulling", timeline.Entries[1].Reason)
+	assert.Equal(t, "sidecar", timeline.Entries[1].Container)
+	assert.Equal(t, "Pulled", timeline.Entries[2].Reason)
+	assert.InDelta(t, 30, timeline.Entries[2].DurationSeconds, 0.001)
+	assert.Empty(t, timeline.StartupDuration)
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+// listActiveNodePods returns the pods scheduled on a node that still hold
+// resources, i.e. everything except Succeeded and Failed pods.
+func listActiveNodePods(ctx context.Context, k8sClient kubernetes.Interface, nodeName string) ([]v1.Pod, error) {
+	podList, err := k8sClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	pods := make([]v1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName != nodeName || isPodTerminated(pod) {
+			continue
+		}
+		pods = append(pods, *pod)
+	}
+
+	return pods, nil
+}
+
+// listActivePodsByNode returns the non-terminated pods of the whole cluster
+// grouped by node name, using a single list call. Unscheduled pods are
+// skipped.
+func listActivePodsByNode(ctx context.Context, k8sClient kubernetes.Interface) (map[string][]v1.Pod, error) {
+	podList, err := k8sClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermNotEqualSelector("spec.nodeName", "").String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	podsByNode := make(map[string][]v1.Pod)
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName == "" || isPodTerminated(pod) {
+			continue
+		}
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], *pod)
+	}
+
+	return podsByNode, nil
+}
+
+func isPodTerminated(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
+
+// podRequests returns the pod's effective requests the way the scheduler
+// and quota admission count them: the larger of the app containers' sum and
+// each init container, with sidecar init containers running alongside both,
+// plus the pod overhead.
+func podRequests(pod *v1.Pod) v1.ResourceList {
+	total := effectivePodResources(pod, func(c *v1.Container) v1.ResourceList { return c.Resources.Requests })
+	addResourceList(total, pod.Spec.Overhead)
+	return total
+}
+
+// podLimits returns the pod's effective limits, counted like podRequests.
+// The overhead only adds to resources that have a limit, as unlimited stays
+// unlimited.
+func podLimits(pod *v1.Pod) v1.ResourceList {
+	total := effectivePodResources(pod, func(c *v1.Container) v1.ResourceList { return c.Resources.Limits })
+	for name, quantity := range pod.Spec.Overhead {
+		if current, ok := total[name]; ok {
+			current.Add(quantity)
+			total[name] = current
+		}
+	}
+	return total
+}
+
+func effectivePodResources(pod *v1.Pod, resources func(*v1.Container) v1.ResourceList) v1.ResourceList {
+	total := v1.ResourceList{}
+	for i := range pod.Spec.Containers {
+		addResourceList(total, resources(&pod.Spec.Containers[i]))
+	}
+
+	// Init containers run one at a time, each next to the sidecars started
+	// before it; sidecars keep running with the app containers.
+	sidecars := v1.ResourceList{}
+	initPeak := v1.ResourceList{}
+	for i := range pod.Spec.InitContainers {
+		container := &pod.Spec.InitContainers[i]
+		running := v1.ResourceList{}
+		if container.RestartPolicy != nil && *container.RestartPolicy == v1.ContainerRestartPolicyAlways {
+			addResourceList(total, resources(container))
+			addResourceList(sidecars, resources(container))
+			addResourceList(running, sidecars)
+		} else {
+			addResourceList(running, resources(container))
+			addResourceList(running, sidecars)
+		}
+		maxResourceList(initPeak, running)
+	}
+	maxResourceList(total, initPeak)
+
+	return total
+}
+
+// maxResourceList raises each quantity in total to the one in other.
+func maxResourceList(total, other v1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := total[name]; !ok || quantity.Cmp(current) > 0 {
+			total[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func addResourceList(total, add v1.ResourceList) {
+	for name, quantity := range add {
+		current := total[name]
+		current.Add(quantity)
+		total[name] = current
+	}
+}
+
+// percentOf returns value as a percentage of total without clamping, so
+// overcommitted limits are reported above 100.
+func percentOf(value, total resource.Quantity) float64 {
+	if total.IsZero() {
+		return 0
+	}
+	return float64(value.MilliValue()) / float64(total.MilliValue()) * 100
+}
+
+// nodeAllocation compares the requests and limits of the given pods with the
+// node's allocatable resources. The pods resource counts scheduled pods.
+func nodeAllocation(node *v1.Node, pods []v1.Pod) map[string]models.ResourceAllocation {
+	requested := v1.ResourceList{}
+	limits := v1.ResourceList{}
+	for i := range pods {
+		addResourceList(requested, podRequests(&pods[i]))
+		addResourceList(limits, podLimits(&pods[i]))
+	}
+	requested[v1.ResourcePods] = *resource.NewQuantity(int64(len(pods)), resource.DecimalSI)
+
+	names := make(map[v1.ResourceName]bool)
+	for name := range node.Status.Allocatable {
+		names[name] = true
+	}
+	for name := range requested {
+		names[name] = true
+	}
+
+	allocation := make(map[string]models.ResourceAllocation, len(names))
+	for name := range names {
+		allocatable := node.Status.Allocatable[name]
+		entry := models.ResourceAllocation{
+			Capacity:            quantityString(node.Status.Capacity, name),
+			Allocatable:         allocatable.String(),
+			Requested:           quantityString(requested, name),
+			RequestedPercentage: percentOf(requested[name], allocatable),
+		}
+		if name != v1.ResourcePods {
+			entry.Limits = quantityString(limits, name)
+			entry.LimitsPercentage = percentOf(limits[name], allocatable)
+		}
+		allocation[string(name)] = entry
+	}
+
+	return allocation
+}
+
+func quantityString(list v1.ResourceList, name v1.ResourceName) string {
+	quantity := list[name]
+	return quantity.String()
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func resourceContainer(name, cpuRequest, memoryRequest, memoryLimit string) v1.Container {
+	container := v1.Container{
+		Name: name,
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpuRequest),
+				v1.ResourceMemory: resource.MustParse(memoryRequest),
+			},
+		},
+	}
+	if memoryLimit != "" {
+		container.Resources.Limits = v1.ResourceList{v1.ResourceMemory: resource.MustParse(memoryLimit)}
+	}
+	return container
+}
+
+func TestPodRequestsAndLimits(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+
+	tests := []struct {
+		name           string
+		spec           v1.PodSpec
+		expectedCPU    string
+		expectedMemory string
+		expectedLimit  string
+	}{
+		{
+			name: "app containers are summed",
+			spec: v1.PodSpec{Containers: []v1.Container{
+				resourceContainer("app", "500m", "256Mi", "512Mi"),
+				resourceContainer("proxy", "100m", "64Mi", "128Mi"),
+			}},
+			expectedCPU:    "600m",
+			expectedMemory: "320Mi",
+			expectedLimit:  "640Mi",
+		},
+		{
+			name: "largest init container wins per resource",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					resourceContainer("migrate", "2", "128Mi", "1Gi"),
+					resourceContainer("warm-cache", "100m", "1Gi", ""),
+				},
+				Containers: []v1.Container{resourceContainer("app", "500m", "256Mi", "512Mi")},
+			},
+			expectedCPU:    "2",
+			expectedMemory: "1Gi",
+			expectedLimit:  "1Gi",
+		},
+		{
+			name: "sidecar init containers run alongside",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					func() v1.Container {
+						c := resourceContainer("mesh", "200m", "128Mi", "256Mi")
+						c.RestartPolicy = &always
+						return c
+					}(),
+					resourceContainer("migrate", "1", "128Mi", ""),
+				},
+				Containers: []v1.Container{resourceContainer("app", "500m", "256Mi", "512Mi")},
+			},
+			// migrate runs next to the mesh sidecar: 1 + 200m.
+			expectedCPU:    "1200m",
+			expectedMemory: "384Mi",
+			expectedLimit:  "768Mi",
+		},
+		{
+			name: "overhead is added",
+			spec: v1.PodSpec{
+				Containers: []v1.Container{resourceContainer("app", "500m", "256Mi", "512Mi")},
+				Overhead: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("250m"),
+					v1.ResourceMemory: resource.MustParse("120Mi"),
+				},
+			},
+			expectedCPU:    "750m",
+			expectedMemory: "376Mi",
+			expectedLimit:  "632Mi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{Spec: tt.spec}
+
+			requests := podRequests(pod)
+			cpu := requests[v1.ResourceCPU]
+			memory := requests[v1.ResourceMemory]
+			assert.Equal(t, 0, cpu.Cmp(resource.MustParse(tt.expectedCPU)), "cpu request %s", cpu.String())
+			assert.Equal(t, 0, memory.Cmp(resource.MustParse(tt.expectedMemory)), "memory request %s", memory.String())
+
+			limits := podLimits(pod)
+			memoryLimit := limits[v1.ResourceMemory]
+			assert.Equal(t, 0, memoryLimit.Cmp(resource.MustParse(tt.expectedLimit)), "memory limit %s", memoryLimit.String())
+			_, hasCPULimit := limits[v1.ResourceCPU]
+			assert.False(t, hasCPULimit, "overhead does not create a limit")
+		})
+	}
+}
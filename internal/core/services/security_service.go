@@ -0,0 +1,557 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const (
+	podSecurityLabelPrefix = "pod-security.kubernetes.io/"
+
+	checkHostProcess              = "hostProcess"
+	checkHostNamespaces           = "hostNamespaces"
+	checkPrivileged               = "privileged"
+	checkCapabilitiesBaseline     = "capabilities_baseline"
+	checkHostPathVolumes          = "hostPathVolumes"
+	checkHostPorts                = "hostPorts"
+	checkSeccompBaseline          = "seccompProfile_baseline"
+	checkProcMount                = "procMount"
+	checkSELinux                  = "seLinuxOptions"
+	checkSysctls                  = "sysctls"
+	checkAppArmor                 = "appArmorProfile"
+	checkRestrictedVolumes        = "restrictedVolumes"
+	checkAllowPrivilegeEscalation = "allowPrivilegeEscalation"
+	checkRunAsNonRoot             = "runAsNonRoot"
+	checkRunAsUser                = "runAsUser"
+	checkSeccompRestricted        = "seccompProfile_restricted"
+	checkCapabilitiesRestricted   = "capabilities_restricted"
+	checkReadOnlyRootFilesystem   = "readOnlyRootFilesystem"
+
+	appArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+)
+
+var baselineAllowedCapabilities = map[v1.Capability]bool{
+	"AUDIT_WRITE":      true,
+	"CHOWN":            true,
+	"DAC_OVERRIDE":     true,
+	"FOWNER":           true,
+	"FSETID":           true,
+	"KILL":             true,
+	"MKNOD":            true,
+	"NET_BIND_SERVICE": true,
+	"SETFCAP":          true,
+	"SETGID":           true,
+	"SETPCAP":          true,
+	"SETUID":           true,
+	"SYS_CHROOT":       true,
+}
+
+var baselineAllowedSELinuxTypes = map[string]bool{
+	"":                 true,
+	"container_t":      true,
+	"container_init_t": true,
+	"container_kvm_t":  true,
+}
+
+// baselineAllowedSysctls is the safe sysctl set the baseline profile allows.
+var baselineAllowedSysctls = map[string]bool{
+	"kernel.shm_rmid_forced":              true,
+	"net.ipv4.ip_local_port_range":        true,
+	"net.ipv4.ip_local_reserved_ports":    true,
+	"net.ipv4.ip_unprivileged_port_start": true,
+	"net.ipv4.ping_group_range":           true,
+	"net.ipv4.tcp_syncookies":             true,
+	"net.ipv4.tcp_keepalive_time":         true,
+	"net.ipv4.tcp_fin_timeout":            true,
+	"net.ipv4.tcp_keepalive_intvl":        true,
+	"net.ipv4.tcp_keepalive_probes":       true,
+}
+
+type securityService struct {
+	k8sClient  kubernetes.Interface
+	podService core.PodService
+	logger     *slog.Logger
+}
+
+func NewSecurityService(k8sClient kubernetes.Interface, podService core.PodService, logger *slog.Logger) core.SecurityService {
+	return &securityService{
+		k8sClient:  k8sClient,
+		podService: podService,
+		logger:     logger,
+	}
+}
+
+func (s *securityService) GetPodSecurityAudit(ctx context.Context, namespace, name string) (*models.PodSecurityAudit, error) {
+	s.logger.Debug("auditing pod security posture", "namespace", namespace, "pod", name)
+
+	pod, err := s.podService.GetPod(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.getNamespacePolicy(ctx, namespace)
+	audit := s.auditPod(pod, policy)
+
+	s.logger.Debug("pod security audit complete",
+		"namespace", namespace,
+		"pod", name,
+		"level", audit.Level,
+		"findings", len(audit.Findings))
+
+	return audit, nil
+}
+
+func (s *securityService) GetNamespaceSecurityAudit(ctx context.Context, namespace string) (*models.NamespaceSecurityAudit, error) {
+	s.logger.Debug("auditing namespace security posture", "namespace", namespace)
+
+	pods, err := s.k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+
+	policy := s.getNamespacePolicy(ctx, namespace)
+
+	result := &models.NamespaceSecurityAudit{
+		Namespace:       namespace,
+		NamespacePolicy: policy,
+		TotalPods:       len(pods.Items),
+		PodsByLevel: map[string]int{
+			models.PodSecurityLevelPrivileged: 0,
+			models.PodSecurityLevelBaseline:   0,
+			models.PodSecurityLevelRestricted: 0,
+		},
+		PodsBlockedIfEnforced: map[string]int{
+			models.PodSecurityLevelBaseline:   0,
+			models.PodSecurityLevelRestricted: 0,
+		},
+		FindingsByCheck: make(map[string]int),
+		Pods:            make([]models.PodSecuritySummary, 0, len(pods.Items)),
+		AuditedAt:       time.Now(),
+	}
+
+	for i := range pods.Items {
+		audit := s.auditPod(&pods.Items[i], policy)
+
+		result.PodsByLevel[audit.Level]++
+		if !audit.BaselineCompliant {
+			result.PodsBlockedIfEnforced[models.PodSecurityLevelBaseline]++
+		}
+		if !audit.RestrictedCompliant {
+			result.PodsBlockedIfEnforced[models.PodSecurityLevelRestricted]++
+		}
+		if audit.ViolatesEnforce {
+			result.PodsViolatingEnforce++
+		}
+		for _, finding := range audit.Findings {
+			result.FindingsByCheck[finding.Check]++
+		}
+
+		result.Pods = append(result.Pods, models.PodSecuritySummary{
+			Name:            audit.PodName,
+			Level:           audit.Level,
+			ViolatesEnforce: audit.ViolatesEnforce,
+			FindingsCount:   len(audit.Findings),
+			CriticalCount:   audit.CriticalCount,
+		})
+	}
+
+	sort.Slice(result.Pods, func(i, j int) bool {
+		if result.Pods[i].CriticalCount != result.Pods[j].CriticalCount {
+			return result.Pods[i].CriticalCount > result.Pods[j].CriticalCount
+		}
+		if result.Pods[i].FindingsCount != result.Pods[j].FindingsCount {
+			return result.Pods[i].FindingsCount > result.Pods[j].FindingsCount
+		}
+		return result.Pods[i].Name < result.Pods[j].Name
+	})
+
+	s.logger.Info("namespace security audit complete",
+		"namespace", namespace,
+		"totalPods", result.TotalPods,
+		"blockedIfBaseline", result.PodsBlockedIfEnforced[models.PodSecurityLevelBaseline],
+		"blockedIfRestricted", result.PodsBlockedIfEnforced[models.PodSecurityLevelRestricted])
+
+	return result, nil
+}
+
+func (s *securityService) getNamespacePolicy(ctx context.Context, namespace string) *models.NamespaceSecurityPolicy {
+	ns, err := s.k8sClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		s.logger.Warn("failed to get namespace for pod security labels",
+			"namespace", namespace,
+			"error", err.Error())
+		return nil
+	}
+
+	labels := ns.Labels
+	policy := &models.NamespaceSecurityPolicy{
+		Enforce:        labels[podSecurityLabelPrefix+"enforce"],
+		EnforceVersion: labels[podSecurityLabelPrefix+"enforce-version"],
+		Audit:          labels[podSecurityLabelPrefix+"audit"],
+		AuditVersion:   labels[podSecurityLabelPrefix+"audit-version"],
+		Warn:           labels[podSecurityLabelPrefix+"warn"],
+		WarnVersion:    labels[podSecurityLabelPrefix+"warn-version"],
+	}
+
+	if policy.Enforce == "" && policy.Audit == "" && policy.Warn == "" {
+		return nil
+	}
+	return policy
+}
+
+func (s *securityService) auditPod(pod *v1.Pod, policy *models.NamespaceSecurityPolicy) *models.PodSecurityAudit {
+	findings := append(s.checkBaseline(pod), s.checkRestricted(pod)...)
+	findings = append(findings, s.checkBestPractices(pod)...)
+
+	audit := &models.PodSecurityAudit{
+		PodName:             pod.Name,
+		Namespace:           pod.Namespace,
+		BaselineCompliant:   true,
+		RestrictedCompliant: true,
+		NamespacePolicy:     policy,
+		Findings:            findings,
+		AuditedAt:           time.Now(),
+	}
+
+	for _, finding := range findings {
+		switch finding.Level {
+		case models.PodSecurityLevelBaseline:
+			audit.BaselineCompliant = false
+			audit.RestrictedCompliant = false
+		case models.PodSecurityLevelRestricted:
+			audit.RestrictedCompliant = false
+		}
+
+		switch finding.Severity {
+		case models.SeverityCritical:
+			audit.CriticalCount++
+		case models.SeverityWarning:
+			audit.WarningCount++
+		case models.SeverityInfo:
+			audit.InfoCount++
+		}
+	}
+
+	switch {
+	case audit.RestrictedCompliant:
+		audit.Level = models.PodSecurityLevelRestricted
+	case audit.BaselineCompliant:
+		audit.Level = models.PodSecurityLevelBaseline
+	default:
+		audit.Level = models.PodSecurityLevelPrivileged
+	}
+
+	if policy != nil {
+		audit.ViolatesEnforce = !satisfiesLevel(audit, policy.Enforce)
+		audit.ViolatesAudit = !satisfiesLevel(audit, policy.Audit)
+		audit.ViolatesWarn = !satisfiesLevel(audit, policy.Warn)
+	}
+
+	return audit
+}
+
+func satisfiesLevel(audit *models.PodSecurityAudit, level string) bool {
+	switch level {
+	case models.PodSecurityLevelBaseline:
+		return audit.BaselineCompliant
+	case models.PodSecurityLevelRestricted:
+		return audit.RestrictedCompliant
+	default:
+		return true
+	}
+}
+
+type auditedContainer struct {
+	name            string
+	securityContext *v1.SecurityContext
+	ports           []v1.ContainerPort
+}
+
+func podContainers(pod *v1.Pod) []auditedContainer {
+	containers := make([]auditedContainer, 0,
+		len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+
+	for i := range pod.Spec.InitContainers {
+		c := &pod.Spec.InitContainers[i]
+		containers = append(containers, auditedContainer{name: c.Name, securityContext: c.SecurityContext, ports: c.Ports})
+	}
+	for i := range pod.Spec.Containers {
+		c := &pod.Spec.Containers[i]
+		containers = append(containers, auditedContainer{name: c.Name, securityContext: c.SecurityContext, ports: c.Ports})
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		c := &pod.Spec.EphemeralContainers[i]
+		containers = append(containers, auditedContainer{name: c.Name, securityContext: c.SecurityContext, ports: c.Ports})
+	}
+
+	return containers
+}
+
+func (s *securityService) checkBaseline(pod *v1.Pod) []models.SecurityFinding {
+	findings := []models.SecurityFinding{}
+	baseline := func(check, severity, container, message, remediation string) {
+		findings = append(findings, models.SecurityFinding{
+			Check:       check,
+			Level:       models.PodSecurityLevelBaseline,
+			Severity:    severity,
+			Container:   container,
+			Message:     message,
+			Remediation: remediation,
+		})
+	}
+
+	hostNamespaces := []string{}
+	if pod.Spec.HostNetwork {
+		hostNamespaces = append(hostNamespaces, "hostNetwork")
+	}
+	if pod.Spec.HostPID {
+		hostNamespaces = append(hostNamespaces, "hostPID")
+	}
+	if pod.Spec.HostIPC {
+		hostNamespaces = append(hostNamespaces, "hostIPC")
+	}
+	if len(hostNamespaces) > 0 {
+		baseline(checkHostNamespaces, models.SeverityCritical, "",
+			fmt.Sprintf("pod shares host namespaces: %s", strings.Join(hostNamespaces, ", ")),
+			"Remove hostNetwork, hostPID and hostIPC from the pod spec")
+	}
+
+	for i := range pod.Spec.Volumes {
+		volume := &pod.Spec.Volumes[i]
+		if volume.HostPath != nil {
+			baseline(checkHostPathVolumes, models.SeverityCritical, "",
+				fmt.Sprintf("volume %s mounts host path %s", volume.Name, volume.HostPath.Path),
+				"Replace hostPath volumes with emptyDir, configMap or persistent volumes")
+		}
+	}
+
+	podSC := pod.Spec.SecurityContext
+	if podSC != nil {
+		if podSC.WindowsOptions != nil && podSC.WindowsOptions.HostProcess != nil && *podSC.WindowsOptions.HostProcess {
+			baseline(checkHostProcess, models.SeverityCritical, "",
+				"pod runs as a Windows HostProcess", "Unset windowsOptions.hostProcess")
+		}
+		if podSC.SeccompProfile != nil && podSC.SeccompProfile.Type == v1.SeccompProfileTypeUnconfined {
+			baseline(checkSeccompBaseline, models.SeverityWarning, "",
+				"pod seccomp profile is Unconfined", "Use the RuntimeDefault or a Localhost seccomp profile")
+		}
+		if podSC.SELinuxOptions != nil && !validBaselineSELinux(podSC.SELinuxOptions) {
+			baseline(checkSELinux, models.SeverityWarning, "",
+				"pod sets a custom SELinux user, role or type", "Remove seLinuxOptions user/role and custom types")
+		}
+
+		unsafe := []string{}
+		for _, sysctl := range podSC.Sysctls {
+			if !baselineAllowedSysctls[sysctl.Name] {
+				unsafe = append(unsafe, sysctl.Name)
+			}
+		}
+		if len(unsafe) > 0 {
+			baseline(checkSysctls, models.SeverityCritical, "",
+				fmt.Sprintf("pod sets unsafe sysctls: %s", strings.Join(unsafe, ", ")),
+				"Remove the sysctls or limit them to the safe set")
+		}
+	}
+
+	for _, container := range podContainers(pod) {
+		sc := container.securityContext
+
+		if profile := pod.Annotations[appArmorAnnotationPrefix+container.name]; !validBaselineAppArmor(profile) {
+			baseline(checkAppArmor, models.SeverityWarning, container.name,
+				fmt.Sprintf("container AppArmor profile is %s", profile),
+				"Use the runtime/default or a localhost/ AppArmor profile")
+		}
+
+		for _, port := range container.ports {
+			if port.HostPort != 0 {
+				baseline(checkHostPorts, models.SeverityWarning, container.name,
+					fmt.Sprintf("container binds host port %d", port.HostPort),
+					"Expose the container through a Service instead of hostPort")
+			}
+		}
+
+		if sc == nil {
+			continue
+		}
+
+		if sc.Privileged != nil && *sc.Privileged {
+			baseline(checkPrivileged, models.SeverityCritical, container.name,
+				"container runs in privileged mode", "Set securityContext.privileged to false")
+		}
+		if sc.WindowsOptions != nil && sc.WindowsOptions.HostProcess != nil && *sc.WindowsOptions.HostProcess {
+			baseline(checkHostProcess, models.SeverityCritical, container.name,
+				"container runs as a Windows HostProcess", "Unset windowsOptions.hostProcess")
+		}
+		if sc.Capabilities != nil {
+			disallowed := []string{}
+			for _, capability := range sc.Capabilities.Add {
+				if !baselineAllowedCapabilities[capability] {
+					disallowed = append(disallowed, string(capability))
+				}
+			}
+			if len(disallowed) > 0 {
+				baseline(checkCapabilitiesBaseline, models.SeverityCritical, container.name,
+					fmt.Sprintf("container adds capabilities outside the baseline set: %s", strings.Join(disallowed, ", ")),
+					"Remove the added capabilities or replace them with narrower ones")
+			}
+		}
+		if sc.SeccompProfile != nil && sc.SeccompProfile.Type == v1.SeccompProfileTypeUnconfined {
+			baseline(checkSeccompBaseline, models.SeverityWarning, container.name,
+				"container seccomp profile is Unconfined", "Use the RuntimeDefault or a Localhost seccomp profile")
+		}
+		if sc.ProcMount != nil && *sc.ProcMount != v1.DefaultProcMount {
+			baseline(checkProcMount, models.SeverityWarning, container.name,
+				fmt.Sprintf("container uses %s proc mount", *sc.ProcMount), "Use the Default proc mount type")
+		}
+		if sc.SELinuxOptions != nil && !validBaselineSELinux(sc.SELinuxOptions) {
+			baseline(checkSELinux, models.SeverityWarning, container.name,
+				"container sets a custom SELinux user, role or type", "Remove seLinuxOptions user/role and custom types")
+		}
+	}
+
+	return findings
+}
+
+func validBaselineSELinux(options *v1.SELinuxOptions) bool {
+	return options.User == "" && options.Role == "" && baselineAllowedSELinuxTypes[options.Type]
+}
+
+// validBaselineAppArmor reports whether an AppArmor annotation value keeps
+// the container confined; "unconfined" and unknown values do not.
+func validBaselineAppArmor(profile string) bool {
+	return profile == "" || profile == "runtime/default" || strings.HasPrefix(profile, "localhost/")
+}
+
+func (s *securityService) checkRestricted(pod *v1.Pod) []models.SecurityFinding {
+	findings := []models.SecurityFinding{}
+	restricted := func(check, container, message, remediation string) {
+		findings = append(findings, models.SecurityFinding{
+			Check:       check,
+			Level:       models.PodSecurityLevelRestricted,
+			Severity:    models.SeverityWarning,
+			Container:   container,
+			Message:     message,
+			Remediation: remediation,
+		})
+	}
+
+	for i := range pod.Spec.Volumes {
+		volume := &pod.Spec.Volumes[i]
+		source := &volume.VolumeSource
+		allowed := source.ConfigMap != nil || source.CSI != nil || source.DownwardAPI != nil ||
+			source.EmptyDir != nil || source.Ephemeral != nil || source.PersistentVolumeClaim != nil ||
+			source.Projected != nil || source.Secret != nil
+		// hostPath is already reported by the baseline check.
+		if !allowed && source.HostPath == nil {
+			restricted(checkRestrictedVolumes, "",
+				fmt.Sprintf("volume %s uses a volume type not allowed by the restricted profile", volume.Name),
+				"Use configMap, secret, emptyDir, projected, downwardAPI, csi, ephemeral or persistentVolumeClaim volumes")
+		}
+	}
+
+	podSC := pod.Spec.SecurityContext
+	podRunAsNonRoot := podSC != nil && podSC.RunAsNonRoot != nil && *podSC.RunAsNonRoot
+	podSeccompOK := podSC != nil && podSC.SeccompProfile != nil && restrictedSeccompType(podSC.SeccompProfile.Type)
+
+	if podSC != nil && podSC.RunAsUser != nil && *podSC.RunAsUser == 0 {
+		restricted(checkRunAsUser, "", "pod runs as UID 0", "Set runAsUser to a non-zero UID")
+	}
+
+	for _, container := range podContainers(pod) {
+		sc := container.securityContext
+		if sc == nil {
+			sc = &v1.SecurityContext{}
+		}
+
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			restricted(checkAllowPrivilegeEscalation, container.name,
+				"container does not set allowPrivilegeEscalation to false",
+				"Set securityContext.allowPrivilegeEscalation to false")
+		}
+
+		containerRunAsNonRoot := podRunAsNonRoot
+		if sc.RunAsNonRoot != nil {
+			containerRunAsNonRoot = *sc.RunAsNonRoot
+		}
+		if !containerRunAsNonRoot {
+			restricted(checkRunAsNonRoot, container.name,
+				"container is not required to run as non-root",
+				"Set runAsNonRoot to true on the pod or container")
+		}
+
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			restricted(checkRunAsUser, container.name, "container runs as UID 0", "Set runAsUser to a non-zero UID")
+		}
+
+		containerSeccompOK := podSeccompOK
+		if sc.SeccompProfile != nil {
+			containerSeccompOK = restrictedSeccompType(sc.SeccompProfile.Type)
+		}
+		if !containerSeccompOK {
+			restricted(checkSeccompRestricted, container.name,
+				"container does not use the RuntimeDefault or a Localhost seccomp profile",
+				"Set seccompProfile.type to RuntimeDefault on the pod or container")
+		}
+
+		droppedAll := false
+		addedDisallowed := []string{}
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Drop {
+				if capability == "ALL" {
+					droppedAll = true
+				}
+			}
+			for _, capability := range sc.Capabilities.Add {
+				if capability != "NET_BIND_SERVICE" {
+					addedDisallowed = append(addedDisallowed, string(capability))
+				}
+			}
+		}
+		if !droppedAll {
+			restricted(checkCapabilitiesRestricted, container.name,
+				"container does not drop ALL capabilities", "Add ALL to securityContext.capabilities.drop")
+		}
+		if len(addedDisallowed) > 0 {
+			restricted(checkCapabilitiesRestricted, container.name,
+				fmt.Sprintf("container adds capabilities other than NET_BIND_SERVICE: %s", strings.Join(addedDisallowed, ", ")),
+				"Only NET_BIND_SERVICE may be added under the restricted profile")
+		}
+	}
+
+	return findings
+}
+
+func restrictedSeccompType(profileType v1.SeccompProfileType) bool {
+	return profileType == v1.SeccompProfileTypeRuntimeDefault || profileType == v1.SeccompProfileTypeLocalhost
+}
+
+func (s *securityService) checkBestPractices(pod *v1.Pod) []models.SecurityFinding {
+	findings := []models.SecurityFinding{}
+
+	for _, container := range podContainers(pod) {
+		sc := container.securityContext
+		if sc == nil || sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
+			findings = append(findings, models.SecurityFinding{
+				Check:       checkReadOnlyRootFilesystem,
+				Level:       models.SecurityCheckLevelBestPractice,
+				Severity:    models.SeverityInfo,
+				Container:   container.name,
+				Message:     "container root filesystem is writable",
+				Remediation: "Set securityContext.readOnlyRootFilesystem to true and mount emptyDir volumes for writable paths",
+			})
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+func newSecurityTestNamespace(name string, labels map[string]string) *v1.Namespace {
+	return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func newPrivilegedPod(namespace, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.PodSpec{
+			HostNetwork: true,
+			Containers: []v1.Container{{
+				Name:            "agent",
+				Image:           "agent:latest",
+				SecurityContext: &v1.SecurityContext{Privileged: boolPtr(true)},
+			}},
+			Volumes: []v1.Volume{{
+				Name:         "host-root",
+				VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/"}},
+			}},
+		},
+	}
+}
+
+func newRestrictedPod(namespace, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.PodSpec{
+			SecurityContext: &v1.PodSecurityContext{
+				RunAsNonRoot:   boolPtr(true),
+				SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []v1.Container{{
+				Name:  "app",
+				Image: "app:1.0",
+				SecurityContext: &v1.SecurityContext{
+					AllowPrivilegeEscalation: boolPtr(false),
+					ReadOnlyRootFilesystem:   boolPtr(true),
+					Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}},
+				},
+			}},
+		},
+	}
+}
+
+func newDefaultPod(namespace, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "web", Image: "nginx:1.25"}},
+		},
+	}
+}
+
+func TestSecurityService_GetPodSecurityAudit(t *testing.T) {
+	namespace := newSecurityTestNamespace("apps", map[string]string{
+		"pod-security.kubernetes.io/enforce": "baseline",
+		"pod-security.kubernetes.io/warn":    "restricted",
+	})
+
+	tests := []struct {
+		name                string
+		pod                 *v1.Pod
+		expectedLevel       string
+		expectedEnforce     bool
+		expectedWarn        bool
+		expectedChecks      []string
+		expectCriticalCount int
+	}{
+		{
+			name:                "privileged pod",
+			pod:                 newPrivilegedPod("apps", "node-agent"),
+			expectedLevel:       models.PodSecurityLevelPrivileged,
+			expectedEnforce:     true,
+			expectedWarn:        true,
+			expectedChecks:      []string{checkHostNamespaces, checkHostPathVolumes, checkPrivileged},
+			expectCriticalCount: 3,
+		},
+		{
+			name:            "default pod",
+			pod:             newDefaultPod("apps", "web"),
+			expectedLevel:   models.PodSecurityLevelBaseline,
+			expectedEnforce: false,
+			expectedWarn:    true,
+			expectedChecks:  []string{checkAllowPrivilegeEscalation, checkRunAsNonRoot, checkSeccompRestricted, checkCapabilitiesRestricted, checkReadOnlyRootFilesystem},
+		},
+		{
+			name: "unsafe sysctls and unconfined AppArmor",
+			pod: func() *v1.Pod {
+				pod := newDefaultPod("apps", "tuned")
+				pod.Annotations = map[string]string{"container.apparmor.security.beta.kubernetes.io/web": "unconfined"}
+				pod.Spec.SecurityContext = &v1.PodSecurityContext{Sysctls: []v1.Sysctl{
+					{Name: "net.ipv4.tcp_syncookies", Value: "1"},
+					{Name: "kernel.msgmax", Value: "65536"},
+				}}
+				return pod
+			}(),
+			expectedLevel:       models.PodSecurityLevelPrivileged,
+			expectedEnforce:     true,
+			expectedWarn:        true,
+			expectedChecks:      []string{checkSysctls, checkAppArmor},
+			expectCriticalCount: 1,
+		},
+		{
+			name:            "restricted pod",
+			pod:             newRestrictedPod("apps", "hardened"),
+			expectedLevel:   models.PodSecurityLevelRestricted,
+			expectedEnforce: false,
+			expectedWarn:    false,
+			expectedChecks:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset(namespace, tt.pod)
+			svc := NewSecurityService(fakeClient, NewPodService(fakeClient, slog.Default()), slog.Default())
+
+			audit, err := svc.GetPodSecurityAudit(context.Background(), tt.pod.Namespace, tt.pod.Name)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedLevel, audit.Level)
+			assert.Equal(t, tt.expectedEnforce, audit.ViolatesEnforce)
+			assert.Equal(t, tt.expectedWarn, audit.ViolatesWarn)
+			assert.Equal(t, tt.expectCriticalCount, audit.CriticalCount)
+			require.NotNil(t, audit.NamespacePolicy)
+			assert.Equal(t, models.PodSecurityLevelBaseline, audit.NamespacePolicy.Enforce)
+
+			checks := []string{}
+			for _, finding := range audit.Findings {
+				checks = append(checks, finding.Check)
+			}
+			for _, check := range tt.expectedChecks {
+				assert.Contains(t, checks, check)
+			}
+			if len(tt.expectedChecks) == 0 {
+				assert.Empty(t, audit.Findings)
+			}
+		})
+	}
+}
+
+func TestSecurityService_GetPodSecurityAudit_NotFound(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	svc := NewSecurityService(fakeClient, NewPodService(fakeClient, slog.Default()), slog.Default())
+
+	_, err := svc.GetPodSecurityAudit(context.Background(), "apps", "missing")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, core.ErrPodNotFound))
+}
+
+func TestSecurityService_GetNamespaceSecurityAudit(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		newSecurityTestNamespace("apps", map[string]string{"pod-security.kubernetes.io/enforce": "baseline"}),
+		newPrivilegedPod("apps", "node-agent"),
+		newDefaultPod("apps", "web"),
+		newRestrictedPod("apps", "hardened"),
+	)
+	svc := NewSecurityService(fakeClient, NewPodService(fakeClient, slog.Default()), slog.Default())
+
+	audit, err := svc.GetNamespaceSecurityAudit(context.Background(), "apps")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, audit.TotalPods)
+	assert.Equal(t, 1, audit.PodsByLevel[models.PodSecurityLevelPrivileged])
+	assert.Equal(t, 1, audit.PodsByLevel[models.PodSecurityLevelBaseline])
+	assert.Equal(t, 1, audit.PodsByLevel[models.PodSecurityLevelRestricted])
+	assert.Equal(t, 1, audit.PodsBlockedIfEnforced[models.PodSecurityLevelBaseline])
+	assert.Equal(t, 2, audit.PodsBlockedIfEnforced[models.PodSecurityLevelRestricted])
+	assert.Equal(t, 1, audit.PodsViolatingEnforce)
+	require.Len(t, audit.Pods, 3)
+	assert.Equal(t, "node-agent", audit.Pods[0].Name)
+	assert.Equal(t, "hardened", audit.Pods[2].Name)
+}
@@ -0,0 +1,119 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Ref identifies a workload by the kind and name returned by Of.
+type Ref struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// Replicas are the desired, ready and available replicas of a workload. For
+// a Job, available replicas are its succeeded pods.
+type Replicas struct {
+	Desired   int32
+	Ready     int32
+	Available int32
+}
+
+// ListReplicas returns the replicas of the workloads of the given kinds in
+// the namespace, all namespaces when empty. Deployments, StatefulSets and
+// DaemonSets are listed, each kind at most once; Jobs and CronJobs are read
+// from jobs, a CronJob being judged by its most recent Job. Kinds that fail
+// to list are left out and their errors are joined into the returned error.
+func ListReplicas(ctx context.Context, client kubernetes.Interface, namespace string, jobs Jobs, kinds ...string) (map[Ref]Replicas, error) {
+	wanted := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		wanted[kind] = true
+	}
+
+	replicas := make(map[Ref]Replicas)
+	var errs []error
+	apps := client.AppsV1()
+
+	if wanted["Deployment"] {
+		list, err := apps.Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list deployments: %w", err))
+		} else {
+			for i := range list.Items {
+				d := &list.Items[i]
+				replicas[Ref{d.Namespace, "Deployment", d.Name}] = Replicas{
+					Desired:   int32Value(d.Spec.Replicas, 1),
+					Ready:     d.Status.ReadyReplicas,
+					Available: d.Status.AvailableReplicas,
+				}
+			}
+		}
+	}
+
+	if wanted["StatefulSet"] {
+		list, err := apps.StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list statefulsets: %w", err))
+		} else {
+			for i := range list.Items {
+				ss := &list.Items[i]
+				replicas[Ref{ss.Namespace, "StatefulSet", ss.Name}] = Replicas{
+					Desired:   int32Value(ss.Spec.Replicas, 1),
+					Ready:     ss.Status.ReadyReplicas,
+					Available: ss.Status.AvailableReplicas,
+				}
+			}
+		}
+	}
+
+	if wanted["DaemonSet"] {
+		list, err := apps.DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list daemonsets: %w", err))
+		} else {
+			for i := range list.Items {
+				ds := &list.Items[i]
+				replicas[Ref{ds.Namespace, "DaemonSet", ds.Name}] = Replicas{
+					Desired:   ds.Status.DesiredNumberScheduled,
+					Ready:     ds.Status.NumberReady,
+					Available: ds.Status.NumberAvailable,
+				}
+			}
+		}
+	}
+
+	latest := make(map[Ref]*batchv1.Job)
+	for _, job := range jobs {
+		ref := Ref{job.Namespace, "Job", job.Name}
+		if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == "CronJob" {
+			ref = Ref{job.Namespace, "CronJob", owner.Name}
+			if previous, ok := latest[ref]; ok && !job.CreationTimestamp.After(previous.CreationTimestamp.Time) {
+				continue
+			}
+			latest[ref] = job
+		}
+		if !wanted[ref.Kind] {
+			continue
+		}
+		replicas[ref] = Replicas{
+			Desired:   int32Value(job.Spec.Completions, 1),
+			Ready:     int32Value(job.Status.Ready, 0),
+			Available: job.Status.Succeeded,
+		}
+	}
+
+	return replicas, errors.Join(errs...)
+}
+
+func int32Value(v *int32, fallback int32) int32 {
+	if v == nil {
+		return fallback
+	}
+	return *v
+}
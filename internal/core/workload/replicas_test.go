@@ -0,0 +1,73 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestListReplicas(t *testing.T) {
+	replicas := int32(3)
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2, AvailableReplicas: 1},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 4, NumberReady: 4, NumberAvailable: 4},
+		},
+	)
+	client.PrependReactor("list", "statefulsets", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("forbidden")
+	})
+
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	nightly := func(name string, created time.Time, succeeded int32) batchv1.Job {
+		return batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(created),
+				OwnerReferences:   []metav1.OwnerReference{owner("CronJob", "nightly", true)},
+			},
+			Status: batchv1.JobStatus{Succeeded: succeeded},
+		}
+	}
+	jobs := NewJobs([]batchv1.Job{
+		nightly("nightly-2", created.Add(time.Hour), 0),
+		nightly("nightly-1", created, 1),
+		{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"}, Status: batchv1.JobStatus{Succeeded: 1}},
+	})
+
+	t.Run("listed kinds", func(t *testing.T) {
+		got, err := ListReplicas(context.Background(), client, "default", jobs, "Deployment", "StatefulSet", "CronJob")
+		require.Error(t, err, "the failed StatefulSet list is reported")
+
+		assert.Equal(t, map[Ref]Replicas{
+			{"default", "Deployment", "web"}: {Desired: 3, Ready: 2, Available: 1},
+			// The CronJob is judged by its latest run.
+			{"default", "CronJob", "nightly"}: {Desired: 1, Ready: 0, Available: 0},
+		}, got)
+	})
+
+	t.Run("jobs only when asked for", func(t *testing.T) {
+		got, err := ListReplicas(context.Background(), client, "default", jobs, "DaemonSet")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[Ref]Replicas{
+			{"default", "DaemonSet", "agent"}: {Desired: 4, Ready: 4, Available: 4},
+		}, got)
+	})
+}
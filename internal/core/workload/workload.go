@@ -0,0 +1,98 @@
+// Package workload resolves the top-level workload a pod belongs to, so that
+// every report groups pods under the same Deployment, CronJob or other owner,
+// and reads the replica counts those workloads are judged by.
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Jobs indexes Jobs by namespace and name so that Job pods can be attributed
+// to their CronJob. A nil Jobs leaves Job pods as Jobs.
+type Jobs map[string]*batchv1.Job
+
+// NewJobs indexes the given Jobs.
+func NewJobs(items []batchv1.Job) Jobs {
+	jobs := make(Jobs, len(items))
+	for i := range items {
+		jobs[items[i].Namespace+"/"+items[i].Name] = &items[i]
+	}
+	return jobs
+}
+
+// Get returns the Job with the given namespace and name.
+func (j Jobs) Get(namespace, name string) (*batchv1.Job, bool) {
+	job, ok := j[namespace+"/"+name]
+	return job, ok
+}
+
+// ListJobs lists the Jobs in the namespace, all namespaces when empty, when
+// any of the pods is owned by a Job. It returns nil without calling the API
+// server otherwise.
+func ListJobs(ctx context.Context, client kubernetes.Interface, namespace string, pods []corev1.Pod) (Jobs, error) {
+	if !HasJobPods(pods) {
+		return nil, nil
+	}
+
+	jobList, err := client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	return NewJobs(jobList.Items), nil
+}
+
+// Owner returns the pod's controller reference, falling back to its first
+// owner reference, or nil for a pod without owners.
+func Owner(pod *corev1.Pod) *metav1.OwnerReference {
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		return owner
+	}
+	if len(pod.OwnerReferences) > 0 {
+		return &pod.OwnerReferences[0]
+	}
+	return nil
+}
+
+// HasJobPods reports whether any of the pods is owned by a Job, that is
+// whether listing Jobs can change how the pods are attributed.
+func HasJobPods(pods []corev1.Pod) bool {
+	for i := range pods {
+		if owner := Owner(&pods[i]); owner != nil && owner.Kind == "Job" {
+			return true
+		}
+	}
+	return false
+}
+
+// Of returns the kind and name of the top-level workload that owns a pod.
+// ReplicaSets created by a Deployment are reported as the Deployment through
+// the pod-template-hash label, and Jobs as their CronJob when the Job is in
+// jobs. Pods without an owner are their own workload of kind Pod.
+func Of(pod *corev1.Pod, jobs Jobs) (string, string) {
+	owner := Owner(pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+
+	switch owner.Kind {
+	case "ReplicaSet":
+		if hash := pod.Labels["pod-template-hash"]; hash != "" && strings.HasSuffix(owner.Name, "-"+hash) {
+			return "Deployment", strings.TrimSuffix(owner.Name, "-"+hash)
+		}
+	case "Job":
+		if job, ok := jobs.Get(pod.Namespace, owner.Name); ok {
+			if cronJob := metav1.GetControllerOf(job); cronJob != nil && cronJob.Kind == "CronJob" {
+				return "CronJob", cronJob.Name
+			}
+		}
+	}
+
+	return owner.Kind, owner.Name
+}
@@ -0,0 +1,122 @@
+package workload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPod(name string, labels map[string]string, owners ...metav1.OwnerReference) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       "default",
+		Labels:          labels,
+		OwnerReferences: owners,
+	}}
+}
+
+func owner(kind, name string, controller bool) metav1.OwnerReference {
+	return metav1.OwnerReference{Kind: kind, Name: name, Controller: &controller}
+}
+
+func TestOf(t *testing.T) {
+	jobs := NewJobs([]batchv1.Job{
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:            "nightly-28123",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{owner("CronJob", "nightly", true)},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:            "nightly-28123",
+			Namespace:       "other",
+			OwnerReferences: []metav1.OwnerReference{owner("CronJob", "other-nightly", true)},
+		}},
+	})
+	hash := map[string]string{"pod-template-hash": "7d9f8"}
+
+	tests := []struct {
+		name         string
+		pod          *corev1.Pod
+		jobs         Jobs
+		expectedKind string
+		expectedName string
+	}{
+		{
+			name:         "no owner",
+			pod:          newPod("debug", nil),
+			expectedKind: "Pod",
+			expectedName: "debug",
+		},
+		{
+			name:         "deployment with hyphenated name",
+			pod:          newPod("api-gateway-7d9f8-x2x4z", hash, owner("ReplicaSet", "api-gateway-7d9f8", true)),
+			expectedKind: "Deployment",
+			expectedName: "api-gateway",
+		},
+		{
+			name:         "replicaset without pod-template-hash",
+			pod:          newPod("web-x2x4z", nil, owner("ReplicaSet", "web-7d9f8", true)),
+			expectedKind: "ReplicaSet",
+			expectedName: "web-7d9f8",
+		},
+		{
+			name:         "replicaset whose name does not end in the hash",
+			pod:          newPod("web-x2x4z", hash, owner("ReplicaSet", "web", true)),
+			expectedKind: "ReplicaSet",
+			expectedName: "web",
+		},
+		{
+			name:         "cronjob",
+			pod:          newPod("nightly-28123-x7k2p", nil, owner("Job", "nightly-28123", true)),
+			jobs:         jobs,
+			expectedKind: "CronJob",
+			expectedName: "nightly",
+		},
+		{
+			name:         "job without its jobs listed",
+			pod:          newPod("nightly-28123-x7k2p", nil, owner("Job", "nightly-28123", true)),
+			expectedKind: "Job",
+			expectedName: "nightly-28123",
+		},
+		{
+			name:         "standalone job",
+			pod:          newPod("migrate-x7k2p", nil, owner("Job", "migrate", true)),
+			jobs:         jobs,
+			expectedKind: "Job",
+			expectedName: "migrate",
+		},
+		{
+			name:         "controller wins over other owners",
+			pod:          newPod("db-0", nil, owner("ConfigMap", "db-config", false), owner("StatefulSet", "db", true)),
+			expectedKind: "StatefulSet",
+			expectedName: "db",
+		},
+		{
+			name:         "first owner without a controller",
+			pod:          newPod("agent-x2", nil, owner("DaemonSet", "agent", false)),
+			expectedKind: "DaemonSet",
+			expectedName: "agent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, name := Of(tt.pod, tt.jobs)
+			assert.Equal(t, tt.expectedKind, kind)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func TestHasJobPods(t *testing.T) {
+	assert.False(t, HasJobPods(nil))
+	assert.False(t, HasJobPods([]corev1.Pod{*newPod("debug", nil)}))
+	assert.True(t, HasJobPods([]corev1.Pod{
+		*newPod("debug", nil),
+		*newPod("migrate-x7k2p", nil, owner("Job", "migrate", true)),
+	}))
+}
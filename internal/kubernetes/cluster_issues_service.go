@@ -12,54 +12,94 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/analysispolicy"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/pagination"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
 )
 
 type clusterIssuesService struct {
 	clientset kubernetes.Interface
+	policies  *analysispolicy.Resolver
 	logger    *slog.Logger
 }
 
-func NewClusterIssuesService(clientset kubernetes.Interface, logger *slog.Logger) core.ClusterIssuesService {
+func NewClusterIssuesService(clientset kubernetes.Interface, cfg *config.Config, logger *slog.Logger) core.ClusterIssuesService {
+	logger = logger.With(slog.String("service", "cluster_issues"))
 	return &clusterIssuesService{
 		clientset: clientset,
-		logger:    logger.With(slog.String("service", "cluster_issues")),
+		policies:  analysispolicy.NewResolver(clientset, cfg, logger).WithPendingGracePeriod(cfg.ClusterPodPendingGracePeriod),
+		logger:    logger,
 	}
 }
 
-func (s *clusterIssuesService) GetClusterIssues(ctx context.Context, namespace string, severityFilter string) (*models.ClusterIssues, error) {
-	listOptions := metav1.ListOptions{}
-	if namespace != "" && namespace != "all" {
-		listOptions.FieldSelector = fmt.Sprintf("metadata.namespace=%s", namespace)
+// defaultCriticalIssuesLimit is the CriticalIssues page size when the caller
+// sets no limit.
+const defaultCriticalIssuesLimit = 20
+
+func (s *clusterIssuesService) GetClusterIssues(ctx context.Context, namespace string, severityFilter string, opts models.IssueListOptions) (*models.ClusterIssues, error) {
+	cursor, err := pagination.Resume(opts.Continue, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	listNamespace := namespace
+	if listNamespace == "all" {
+		listNamespace = metav1.NamespaceAll
 	}
 
-	pods, err := s.clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
+	podList, err := s.clientset.CoreV1().Pods(listNamespace).List(ctx, opts.PodListOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
+	pods := filterPods(podList.Items, opts, s.ownerJobs(ctx, listNamespace, podList.Items, opts))
 
 	issues := &models.ClusterIssues{
-		TotalPods:         len(pods.Items),
+		TotalPods:         len(pods),
 		IssueCategories:   make(map[string]int),
 		IssuesByNamespace: make(map[string]models.NamespaceIssues),
+		DefaultPolicy:     s.policies.Defaults(),
+		NamespacePolicies: make(map[string]models.AnalysisPolicy),
 		CalculatedAt:      time.Now(),
 	}
 
+	var policies map[string]models.AnalysisPolicy
+	if listNamespace == metav1.NamespaceAll {
+		policies = s.policies.ForAllNamespaces(ctx)
+	} else {
+		policies = map[string]models.AnalysisPolicy{namespace: s.policies.ForNamespace(ctx, namespace)}
+	}
+	for ns, policy := range policies {
+		if len(policy.Overrides) > 0 || len(policy.Errors) > 0 {
+			issues.NamespacePolicies[ns] = policy
+		}
+	}
+
 	allIssues := []models.ClusterPodIssue{}
 	issuePatterns := make(map[string]*models.IssuePattern)
 
 	cutoffTime1h := time.Now().Add(-1 * time.Hour)
 	cutoffTime24h := time.Now().Add(-24 * time.Hour)
 
-	for _, pod := range pods.Items {
-		podIssues := s.analyzePod(&pod)
+	for _, pod := range pods {
+		policy, ok := policies[pod.Namespace]
+		if !ok {
+			policy = issues.DefaultPolicy
+		}
+		podIssues := s.analyzePod(&pod, policy)
 
 		if len(podIssues) == 0 {
 			issues.HealthyPods++
 			continue
 		}
 
+		podIssues = filterByCategory(podIssues, opts.IssueType)
+		if len(podIssues) == 0 {
+			continue
+		}
+
 		issues.UnhealthyPods++
 
 		nsIssues := issues.IssuesByNamespace[pod.Namespace]
@@ -102,16 +142,16 @@ func (s *clusterIssuesService) GetClusterIssues(ctx context.Context, namespace s
 	s.calculateTopIssues(issues, allIssues)
 	s.calculateIssueVelocity(issues)
 	s.processPatterns(issues, issuePatterns)
-	s.sortCriticalIssues(issues)
+	s.pageCriticalIssues(issues, opts.Limit, cursor)
 
 	return issues, nil
 }
 
-func (s *clusterIssuesService) analyzePod(pod *corev1.Pod) []models.ClusterPodIssue {
+func (s *clusterIssuesService) analyzePod(pod *corev1.Pod, policy models.AnalysisPolicy) []models.ClusterPodIssue {
 	issues := []models.ClusterPodIssue{}
 
 	if pod.Status.Phase == corev1.PodPending {
-		issue := s.analyzePendingPod(pod)
+		issue := s.analyzePendingPod(pod, policy.PendingGracePeriod)
 		if issue != nil {
 			issues = append(issues, *issue)
 		}
@@ -146,7 +186,7 @@ func (s *clusterIssuesService) analyzePod(pod *corev1.Pod) []models.ClusterPodIs
 	}
 
 	for _, status := range pod.Status.ContainerStatuses {
-		containerIssues := s.analyzeContainerStatus(pod, &status)
+		containerIssues := s.analyzeContainerStatus(pod, &status, policy.RestartThreshold)
 		issues = append(issues, containerIssues...)
 	}
 
@@ -192,11 +232,21 @@ func (s *clusterIssuesService) analyzePod(pod *corev1.Pod) []models.ClusterPodIs
 		}
 	}
 
-	return issues
+	if len(policy.IgnoreIssueTypes) == 0 {
+		return issues
+	}
+
+	kept := []models.ClusterPodIssue{}
+	for _, issue := range issues {
+		if !policy.Ignores(issue.Category) && !policy.Ignores(issue.Reason) {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
 }
 
-func (s *clusterIssuesService) analyzePendingPod(pod *corev1.Pod) *models.ClusterPodIssue {
-	if time.Since(pod.CreationTimestamp.Time) < 30*time.Second {
+func (s *clusterIssuesService) analyzePendingPod(pod *corev1.Pod, gracePeriod time.Duration) *models.ClusterPodIssue {
+	if time.Since(pod.CreationTimestamp.Time) <= gracePeriod {
 		return nil
 	}
 
@@ -224,7 +274,7 @@ func (s *clusterIssuesService) analyzePendingPod(pod *corev1.Pod) *models.Cluste
 	return issue
 }
 
-func (s *clusterIssuesService) analyzeContainerStatus(pod *corev1.Pod, status *corev1.ContainerStatus) []models.ClusterPodIssue {
+func (s *clusterIssuesService) analyzeContainerStatus(pod *corev1.Pod, status *corev1.ContainerStatus, restartThreshold int) []models.ClusterPodIssue {
 	issues := []models.ClusterPodIssue{}
 
 	if status.State.Waiting != nil {
@@ -281,7 +331,7 @@ func (s *clusterIssuesService) analyzeContainerStatus(pod *corev1.Pod, status *c
 		issues = append(issues, issue)
 	}
 
-	if status.RestartCount > 5 && len(issues) == 0 {
+	if int(status.RestartCount) > restartThreshold && len(issues) == 0 {
 		issue := models.ClusterPodIssue{
 			PodName:       pod.Name,
 			Namespace:     pod.Namespace,
@@ -406,14 +456,86 @@ func (s *clusterIssuesService) processPatterns(issues *models.ClusterIssues, pat
 	}
 }
 
-func (s *clusterIssuesService) sortCriticalIssues(issues *models.ClusterIssues) {
-	sort.Slice(issues.CriticalIssues, func(i, j int) bool {
-		return issues.CriticalIssues[i].LastSeen.After(issues.CriticalIssues[j].LastSeen)
-	})
+// pageCriticalIssues orders critical issues most recently seen first, as of
+// the cursor's snapshot, then by namespace, pod, container and category, and
+// keeps the requested page.
+func (s *clusterIssuesService) pageCriticalIssues(issues *models.ClusterIssues, limit int, cursor pagination.Cursor) {
+	if limit == 0 {
+		limit = defaultCriticalIssuesLimit
+	}
+
+	key := func(issue models.ClusterPodIssue) string {
+		return pagination.RecencyKey(issue.LastSeen, cursor.Snapshot,
+			issue.Namespace, issue.PodName, issue.ContainerName, issue.Category, issue.Reason)
+	}
 
-	if len(issues.CriticalIssues) > 20 {
-		issues.CriticalIssues = issues.CriticalIssues[:20]
+	issues.TotalCriticalIssues = len(issues.CriticalIssues)
+	pagination.SortByKey(issues.CriticalIssues, key)
+	issues.CriticalIssues, issues.Continue = pagination.Page(issues.CriticalIssues, key, limit, cursor)
+}
+
+// ownerJobs returns the Jobs needed to match opts.OwnerName against the
+// CronJobs of Job pods. Nothing is listed without an owner filter.
+func (s *clusterIssuesService) ownerJobs(ctx context.Context, namespace string, pods []corev1.Pod, opts models.IssueListOptions) workload.Jobs {
+	if opts.OwnerName == "" {
+		return nil
 	}
+
+	jobs, err := workload.ListJobs(ctx, s.clientset, namespace, pods)
+	if err != nil {
+		s.logger.Warn("failed to list jobs for owner filter, matching Job pods by Job name",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()))
+	}
+	return jobs
+}
+
+// filterPods keeps the pods owned by opts.OwnerName and running on
+// opts.NodeName. The node is also sent as a field selector; checking it here
+// covers API servers and fakes that ignore the selector.
+func filterPods(pods []corev1.Pod, opts models.IssueListOptions, jobs workload.Jobs) []corev1.Pod {
+	if opts.OwnerName == "" && opts.NodeName == "" {
+		return pods
+	}
+
+	filtered := []corev1.Pod{}
+	for _, pod := range pods {
+		if opts.NodeName != "" && pod.Spec.NodeName != opts.NodeName {
+			continue
+		}
+		if opts.OwnerName != "" && !ownedBy(&pod, opts.OwnerName, jobs) {
+			continue
+		}
+		filtered = append(filtered, pod)
+	}
+	return filtered
+}
+
+// ownedBy reports whether the pod's direct owner or its top-level workload
+// is named ownerName.
+func ownedBy(pod *corev1.Pod, ownerName string, jobs workload.Jobs) bool {
+	owner := workload.Owner(pod)
+	if owner == nil {
+		return false
+	}
+	_, workloadName := workload.Of(pod, jobs)
+	return owner.Name == ownerName || workloadName == ownerName
+}
+
+// filterByCategory keeps the issues of category, compared case-insensitively.
+// An empty category keeps every issue.
+func filterByCategory(issues []models.ClusterPodIssue, category string) []models.ClusterPodIssue {
+	if category == "" {
+		return issues
+	}
+
+	filtered := []models.ClusterPodIssue{}
+	for _, issue := range issues {
+		if strings.EqualFold(issue.Category, category) {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered
 }
 
 func (s *clusterIssuesService) filterBySeverity(issues []models.ClusterPodIssue, severity string) []models.ClusterPodIssue {
@@ -0,0 +1,377 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
+)
+
+const (
+	// maxHealthDrillDown caps the worst workloads and pods listed.
+	maxHealthDrillDown = 10
+
+	// maxReplicaPenalty is subtracted from a workload with no ready replicas;
+	// a partially ready workload loses the missing fraction of it.
+	maxReplicaPenalty = 50
+
+	// systemPriorityThreshold is the lowest priority reserved for the
+	// system-cluster-critical and system-node-critical classes.
+	systemPriorityThreshold = 1000000000
+)
+
+// workloadAccumulator collects the pod scores of one workload.
+type workloadAccumulator struct {
+	pods       int
+	scoreSum   int
+	components map[string]int
+	priority   int32
+	worstPod   string
+	worstScore int
+}
+
+// CalculateNamespaceHealthScore aggregates the health of every running pod in
+// the namespace.
+func (s *healthScoreService) CalculateNamespaceHealthScore(ctx context.Context, namespace string) (*models.AggregateHealthScore, error) {
+	policy := s.policies.ForNamespace(ctx, namespace)
+
+	workloads, pods, err := s.scoreWorkloads(ctx, namespace, func(string) models.AnalysisPolicy { return policy })
+	if err != nil {
+		return nil, err
+	}
+
+	result := newAggregateHealthScore(workloads, pods, s.scoring.ForNamespace(namespace))
+	result.Namespace = namespace
+
+	s.logger.Info("namespace health score calculated",
+		slog.String("namespace", namespace),
+		slog.Int("score", result.OverallScore),
+		slog.Int("workloads", result.WorkloadCount),
+		slog.Int("pods", result.PodsScored))
+
+	return result, nil
+}
+
+// CalculateClusterHealthScore aggregates the health of every running pod in
+// the cluster and scores each namespace on the way.
+func (s *healthScoreService) CalculateClusterHealthScore(ctx context.Context) (*models.AggregateHealthScore, error) {
+	workloads, pods, err := s.scoreWorkloads(ctx, metav1.NamespaceAll, s.clusterPolicyFor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := newAggregateHealthScore(workloads, pods, s.scoring.Base())
+
+	byNamespace := make(map[string][]models.WorkloadHealthScore)
+	for _, workload := range workloads {
+		byNamespace[workload.Namespace] = append(byNamespace[workload.Namespace], workload)
+	}
+	result.Namespaces = []models.NamespaceHealthItem{}
+	for namespace, nsWorkloads := range byNamespace {
+		score, _ := combineWorkloadScores(nsWorkloads)
+		result.Namespaces = append(result.Namespaces, models.NamespaceHealthItem{
+			Namespace: namespace,
+			Score:     score,
+			Status:    s.scoring.ForNamespace(namespace).Status(score),
+			Workloads: len(nsWorkloads),
+		})
+	}
+	sort.Slice(result.Namespaces, func(i, j int) bool {
+		if result.Namespaces[i].Score != result.Namespaces[j].Score {
+			return result.Namespaces[i].Score < result.Namespaces[j].Score
+		}
+		return result.Namespaces[i].Namespace < result.Namespaces[j].Namespace
+	})
+
+	s.logger.Info("cluster health score calculated",
+		slog.Int("score", result.OverallScore),
+		slog.Int("namespaces", len(result.Namespaces)),
+		slog.Int("workloads", result.WorkloadCount),
+		slog.Int("pods", result.PodsScored))
+
+	return result, nil
+}
+
+// SnapshotHealthScores scores every running pod and workload in the cluster
+// for the health history.
+func (s *healthScoreService) SnapshotHealthScores(ctx context.Context) (*models.HealthScoreSnapshot, error) {
+	workloads, pods, err := s.scoreWorkloads(ctx, metav1.NamespaceAll, s.clusterPolicyFor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("health score snapshot calculated",
+		slog.Int("workloads", len(workloads)),
+		slog.Int("pods", len(pods)))
+
+	return &models.HealthScoreSnapshot{
+		Pods:         pods,
+		Workloads:    workloads,
+		CalculatedAt: time.Now(),
+	}, nil
+}
+
+// clusterPolicyFor reads the analysis policies of all namespaces at once.
+func (s *healthScoreService) clusterPolicyFor(ctx context.Context) func(string) models.AnalysisPolicy {
+	policies := s.policies.ForAllNamespaces(ctx)
+	defaults := s.policies.Defaults()
+	return func(namespace string) models.AnalysisPolicy {
+		if policy, ok := policies[namespace]; ok {
+			return policy
+		}
+		return defaults
+	}
+}
+
+// newAggregateHealthScore combines the workloads; the scoring policy labels
+// the overall score.
+func newAggregateHealthScore(workloads []models.WorkloadHealthScore, pods []models.PodHealthItem, scoring *scoringpolicy.Policy) *models.AggregateHealthScore {
+	score, components := combineWorkloadScores(workloads)
+
+	result := &models.AggregateHealthScore{
+		OverallScore:   score,
+		Status:         scoring.Status(score),
+		Components:     components,
+		PodsScored:     len(pods),
+		WorkloadCount:  len(workloads),
+		WorstWorkloads: workloads,
+		WorstPods:      pods,
+		Scoring:        scoring.Ref(),
+		CalculatedAt:   time.Now(),
+	}
+	if len(result.WorstWorkloads) > maxHealthDrillDown {
+		result.WorstWorkloads = result.WorstWorkloads[:maxHealthDrillDown]
+	}
+	if len(result.WorstPods) > maxHealthDrillDown {
+		result.WorstPods = result.WorstPods[:maxHealthDrillDown]
+	}
+	return result
+}
+
+// scoreWorkloads scores every running pod in the namespace, all namespaces
+// when empty, and groups the scores by top-level workload. Both results are
+// sorted worst first. Pod events come from one list call, so pod scores here
+// only consider the pods' own events.
+func (s *healthScoreService) scoreWorkloads(ctx context.Context, namespace string, policyFor func(string) models.AnalysisPolicy) ([]models.WorkloadHealthScore, []models.PodHealthItem, error) {
+	podList, err := s.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	eventsByPod, err := s.eventCollector.CollectPodEvents(ctx, namespace)
+	if err != nil {
+		s.logger.Warn("failed to list pod events for aggregate health score",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()))
+		eventsByPod = map[string][]models.EventInfo{}
+	}
+
+	sampler := s.newResourceSampler(ctx, namespace, podList.Items)
+
+	jobs, err := workload.ListJobs(ctx, s.clientset, namespace, podList.Items)
+	if err != nil {
+		s.logger.Warn("failed to list jobs for aggregate health score, grouping their pods by Job",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()))
+	}
+
+	accumulators := make(map[workload.Ref]*workloadAccumulator)
+	pods := []models.PodHealthItem{}
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		// Completed pods have done their job and failed ones are left
+		// behind for inspection; neither is running any more.
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		score := s.scorePod(pod, eventsByPod[pod.Namespace+"/"+pod.Name], policyFor(pod.Namespace), sampler.sample(pod))
+		pods = append(pods, models.PodHealthItem{
+			Namespace:      pod.Namespace,
+			Name:           pod.Name,
+			Score:          score.OverallScore,
+			Status:         score.Status,
+			WorstComponent: worstComponent(score.Components),
+		})
+
+		kind, name := workload.Of(pod, jobs)
+		key := workload.Ref{Namespace: pod.Namespace, Kind: kind, Name: name}
+		acc, ok := accumulators[key]
+		if !ok {
+			acc = &workloadAccumulator{components: make(map[string]int), priority: math.MinInt32, worstScore: math.MaxInt}
+			accumulators[key] = acc
+		}
+		acc.pods++
+		acc.scoreSum += score.OverallScore
+		for name, component := range score.Components {
+			acc.components[name] += component.Score
+		}
+		if priority := podPriority(pod); priority > acc.priority {
+			acc.priority = priority
+		}
+		if score.OverallScore < acc.worstScore {
+			acc.worstScore = score.OverallScore
+			acc.worstPod = pod.Name
+		}
+	}
+
+	replicas := s.workloadReplicas(ctx, namespace)
+	// Workloads that want replicas but have no running pods at all are the
+	// most broken of all and would otherwise be invisible; with no pod score
+	// they score 0.
+	for key, status := range replicas {
+		if _, ok := accumulators[key]; !ok && status.Desired > 0 {
+			accumulators[key] = &workloadAccumulator{}
+		}
+	}
+
+	workloads := make([]models.WorkloadHealthScore, 0, len(accumulators))
+	for key, acc := range accumulators {
+		score := workloadHealthScore(key, acc, replicas)
+		score.Status = s.scoring.ForNamespace(key.Namespace).Status(score.Score)
+		workloads = append(workloads, score)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		a, b := workloads[i], workloads[j]
+		if a.Score != b.Score {
+			return a.Score < b.Score
+		}
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		return a.Namespace+"/"+a.Kind+"/"+a.Name < b.Namespace+"/"+b.Kind+"/"+b.Name
+	})
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Score != pods[j].Score {
+			return pods[i].Score < pods[j].Score
+		}
+		return pods[i].Namespace+"/"+pods[i].Name < pods[j].Namespace+"/"+pods[j].Name
+	})
+
+	return workloads, pods, nil
+}
+
+func workloadHealthScore(key workload.Ref, acc *workloadAccumulator, replicas map[workload.Ref]workload.Replicas) models.WorkloadHealthScore {
+	workload := models.WorkloadHealthScore{
+		Namespace:  key.Namespace,
+		Kind:       key.Kind,
+		Name:       key.Name,
+		Pods:       acc.pods,
+		Components: map[string]int{},
+		WorstPod:   acc.worstPod,
+	}
+
+	if acc.pods > 0 {
+		workload.Priority = acc.priority
+		workload.PodScore = int(math.Round(float64(acc.scoreSum) / float64(acc.pods)))
+		for name, sum := range acc.components {
+			workload.Components[name] = int(math.Round(float64(sum) / float64(acc.pods)))
+		}
+	}
+	workload.Weight = priorityWeight(workload.Priority)
+
+	if status, ok := replicas[key]; ok {
+		workload.StatusKnown = true
+		workload.DesiredReplicas = status.Desired
+		workload.ReadyReplicas = status.Ready
+		if status.Desired > 0 && status.Ready < status.Desired {
+			missing := float64(status.Desired-status.Ready) / float64(status.Desired)
+			workload.ReplicaPenalty = int(math.Round(maxReplicaPenalty * missing))
+		}
+	}
+
+	workload.Score = max(workload.PodScore-workload.ReplicaPenalty, 0)
+	return workload
+}
+
+// combineWorkloadScores averages workload scores and their components
+// weighted by priority. Without workloads there is nothing unhealthy and the
+// score is 100.
+func combineWorkloadScores(workloads []models.WorkloadHealthScore) (int, map[string]int) {
+	components := map[string]int{}
+	if len(workloads) == 0 {
+		return 100, components
+	}
+
+	totalWeight, weightedScore := 0.0, 0.0
+	componentWeights := make(map[string]float64)
+	componentSums := make(map[string]float64)
+	for _, workload := range workloads {
+		totalWeight += workload.Weight
+		weightedScore += workload.Weight * float64(workload.Score)
+		for name, score := range workload.Components {
+			componentWeights[name] += workload.Weight
+			componentSums[name] += workload.Weight * float64(score)
+		}
+	}
+
+	for name, sum := range componentSums {
+		components[name] = int(math.Round(sum / componentWeights[name]))
+	}
+	return int(math.Round(weightedScore / totalWeight)), components
+}
+
+// priorityWeight weighs workloads by pod priority: system-critical pods count
+// three times, other positive priorities twice and negative priorities,
+// typically preemptible batch work, half.
+func priorityWeight(priority int32) float64 {
+	switch {
+	case priority >= systemPriorityThreshold:
+		return 3
+	case priority > 0:
+		return 2
+	case priority < 0:
+		return 0.5
+	default:
+		return 1
+	}
+}
+
+func podPriority(pod *corev1.Pod) int32 {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	return 0
+}
+
+// workloadReplicas returns the replicas of the Deployments, StatefulSets and
+// DaemonSets in the namespace. Jobs are left out: a finished Job has no ready
+// pods and must not be penalized for it. Kinds that cannot be listed are left
+// out and their workloads are not penalized.
+func (s *healthScoreService) workloadReplicas(ctx context.Context, namespace string) map[workload.Ref]workload.Replicas {
+	replicas, err := workload.ListReplicas(ctx, s.clientset, namespace, nil, "Deployment", "StatefulSet", "DaemonSet")
+	if err != nil {
+		s.logger.Warn("failed to list workloads for health score", slog.String("error", err.Error()))
+	}
+	return replicas
+}
+
+// worstComponent names the lowest scoring component, preferring the one
+// with the larger weight on ties.
+func worstComponent(components map[string]models.HealthComponent) string {
+	worst := ""
+	for name, component := range components {
+		if worst == "" {
+			worst = name
+			continue
+		}
+		current := components[worst]
+		if component.Score < current.Score ||
+			(component.Score == current.Score && (component.Weight > current.Weight ||
+				(component.Weight == current.Weight && name < worst))) {
+			worst = name
+		}
+	}
+	return worst
+}
@@ -0,0 +1,229 @@
+package kubernetes
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/cadvisor"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/workload"
+)
+
+func newTestHealthScoreService(t *testing.T, objects ...runtime.Object) *healthScoreService {
+	t.Helper()
+	scoring, err := scoringpolicy.NewSet(scoringpolicy.Default())
+	require.NoError(t, err)
+
+	cfg := &config.Config{PodRestartThreshold: 5}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := fake.NewSimpleClientset(objects...)
+	throttling := cadvisor.NewTracker(cadvisor.NewProxySource(client), 0, time.Minute, logger)
+	return NewHealthScoreService(client, nil, cfg, scoring, throttling, logger).(*healthScoreService)
+}
+
+func TestPriorityWeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority int32
+		expected float64
+	}{
+		{name: "system-node-critical", priority: 2000001000, expected: 3},
+		{name: "system-cluster-critical", priority: 2000000000, expected: 3},
+		{name: "lowest system priority", priority: systemPriorityThreshold, expected: 3},
+		{name: "user priority class", priority: 1000, expected: 2},
+		{name: "default priority", priority: 0, expected: 1},
+		{name: "preemptible batch", priority: -10, expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, priorityWeight(tt.priority))
+		})
+	}
+}
+
+func TestWorkloadHealthScore(t *testing.T) {
+	key := workload.Ref{Namespace: "default", Kind: "Deployment", Name: "web"}
+
+	tests := []struct {
+		name            string
+		acc             *workloadAccumulator
+		replicas        map[workload.Ref]workload.Replicas
+		expectedPod     int
+		expectedPenalty int
+		expectedScore   int
+		expectedWeight  float64
+		statusKnown     bool
+	}{
+		{
+			name:           "all replicas ready",
+			acc:            &workloadAccumulator{pods: 3, scoreSum: 270, components: map[string]int{"restarts": 300}},
+			replicas:       map[workload.Ref]workload.Replicas{key: {Desired: 3, Ready: 3}},
+			expectedPod:    90,
+			expectedScore:  90,
+			expectedWeight: 1,
+			statusKnown:    true,
+		},
+		{
+			name:            "partially unready",
+			acc:             &workloadAccumulator{pods: 4, scoreSum: 360},
+			replicas:        map[workload.Ref]workload.Replicas{key: {Desired: 4, Ready: 1}},
+			expectedPod:     90,
+			expectedPenalty: 38,
+			expectedScore:   52,
+			expectedWeight:  1,
+			statusKnown:     true,
+		},
+		{
+			name:            "fully unready",
+			acc:             &workloadAccumulator{pods: 3, scoreSum: 180},
+			replicas:        map[workload.Ref]workload.Replicas{key: {Desired: 3, Ready: 0}},
+			expectedPod:     60,
+			expectedPenalty: 50,
+			expectedScore:   10,
+			expectedWeight:  1,
+			statusKnown:     true,
+		},
+		{
+			name:            "penalty does not go below zero",
+			acc:             &workloadAccumulator{pods: 2, scoreSum: 40},
+			replicas:        map[workload.Ref]workload.Replicas{key: {Desired: 2, Ready: 0}},
+			expectedPod:     20,
+			expectedPenalty: 50,
+			expectedScore:   0,
+			expectedWeight:  1,
+			statusKnown:     true,
+		},
+		{
+			name:            "no running pods",
+			acc:             &workloadAccumulator{},
+			replicas:        map[workload.Ref]workload.Replicas{key: {Desired: 2, Ready: 0}},
+			expectedPenalty: 50,
+			expectedScore:   0,
+			expectedWeight:  1,
+			statusKnown:     true,
+		},
+		{
+			name:           "unknown replica status",
+			acc:            &workloadAccumulator{pods: 1, scoreSum: 75},
+			expectedPod:    75,
+			expectedScore:  75,
+			expectedWeight: 1,
+		},
+		{
+			name:           "system-critical pods weigh more",
+			acc:            &workloadAccumulator{pods: 1, scoreSum: 80, priority: 2000000000},
+			replicas:       map[workload.Ref]workload.Replicas{key: {Desired: 1, Ready: 1}},
+			expectedPod:    80,
+			expectedScore:  80,
+			expectedWeight: 3,
+			statusKnown:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workload := workloadHealthScore(key, tt.acc, tt.replicas)
+			assert.Equal(t, tt.expectedPod, workload.PodScore)
+			assert.Equal(t, tt.expectedPenalty, workload.ReplicaPenalty)
+			assert.Equal(t, tt.expectedScore, workload.Score)
+			assert.Equal(t, tt.expectedWeight, workload.Weight)
+			assert.Equal(t, tt.statusKnown, workload.StatusKnown)
+		})
+	}
+}
+
+func TestCombineWorkloadScores(t *testing.T) {
+	tests := []struct {
+		name               string
+		workloads          []models.WorkloadHealthScore
+		expectedScore      int
+		expectedComponents map[string]int
+	}{
+		{
+			name:               "no workloads",
+			expectedScore:      100,
+			expectedComponents: map[string]int{},
+		},
+		{
+			name: "weighted by priority",
+			workloads: []models.WorkloadHealthScore{
+				{Name: "coredns", Score: 40, Weight: 3, Components: map[string]int{"restarts": 40}},
+				{Name: "web", Score: 100, Weight: 1, Components: map[string]int{"restarts": 100, "resources": 80}},
+			},
+			expectedScore:      55,
+			expectedComponents: map[string]int{"restarts": 55, "resources": 80},
+		},
+		{
+			name: "preemptible work counts half",
+			workloads: []models.WorkloadHealthScore{
+				{Name: "batch", Score: 0, Weight: 0.5},
+				{Name: "web", Score: 90, Weight: 1},
+			},
+			expectedScore:      60,
+			expectedComponents: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, components := combineWorkloadScores(tt.workloads)
+			assert.Equal(t, tt.expectedScore, score)
+			assert.Equal(t, tt.expectedComponents, components)
+		})
+	}
+}
+
+func TestHealthScoreService_CalculateNamespaceHealthScore(t *testing.T) {
+	t.Run("empty namespace", func(t *testing.T) {
+		svc := newTestHealthScoreService(t)
+
+		result, err := svc.CalculateNamespaceHealthScore(context.Background(), "empty")
+		require.NoError(t, err)
+		assert.Equal(t, "empty", result.Namespace)
+		assert.Equal(t, 100, result.OverallScore)
+		assert.Equal(t, 0, result.WorkloadCount)
+		assert.Equal(t, 0, result.PodsScored)
+		assert.Empty(t, result.WorstWorkloads)
+	})
+
+	t.Run("deployment without running pods", func(t *testing.T) {
+		replicas, none := int32(3), int32(0)
+		svc := newTestHealthScoreService(t,
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			},
+			// Scaled to zero: nothing is missing.
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &none},
+			},
+		)
+
+		result, err := svc.CalculateNamespaceHealthScore(context.Background(), "default")
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.OverallScore)
+		require.Len(t, result.WorstWorkloads, 1)
+
+		web := result.WorstWorkloads[0]
+		assert.Equal(t, "Deployment", web.Kind)
+		assert.Equal(t, "web", web.Name)
+		assert.Equal(t, 0, web.Pods)
+		assert.Equal(t, 0, web.Score)
+		assert.Equal(t, int32(3), web.DesiredReplicas)
+		assert.Equal(t, 50, web.ReplicaPenalty)
+	})
+}
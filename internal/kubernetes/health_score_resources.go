@@ -0,0 +1,243 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/cadvisor"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
+)
+
+// resourceSample is the resource data of one pod, keyed by container name.
+// A nil map means its source could not be read.
+type resourceSample struct {
+	usage      map[string]corev1.ResourceList
+	throttling map[string]float64
+}
+
+// podResourceSample reads the usage and CPU throttling of one pod. Missing
+// metrics-server or kubelet access leaves the matching part empty.
+func (s *healthScoreService) podResourceSample(ctx context.Context, pod *corev1.Pod) resourceSample {
+	sample := resourceSample{}
+
+	if s.metricsClient != nil {
+		podMetrics, err := s.metricsClient.MetricsV1beta1().PodMetricses(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			sample.usage = containerUsage(podMetrics)
+		case apierrors.IsNotFound(err):
+			// Pods show up in metrics-server a scrape interval after they
+			// start.
+			s.logger.Debug("no metrics for pod yet",
+				slog.String("namespace", pod.Namespace),
+				slog.String("pod", pod.Name))
+		default:
+			s.logger.Warn("failed to get pod metrics, scoring resources without usage",
+				slog.String("namespace", pod.Namespace),
+				slog.String("pod", pod.Name),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	if pod.Spec.NodeName != "" {
+		if throttling := s.throttling.Throttling(pod.Spec.NodeName); throttling != nil {
+			sample.throttling = podThrottling(throttling, pod)
+		}
+	}
+
+	return sample
+}
+
+// resourceSampler reads resource data for many pods with one metrics list
+// call and the last throttling poll of each node.
+type resourceSampler struct {
+	usage      map[string]map[string]corev1.ResourceList
+	throttling map[string]map[cadvisor.ContainerKey]float64
+}
+
+func (s *healthScoreService) newResourceSampler(ctx context.Context, namespace string, pods []corev1.Pod) *resourceSampler {
+	sampler := &resourceSampler{}
+
+	if s.metricsClient != nil {
+		metricsList, err := s.metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			s.logger.Warn("failed to list pod metrics, scoring resources without usage",
+				slog.String("namespace", namespace),
+				slog.String("error", err.Error()))
+		} else {
+			sampler.usage = make(map[string]map[string]corev1.ResourceList, len(metricsList.Items))
+			for i := range metricsList.Items {
+				podMetrics := &metricsList.Items[i]
+				sampler.usage[podMetrics.Namespace+"/"+podMetrics.Name] = containerUsage(podMetrics)
+			}
+		}
+	}
+
+	sampler.throttling = make(map[string]map[cadvisor.ContainerKey]float64)
+	for i := range pods {
+		node := pods[i].Spec.NodeName
+		if node == "" || pods[i].Status.Phase == corev1.PodSucceeded || pods[i].Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if _, ok := sampler.throttling[node]; ok {
+			continue
+		}
+		if throttling := s.throttling.Throttling(node); throttling != nil {
+			sampler.throttling[node] = throttling
+		}
+	}
+
+	return sampler
+}
+
+func (r *resourceSampler) sample(pod *corev1.Pod) resourceSample {
+	sample := resourceSample{}
+	if r.usage != nil {
+		// A pod missing from the list is too new to have metrics; score
+		// it without usage rather than as using nothing.
+		sample.usage = r.usage[pod.Namespace+"/"+pod.Name]
+	}
+	if throttling, ok := r.throttling[pod.Spec.NodeName]; ok {
+		sample.throttling = podThrottling(throttling, pod)
+	}
+	return sample
+}
+
+func containerUsage(podMetrics *metricsv1beta1.PodMetrics) map[string]corev1.ResourceList {
+	usage := make(map[string]corev1.ResourceList, len(podMetrics.Containers))
+	for _, container := range podMetrics.Containers {
+		usage[container.Name] = container.Usage
+	}
+	return usage
+}
+
+func podThrottling(node map[cadvisor.ContainerKey]float64, pod *corev1.Pod) map[string]float64 {
+	throttling := make(map[string]float64)
+	for _, container := range pod.Spec.Containers {
+		key := cadvisor.ContainerKey{Namespace: pod.Namespace, Pod: pod.Name, Container: container.Name}
+		if ratio, ok := node[key]; ok {
+			throttling[container.Name] = ratio
+		}
+	}
+	return throttling
+}
+
+// calculateResourceScore grades memory usage against limits, CPU throttling
+// and recent OOM kills; the worst container sets the score. Containers
+// without a memory limit are not graded on memory.
+func (s *healthScoreService) calculateResourceScore(score *models.PodHealthScore, pod *corev1.Pod, sample resourceSample, scoring scoringpolicy.ResourceScoring) {
+	pressure := models.ResourcePressure{
+		UsageAvailable:      sample.usage != nil,
+		ThrottlingAvailable: sample.throttling != nil,
+		Containers:          []models.ContainerResourcePressure{},
+	}
+
+	limits := make(map[string]corev1.ResourceList, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		limits[container.Name] = container.Resources.Limits
+	}
+
+	resourceScore := 100
+	maxMemoryRatio, maxThrottledRatio := -1.0, -1.0
+	oomKilled := 0
+	oomCutoff := time.Now().Add(-scoring.OOMWindowDuration())
+
+	for _, status := range pod.Status.ContainerStatuses {
+		container := models.ContainerResourcePressure{Name: status.Name}
+
+		if usage, ok := sample.usage[status.Name]; ok {
+			used := usage.Memory().Value()
+			container.MemoryUsageBytes = &used
+			if limit, ok := limits[status.Name][corev1.ResourceMemory]; ok && !limit.IsZero() {
+				limitBytes := limit.Value()
+				ratio := float64(used) / float64(limitBytes)
+				container.MemoryLimitBytes = &limitBytes
+				container.MemoryLimitRatio = roundRatio(ratio)
+				maxMemoryRatio = math.Max(maxMemoryRatio, ratio)
+				resourceScore = min(resourceScore, tierScore(scoring.MemoryTiers, ratio, scoring.MemoryExceededScore))
+			}
+		}
+
+		if ratio, ok := sample.throttling[status.Name]; ok {
+			container.CPUThrottledRatio = roundRatio(ratio)
+			maxThrottledRatio = math.Max(maxThrottledRatio, ratio)
+			resourceScore = min(resourceScore, tierScore(scoring.ThrottlingTiers, ratio, scoring.ThrottlingExceededScore))
+		}
+
+		if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+			killedAt := terminated.FinishedAt.Time
+			container.LastOOMKilledAt = &killedAt
+			if scoring.OOMWindowDuration() > 0 && killedAt.After(oomCutoff) {
+				oomKilled++
+				resourceScore = min(resourceScore, scoring.OOMKilledScore)
+			}
+		}
+
+		pressure.Containers = append(pressure.Containers, container)
+	}
+
+	var findings []string
+	if maxMemoryRatio >= 0 {
+		findings = append(findings, fmt.Sprintf("memory at %.0f%% of limit", maxMemoryRatio*100))
+	}
+	if maxThrottledRatio >= 0 {
+		findings = append(findings, fmt.Sprintf("CPU throttled in %.0f%% of periods", maxThrottledRatio*100))
+	}
+	if oomKilled > 0 {
+		findings = append(findings, fmt.Sprintf("%d containers OOMKilled in last %s", oomKilled, scoring.OOMWindow))
+	}
+	if !pressure.UsageAvailable {
+		findings = append(findings, "usage metrics unavailable")
+	}
+	if len(findings) == 0 {
+		findings = append(findings, "no resource limits to grade against")
+	}
+
+	score.Details.Resources = pressure
+	score.Components["resources"] = models.HealthComponent{
+		Name:        "Resource Pressure",
+		Score:       resourceScore,
+		Weight:      scoring.Weight,
+		Status:      getComponentStatus(resourceScore),
+		Description: strings.Join(findings, ", "),
+	}
+}
+
+// capOverallScore keeps pods under heavy resource pressure from scoring
+// well overall.
+func capOverallScore(score *models.PodHealthScore, scoring scoringpolicy.ResourceScoring) {
+	resources, ok := score.Components["resources"]
+	if !ok || scoring.Weight == 0 || resources.Score >= scoring.CapBelowScore {
+		return
+	}
+	if score.OverallScore > scoring.OverallScoreCap {
+		score.OverallScore = scoring.OverallScoreCap
+		score.Details.Resources.OverallScoreCapped = true
+	}
+}
+
+// tierScore returns the score of the first tier whose max is at least value,
+// or exceeded above the last tier.
+func tierScore(tiers []scoringpolicy.Tier, value float64, exceeded int) int {
+	for _, tier := range tiers {
+		if value <= tier.Max {
+			return tier.Score
+		}
+	}
+	return exceeded
+}
+
+func roundRatio(ratio float64) *float64 {
+	rounded := math.Round(ratio*1000) / 1000
+	return &rounded
+}
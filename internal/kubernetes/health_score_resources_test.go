@@ -0,0 +1,229 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
+)
+
+// newResourceTestPod returns a pod with one container per memory limit,
+// named app, sidecar and so on; an empty limit leaves the container
+// unlimited.
+func newResourceTestPod(memoryLimits ...string) *corev1.Pod {
+	names := []string{"app", "sidecar"}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}}
+	for i, limit := range memoryLimits {
+		container := corev1.Container{Name: names[i]}
+		if limit != "" {
+			container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(limit)}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, container)
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{Name: names[i], Ready: true})
+	}
+	return pod
+}
+
+func oomKilledAt(pod *corev1.Pod, finishedAt time.Time) *corev1.Pod {
+	pod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+		Reason:     "OOMKilled",
+		ExitCode:   137,
+		FinishedAt: metav1.NewTime(finishedAt),
+	}
+	return pod
+}
+
+func memoryUsage(usage map[string]string) map[string]corev1.ResourceList {
+	lists := make(map[string]corev1.ResourceList, len(usage))
+	for name, memory := range usage {
+		lists[name] = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)}
+	}
+	return lists
+}
+
+func TestCalculateResourceScore(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name                string
+		pod                 *corev1.Pod
+		sample              resourceSample
+		expectedScore       int
+		expectedDescription string
+		expectedMemoryRatio *float64
+		lastOOMKilled       bool
+	}{
+		{
+			name:                "usage unavailable scores without penalty",
+			pod:                 newResourceTestPod("512Mi"),
+			expectedScore:       100,
+			expectedDescription: "usage metrics unavailable",
+		},
+		{
+			name:                "memory well below the limit",
+			pod:                 newResourceTestPod("512Mi"),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "256Mi"})},
+			expectedScore:       100,
+			expectedDescription: "memory at 50% of limit",
+			expectedMemoryRatio: ptrFloat(0.5),
+		},
+		{
+			name:                "memory approaching the limit",
+			pod:                 newResourceTestPod("1000Mi"),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "920Mi"})},
+			expectedScore:       40,
+			expectedDescription: "memory at 92% of limit",
+			expectedMemoryRatio: ptrFloat(0.92),
+		},
+		{
+			name:                "memory near the limit",
+			pod:                 newResourceTestPod("512Mi"),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "500Mi"})},
+			expectedScore:       10,
+			expectedDescription: "memory at 98% of limit",
+			expectedMemoryRatio: ptrFloat(0.977),
+		},
+		{
+			name:                "containers without a memory limit are not graded",
+			pod:                 newResourceTestPod(""),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "4Gi"})},
+			expectedScore:       100,
+			expectedDescription: "no resource limits to grade against",
+		},
+		{
+			name: "the worst container sets the score",
+			pod:  newResourceTestPod("1Gi", "128Mi"),
+			sample: resourceSample{
+				usage:      memoryUsage(map[string]string{"app": "256Mi", "sidecar": "120Mi"}),
+				throttling: map[string]float64{"app": 0.05},
+			},
+			expectedScore:       40,
+			expectedDescription: "memory at 94% of limit, CPU throttled in 5% of periods",
+			expectedMemoryRatio: ptrFloat(0.25),
+		},
+		{
+			name:                "CPU throttling",
+			pod:                 newResourceTestPod(""),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "64Mi"}), throttling: map[string]float64{"app": 0.3}},
+			expectedScore:       50,
+			expectedDescription: "CPU throttled in 30% of periods",
+		},
+		{
+			name:                "OOMKilled inside the window",
+			pod:                 oomKilledAt(newResourceTestPod("512Mi"), now.Add(-2*time.Hour)),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "128Mi"})},
+			expectedScore:       40,
+			expectedDescription: "memory at 25% of limit, 1 containers OOMKilled in last 24h",
+			expectedMemoryRatio: ptrFloat(0.25),
+			lastOOMKilled:       true,
+		},
+		{
+			name:                "OOMKilled outside the window",
+			pod:                 oomKilledAt(newResourceTestPod("512Mi"), now.Add(-48*time.Hour)),
+			sample:              resourceSample{usage: memoryUsage(map[string]string{"app": "128Mi"})},
+			expectedScore:       100,
+			expectedDescription: "memory at 25% of limit",
+			expectedMemoryRatio: ptrFloat(0.25),
+			lastOOMKilled:       true,
+		},
+	}
+
+	svc := &healthScoreService{}
+	scoring := scoringpolicy.Default().Components.Resources
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := &models.PodHealthScore{Components: make(map[string]models.HealthComponent)}
+			svc.calculateResourceScore(score, tt.pod, tt.sample, scoring)
+
+			component, ok := score.Components["resources"]
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedScore, component.Score)
+			assert.Equal(t, tt.expectedDescription, component.Description)
+			assert.Equal(t, tt.sample.usage != nil, score.Details.Resources.UsageAvailable)
+			assert.Equal(t, tt.sample.throttling != nil, score.Details.Resources.ThrottlingAvailable)
+
+			require.NotEmpty(t, score.Details.Resources.Containers)
+			app := score.Details.Resources.Containers[0]
+			assert.Equal(t, tt.expectedMemoryRatio, app.MemoryLimitRatio)
+			assert.Equal(t, tt.lastOOMKilled, app.LastOOMKilledAt != nil)
+		})
+	}
+}
+
+func TestCapOverallScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		resources      *int
+		weight         float64
+		overall        int
+		expectedScore  int
+		expectedCapped bool
+	}{
+		{
+			name:           "heavy pressure caps a good score",
+			resources:      ptrInt(10),
+			weight:         0.15,
+			overall:        85,
+			expectedScore:  69,
+			expectedCapped: true,
+		},
+		{
+			name:          "a score at the threshold is not capped",
+			resources:     ptrInt(40),
+			weight:        0.15,
+			overall:       85,
+			expectedScore: 85,
+		},
+		{
+			name:          "a score below the cap is kept",
+			resources:     ptrInt(10),
+			weight:        0.15,
+			overall:       50,
+			expectedScore: 50,
+		},
+		{
+			name:          "weight 0 reports without capping",
+			resources:     ptrInt(10),
+			overall:       85,
+			expectedScore: 85,
+		},
+		{
+			name:          "no resources component",
+			weight:        0.15,
+			overall:       85,
+			expectedScore: 85,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scoring := scoringpolicy.Default().Components.Resources
+			scoring.Weight = tt.weight
+
+			score := &models.PodHealthScore{OverallScore: tt.overall, Components: make(map[string]models.HealthComponent)}
+			if tt.resources != nil {
+				score.Components["resources"] = models.HealthComponent{Score: *tt.resources}
+			}
+
+			capOverallScore(score, scoring)
+			assert.Equal(t, tt.expectedScore, score.OverallScore)
+			assert.Equal(t, tt.expectedCapped, score.Details.Resources.OverallScoreCapped)
+		})
+	}
+}
+
+func ptrFloat(f float64) *float64 {
+	return &f
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
@@ -10,20 +10,39 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/analysispolicy"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/cadvisor"
 	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/relatedevents"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/scoringpolicy"
 )
 
 type healthScoreService struct {
-	clientset kubernetes.Interface
-	logger    *slog.Logger
+	clientset      kubernetes.Interface
+	metricsClient  metricsclientset.Interface
+	throttling     *cadvisor.Tracker
+	eventCollector *relatedevents.Collector
+	policies       *analysispolicy.Resolver
+	scoring        *scoringpolicy.Set
+	logger         *slog.Logger
 }
 
-func NewHealthScoreService(clientset kubernetes.Interface, logger *slog.Logger) core.HealthScoreService {
+// NewHealthScoreService scores pods with the CPU throttling the tracker last
+// polled; the caller runs the tracker.
+func NewHealthScoreService(clientset kubernetes.Interface, metricsClient metricsclientset.Interface, cfg *config.Config, scoring *scoringpolicy.Set, throttling *cadvisor.Tracker, logger *slog.Logger) core.HealthScoreService {
+	logger = logger.With(slog.String("service", "health_score"))
 	return &healthScoreService{
-		clientset: clientset,
-		logger:    logger.With(slog.String("service", "health_score")),
+		clientset:      clientset,
+		metricsClient:  metricsClient,
+		throttling:     throttling,
+		eventCollector: relatedevents.NewCollector(clientset, logger),
+		policies:       analysispolicy.NewResolver(clientset, cfg, logger),
+		scoring:        scoring,
+		logger:         logger,
 	}
 }
 
@@ -33,59 +52,73 @@ func (s *healthScoreService) CalculateHealthScore(ctx context.Context, namespace
 		return nil, fmt.Errorf("failed to get pod: %w", err)
 	}
 
-	events, err := s.getPodEvents(ctx, namespace, podName)
+	events, err := s.eventCollector.CollectForPod(ctx, pod)
 	if err != nil {
 		s.logger.Error("failed to get pod events", slog.String("error", err.Error()))
-		events = &corev1.EventList{Items: []corev1.Event{}}
+		events = []models.EventInfo{}
 	}
 
+	policy := s.policies.ForNamespace(ctx, namespace)
+	sample := s.podResourceSample(ctx, pod)
+
+	return s.scorePod(pod, events, policy, sample), nil
+}
+
+// scorePod scores one pod from its events and resource data under the
+// namespace policy, graded by the scoring policy that selects the pod.
+func (s *healthScoreService) scorePod(pod *corev1.Pod, events []models.EventInfo, policy models.AnalysisPolicy, sample resourceSample) *models.PodHealthScore {
+	scoring := s.scoring.ForPod(pod)
 	healthScore := &models.PodHealthScore{
-		PodName:      podName,
-		Namespace:    namespace,
+		PodName:      pod.Name,
+		Namespace:    pod.Namespace,
 		CalculatedAt: time.Now(),
 		Components:   make(map[string]models.HealthComponent),
 		Details:      s.extractHealthDetails(pod, events),
+		Policy:       policy,
+		Scoring:      scoring.Ref(),
 	}
 
-	s.calculateRestartScore(healthScore, pod)
-	s.calculateContainerStateScore(healthScore, pod)
-	s.calculateEventScore(healthScore, events)
-	s.calculatePodConditionScore(healthScore, pod)
-	s.calculateUptimeScore(healthScore, pod)
+	s.calculateRestartScore(healthScore, pod, policy, scoring.Components.Restarts)
+	s.calculateContainerStateScore(healthScore, pod, policy, scoring.Components.ContainerStates)
+	s.calculateEventScore(healthScore, events, scoring.Components.Events, scoring.EventWindow())
+	s.calculatePodConditionScore(healthScore, pod, policy, scoring.Components.Conditions)
+	s.calculateUptimeScore(healthScore, pod, scoring.Components.Uptime)
+	s.calculateResourceScore(healthScore, pod, sample, scoring.Components.Resources)
 
 	healthScore.OverallScore = s.calculateOverallScore(healthScore.Components)
-	healthScore.Status = healthScore.GetHealthStatus()
+	capOverallScore(healthScore, scoring.Components.Resources)
+	healthScore.Status = scoring.Status(healthScore.OverallScore)
 
-	return healthScore, nil
+	return healthScore
 }
 
-func (s *healthScoreService) calculateRestartScore(score *models.PodHealthScore, pod *corev1.Pod) {
+// calculateRestartScore grades restarts against the namespace's restart
+// threshold; with the default tiers and threshold of 5 the tiers are 2, 5, 10
+// and 20 restarts. Restarts do not count when the policy ignores HighRestarts.
+func (s *healthScoreService) calculateRestartScore(score *models.PodHealthScore, pod *corev1.Pod, policy models.AnalysisPolicy, scoring scoringpolicy.RestartScoring) {
 	totalRestarts := int32(0)
 	for _, status := range pod.Status.ContainerStatuses {
 		totalRestarts += status.RestartCount
 	}
 
-	var restartScore int
-	switch {
-	case totalRestarts == 0:
-		restartScore = 100
-	case totalRestarts <= 2:
-		restartScore = 85
-	case totalRestarts <= 5:
-		restartScore = 70
-	case totalRestarts <= 10:
-		restartScore = 50
-	case totalRestarts <= 20:
-		restartScore = 30
-	default:
-		restartScore = 10
+	ignored := policy.Ignores(string(models.PodIssueHighRestarts))
+
+	restartScore := 100
+	if totalRestarts > 0 && !ignored {
+		restartScore = scoring.ExceededScore
+		for _, tier := range scoring.Tiers {
+			if float64(totalRestarts) <= tier.Max*float64(policy.RestartThreshold) {
+				restartScore = tier.Score
+				break
+			}
+		}
 	}
 
 	podAge := time.Since(pod.CreationTimestamp.Time)
-	if podAge > 0 && totalRestarts > 0 {
+	if podAge > 0 && totalRestarts > 0 && !ignored {
 		restartsPerHour := float64(totalRestarts) / podAge.Hours()
-		if restartsPerHour > 1 {
-			restartScore = int(math.Max(float64(restartScore)*0.5, 10))
+		if restartsPerHour > scoring.RapidRestartsPerHour {
+			restartScore = int(math.Max(float64(restartScore)*scoring.RapidRestartFactor, float64(scoring.ExceededScore)))
 		}
 		score.Details.RestartFrequency = fmt.Sprintf("%.2f restarts/hour", restartsPerHour)
 	}
@@ -93,13 +126,13 @@ func (s *healthScoreService) calculateRestartScore(score *models.PodHealthScore,
 	score.Components["restarts"] = models.HealthComponent{
 		Name:        "Container Restarts",
 		Score:       restartScore,
-		Weight:      0.30,
+		Weight:      scoring.Weight,
 		Status:      getComponentStatus(restartScore),
 		Description: fmt.Sprintf("%d total restarts", totalRestarts),
 	}
 }
 
-func (s *healthScoreService) calculateContainerStateScore(score *models.PodHealthScore, pod *corev1.Pod) {
+func (s *healthScoreService) calculateContainerStateScore(score *models.PodHealthScore, pod *corev1.Pod, policy models.AnalysisPolicy, scoring scoringpolicy.ContainerStateScoring) {
 	stateScore := 100
 	unhealthyContainers := 0
 
@@ -115,22 +148,23 @@ func (s *healthScoreService) calculateContainerStateScore(score *models.PodHealt
 		} else if status.State.Waiting != nil {
 			containerHealth.State = "Waiting"
 			containerHealth.Reason = status.State.Waiting.Reason
+			if waitingReasonIgnored(status.State.Waiting.Reason, policy) {
+				score.Details.ContainerStatuses = append(score.Details.ContainerStatuses, containerHealth)
+				continue
+			}
 			unhealthyContainers++
-			switch status.State.Waiting.Reason {
-			case "CrashLoopBackOff", "Error":
-				stateScore = int(math.Min(float64(stateScore), 20))
-			case "ImagePullBackOff", "ErrImagePull":
-				stateScore = int(math.Min(float64(stateScore), 30))
-			default:
-				stateScore = int(math.Min(float64(stateScore), 50))
+			waitingScore, ok := scoring.WaitingReasons[status.State.Waiting.Reason]
+			if !ok {
+				waitingScore = scoring.WaitingScore
 			}
+			stateScore = min(stateScore, waitingScore)
 		} else if status.State.Terminated != nil {
 			containerHealth.State = "Terminated"
 			containerHealth.Reason = status.State.Terminated.Reason
 			containerHealth.ExitCode = &status.State.Terminated.ExitCode
 			unhealthyContainers++
 			if status.State.Terminated.ExitCode != 0 {
-				stateScore = int(math.Min(float64(stateScore), 40))
+				stateScore = min(stateScore, scoring.FailedScore)
 			}
 		}
 
@@ -151,25 +185,25 @@ func (s *healthScoreService) calculateContainerStateScore(score *models.PodHealt
 	score.Components["containerStates"] = models.HealthComponent{
 		Name:        "Container States",
 		Score:       stateScore,
-		Weight:      0.25,
+		Weight:      scoring.Weight,
 		Status:      getComponentStatus(stateScore),
 		Description: fmt.Sprintf("%d/%d containers healthy", len(pod.Status.ContainerStatuses)-unhealthyContainers, len(pod.Status.ContainerStatuses)),
 	}
 }
 
-func (s *healthScoreService) calculateEventScore(score *models.PodHealthScore, events *corev1.EventList) {
+func (s *healthScoreService) calculateEventScore(score *models.PodHealthScore, events []models.EventInfo, scoring scoringpolicy.EventScoring, window time.Duration) {
 	eventScore := 100
 	warningCount := 0
 	recentEvents := make(map[string]*models.EventSummary)
 
-	cutoffTime := time.Now().Add(-24 * time.Hour)
+	cutoffTime := time.Now().Add(-window)
 
-	for _, event := range events.Items {
+	for _, event := range events {
 		if event.LastTimestamp.Time.Before(cutoffTime) {
 			continue
 		}
 
-		key := fmt.Sprintf("%s:%s", event.Type, event.Reason)
+		key := fmt.Sprintf("%s:%s:%s", event.Relationship, event.Type, event.Reason)
 		if summary, exists := recentEvents[key]; exists {
 			summary.Count += event.Count
 			if event.LastTimestamp.Time.After(summary.LastSeen) {
@@ -177,26 +211,22 @@ func (s *healthScoreService) calculateEventScore(score *models.PodHealthScore, e
 			}
 		} else {
 			recentEvents[key] = &models.EventSummary{
-				Type:     event.Type,
-				Reason:   event.Reason,
-				Message:  event.Message,
-				Count:    event.Count,
-				LastSeen: event.LastTimestamp.Time,
+				Type:         event.Type,
+				Reason:       event.Reason,
+				Message:      event.Message,
+				Count:        event.Count,
+				LastSeen:     event.LastTimestamp.Time,
+				Relationship: event.Relationship,
 			}
 		}
 
 		if event.Type == corev1.EventTypeWarning {
 			warningCount++
-			switch event.Reason {
-			case "Failed", "FailedScheduling", "FailedMount":
-				eventScore = int(math.Min(float64(eventScore), 30))
-			case "BackOff", "CrashLoopBackOff":
-				eventScore = int(math.Min(float64(eventScore), 40))
-			case "Unhealthy":
-				eventScore = int(math.Min(float64(eventScore), 50))
-			default:
-				eventScore = int(math.Min(float64(eventScore), 70))
+			reasonScore, ok := scoring.Reasons[event.Reason]
+			if !ok {
+				reasonScore = scoring.WarningScore
 			}
+			eventScore = min(eventScore, reasonScore)
 		}
 	}
 
@@ -207,16 +237,24 @@ func (s *healthScoreService) calculateEventScore(score *models.PodHealthScore, e
 	score.Components["events"] = models.HealthComponent{
 		Name:        "Recent Events",
 		Score:       eventScore,
-		Weight:      0.20,
+		Weight:      scoring.Weight,
 		Status:      getComponentStatus(eventScore),
-		Description: fmt.Sprintf("%d warning events in last 24h", warningCount),
+		Description: fmt.Sprintf("%d warning events in last %s", warningCount, scoring.Window),
 	}
 }
 
-func (s *healthScoreService) calculatePodConditionScore(score *models.PodHealthScore, pod *corev1.Pod) {
+func (s *healthScoreService) calculatePodConditionScore(score *models.PodHealthScore, pod *corev1.Pod, policy models.AnalysisPolicy, scoring scoringpolicy.ConditionScoring) {
 	conditionScore := 100
 	failedConditions := 0
 
+	// A pod still inside the namespace's pending grace period, or pending in
+	// a namespace that ignores pending pods, is not penalized for conditions
+	// it has not reached yet.
+	pendingExcused := pod.Status.Phase == corev1.PodPending &&
+		(time.Since(pod.CreationTimestamp.Time) <= policy.PendingGracePeriod ||
+			policy.Ignores(string(models.PodIssuePending)) ||
+			policy.Ignores(models.IssueCategoryPending))
+
 	for _, condition := range pod.Status.Conditions {
 		condStatus := models.ConditionStatus{
 			Type:    string(condition.Type),
@@ -226,19 +264,9 @@ func (s *healthScoreService) calculatePodConditionScore(score *models.PodHealthS
 		}
 		score.Details.PodConditions = append(score.Details.PodConditions, condStatus)
 
-		if condition.Status != corev1.ConditionTrue {
-			switch condition.Type {
-			case corev1.PodReady:
-				conditionScore = int(math.Min(float64(conditionScore), 50))
-				failedConditions++
-			case corev1.PodScheduled:
-				conditionScore = int(math.Min(float64(conditionScore), 30))
-				failedConditions++
-			case corev1.ContainersReady:
-				conditionScore = int(math.Min(float64(conditionScore), 60))
-				failedConditions++
-			case corev1.PodInitialized:
-				conditionScore = int(math.Min(float64(conditionScore), 70))
+		if condition.Status != corev1.ConditionTrue && !pendingExcused {
+			if typeScore, ok := scoring.Conditions[string(condition.Type)]; ok {
+				conditionScore = min(conditionScore, typeScore)
 				failedConditions++
 			}
 		}
@@ -247,13 +275,13 @@ func (s *healthScoreService) calculatePodConditionScore(score *models.PodHealthS
 	score.Components["conditions"] = models.HealthComponent{
 		Name:        "Pod Conditions",
 		Score:       conditionScore,
-		Weight:      0.15,
+		Weight:      scoring.Weight,
 		Status:      getComponentStatus(conditionScore),
 		Description: fmt.Sprintf("%d/%d conditions healthy", len(pod.Status.Conditions)-failedConditions, len(pod.Status.Conditions)),
 	}
 }
 
-func (s *healthScoreService) calculateUptimeScore(score *models.PodHealthScore, pod *corev1.Pod) {
+func (s *healthScoreService) calculateUptimeScore(score *models.PodHealthScore, pod *corev1.Pod, scoring scoringpolicy.UptimeScoring) {
 	uptimeScore := 100
 	podAge := time.Since(pod.CreationTimestamp.Time)
 	score.Details.Uptime = formatDuration(podAge)
@@ -264,12 +292,11 @@ func (s *healthScoreService) calculateUptimeScore(score *models.PodHealthScore,
 				containerUptime := time.Since(status.State.Running.StartedAt.Time)
 				uptimeRatio := containerUptime.Seconds() / podAge.Seconds()
 
-				if uptimeRatio < 0.5 {
-					uptimeScore = int(math.Min(float64(uptimeScore), 50))
-				} else if uptimeRatio < 0.8 {
-					uptimeScore = int(math.Min(float64(uptimeScore), 70))
-				} else if uptimeRatio < 0.95 {
-					uptimeScore = int(math.Min(float64(uptimeScore), 85))
+				for _, tier := range scoring.Tiers {
+					if uptimeRatio < tier.Max {
+						uptimeScore = min(uptimeScore, tier.Score)
+						break
+					}
 				}
 			}
 
@@ -284,36 +311,17 @@ func (s *healthScoreService) calculateUptimeScore(score *models.PodHealthScore,
 	score.Components["uptime"] = models.HealthComponent{
 		Name:        "Uptime/Stability",
 		Score:       uptimeScore,
-		Weight:      0.10,
+		Weight:      scoring.Weight,
 		Status:      getComponentStatus(uptimeScore),
 		Description: fmt.Sprintf("Pod age: %s", score.Details.Uptime),
 	}
 }
 
 func (s *healthScoreService) calculateOverallScore(components map[string]models.HealthComponent) int {
-	weightedSum := 0.0
-	totalWeight := 0.0
-
-	for _, component := range components {
-		weightedSum += float64(component.Score) * component.Weight
-		totalWeight += component.Weight
-	}
-
-	if totalWeight == 0 {
-		return 0
-	}
-
-	return int(math.Round(weightedSum / totalWeight))
+	return models.WeightedScore(components)
 }
 
-func (s *healthScoreService) getPodEvents(ctx context.Context, namespace, podName string) (*corev1.EventList, error) {
-	fieldSelector := fmt.Sprintf("involvedObject.kind=Pod,involvedObject.name=%s", podName)
-	return s.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fieldSelector,
-	})
-}
-
-func (s *healthScoreService) extractHealthDetails(pod *corev1.Pod, _ *corev1.EventList) models.HealthDetails {
+func (s *healthScoreService) extractHealthDetails(pod *corev1.Pod, _ []models.EventInfo) models.HealthDetails {
 	details := models.HealthDetails{
 		RestartCount:      0,
 		ContainerStatuses: []models.ContainerHealth{},
@@ -328,19 +336,22 @@ func (s *healthScoreService) extractHealthDetails(pod *corev1.Pod, _ *corev1.Eve
 	return details
 }
 
-func getComponentStatus(score int) string {
-	switch {
-	case score >= 90:
-		return "Excellent"
-	case score >= 70:
-		return "Good"
-	case score >= 50:
-		return "Fair"
-	case score >= 30:
-		return "Poor"
-	default:
-		return "Critical"
+// waitingReasonIgnored reports whether the policy ignores the issue type a
+// container waiting reason is reported as.
+func waitingReasonIgnored(reason string, policy models.AnalysisPolicy) bool {
+	switch reason {
+	case "CrashLoopBackOff":
+		return policy.Ignores(string(models.PodIssueCrashLoop))
+	case "ImagePullBackOff", "ErrImagePull":
+		return policy.Ignores(string(models.PodIssueImagePull))
+	case "CreateContainerConfigError":
+		return policy.Ignores(models.IssueCategoryConfigError)
 	}
+	return false
+}
+
+func getComponentStatus(score int) string {
+	return models.ComponentStatus(score)
 }
 
 func formatDuration(d time.Duration) string {
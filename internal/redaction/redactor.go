@@ -0,0 +1,303 @@
+package redaction
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"unicode"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+const (
+	Marker = "[REDACTED]"
+
+	minEntropyValueLength = 20
+	hexEntropyThreshold   = 3.0
+)
+
+var (
+	ErrUnredactedDisabled = errors.New("unredacted mode is disabled")
+
+	ErrUnauthorized = errors.New("valid bearer token required for unredacted mode")
+)
+
+type Policy struct {
+	Enabled             bool
+	KeyPatterns         []string
+	AnnotationAllowlist []string
+	EntropyThreshold    float64
+	AccessToken         string
+}
+
+type Redactor struct {
+	policy Policy
+}
+
+func PolicyFromConfig(cfg *config.Config) Policy {
+	return Policy{
+		Enabled:             cfg.RedactionEnabled,
+		KeyPatterns:         cfg.RedactionKeyPatterns,
+		AnnotationAllowlist: cfg.RedactionAnnotationAllowlist,
+		EntropyThreshold:    cfg.RedactionEntropyThreshold,
+		AccessToken:         cfg.UnredactedAccessToken,
+	}
+}
+
+func New(policy Policy) (*Redactor, error) {
+	keyPatterns := make([]string, 0, len(policy.KeyPatterns))
+	for _, pattern := range policy.KeyPatterns {
+		pattern = strings.ToUpper(pattern)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid key pattern %q: %w", pattern, err)
+		}
+		keyPatterns = append(keyPatterns, pattern)
+	}
+	policy.KeyPatterns = keyPatterns
+
+	for _, pattern := range policy.AnnotationAllowlist {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid annotation allowlist pattern %q: %w", pattern, err)
+		}
+	}
+
+	return &Redactor{policy: policy}, nil
+}
+
+func (r *Redactor) Enabled() bool {
+	return r != nil && r.policy.Enabled
+}
+
+// AuthorizeUnredacted checks the bearer token of a request asking for
+// unredacted output against the configured access token.
+func (r *Redactor) AuthorizeUnredacted(req *http.Request) error {
+	if r == nil || r.policy.AccessToken == "" {
+		return ErrUnredactedDisabled
+	}
+
+	token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !found || subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(r.policy.AccessToken)) != 1 {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+func (r *Redactor) RedactPodDescription(description *models.PodDescription) {
+	if !r.Enabled() || description == nil {
+		return
+	}
+
+	redactions := []string{}
+
+	var redactedAnnotations []string
+	description.Annotations, redactedAnnotations = r.RedactAnnotations(description.Annotations)
+	for _, key := range redactedAnnotations {
+		redactions = append(redactions, fmt.Sprintf("annotations.%s", key))
+	}
+
+	for _, group := range []struct {
+		prefix     string
+		containers []models.ContainerInfo
+	}{
+		{prefix: "containers", containers: description.Containers},
+		{prefix: "initContainers", containers: description.InitContainers},
+	} {
+		for i := range group.containers {
+			container := &group.containers[i]
+			var redactedEnv []string
+			container.Environment, redactedEnv = r.RedactEnv(container.Environment)
+			for _, name := range redactedEnv {
+				redactions = append(redactions, fmt.Sprintf("%s[%s].environment.%s", group.prefix, container.Name, name))
+			}
+		}
+	}
+
+	if len(redactions) > 0 {
+		description.Redactions = redactions
+	}
+}
+
+func (r *Redactor) RedactPodComparison(comparison *models.PodComparison) {
+	if !r.Enabled() || comparison == nil {
+		return
+	}
+
+	redactions := []string{}
+	for i := range comparison.Differences {
+		difference := &comparison.Differences[i]
+		if difference.Category != models.PodDiffCategoryEnv {
+			continue
+		}
+
+		redacted := false
+		for _, value := range []*string{&difference.A, &difference.B} {
+			if *value != "" && r.ShouldRedact(difference.Field, *value) {
+				*value = Marker
+				redacted = true
+			}
+		}
+		if redacted {
+			redactions = append(redactions, fmt.Sprintf("containers[%s].environment.%s", difference.Container, difference.Field))
+		}
+	}
+
+	if len(redactions) > 0 {
+		comparison.Redactions = redactions
+	}
+}
+
+func (r *Redactor) RedactEnv(env []v1.EnvVar) ([]v1.EnvVar, []string) {
+	if !r.Enabled() || len(env) == 0 {
+		return env, nil
+	}
+
+	redacted := []string{}
+	result := make([]v1.EnvVar, len(env))
+	for i := range env {
+		result[i] = env[i]
+		if env[i].Value != "" && r.ShouldRedact(env[i].Name, env[i].Value) {
+			result[i].Value = Marker
+			redacted = append(redacted, env[i].Name)
+		}
+	}
+
+	return result, redacted
+}
+
+func (r *Redactor) RedactAnnotations(annotations map[string]string) (map[string]string, []string) {
+	if !r.Enabled() || len(annotations) == 0 {
+		return annotations, nil
+	}
+
+	redacted := []string{}
+	result := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		if r.annotationAllowed(key) {
+			result[key] = value
+			continue
+		}
+		result[key] = Marker
+		redacted = append(redacted, key)
+	}
+
+	return result, redacted
+}
+
+// ShouldRedact reports whether a key/value pair looks like a credential,
+// either by its key name or by the shape of its value.
+func (r *Redactor) ShouldRedact(key, value string) bool {
+	if !r.Enabled() {
+		return false
+	}
+
+	upperKey := strings.ToUpper(key)
+	for _, pattern := range r.policy.KeyPatterns {
+		if matched, _ := path.Match(pattern, upperKey); matched {
+			return true
+		}
+	}
+
+	return hasURLPassword(value) || r.looksLikeSecret(value)
+}
+
+func (r *Redactor) annotationAllowed(key string) bool {
+	for _, pattern := range r.policy.AnnotationAllowlist {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redactor) looksLikeSecret(value string) bool {
+	if len(value) < minEntropyValueLength || strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return false
+	}
+
+	if strings.Contains(value, "://") || isDigest(value) {
+		return false
+	}
+
+	threshold := r.policy.EntropyThreshold
+	if isHex(value) {
+		threshold = hexEntropyThreshold
+	}
+
+	return shannonEntropy(value) >= threshold
+}
+
+func hasURLPassword(value string) bool {
+	if !strings.Contains(value, "://") {
+		return false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.User == nil {
+		return false
+	}
+
+	_, hasPassword := parsed.User.Password()
+	return hasPassword
+}
+
+// digestHexLengths are the hex lengths of MD5, SHA-1 (git commits), SHA-256
+// and SHA-512 digests.
+var digestHexLengths = map[int]bool{32: true, 40: true, 64: true, 128: true}
+
+// isDigest reports whether value is shaped like a content digest or commit
+// SHA, optionally prefixed with its algorithm ("sha256:...") or an image
+// reference ("repo@sha256:..."). Such values are identifiers, not secrets,
+// even though their entropy is as high as a random token's.
+func isDigest(value string) bool {
+	if i := strings.LastIndex(value, "@"); i >= 0 {
+		value = value[i+1:]
+	}
+	if algorithm, hex, found := strings.Cut(value, ":"); found {
+		switch strings.ToLower(algorithm) {
+		case "md5", "sha1", "sha256", "sha512":
+			value = hex
+		default:
+			return false
+		}
+	}
+	return digestHexLengths[len(value)] && isHex(value)
+}
+
+func isHex(value string) bool {
+	for _, c := range value {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+func shannonEntropy(value string) float64 {
+	if value == "" {
+		return 0
+	}
+
+	frequencies := make(map[rune]int)
+	total := 0
+	for _, c := range value {
+		frequencies[c]++
+		total++
+	}
+
+	entropy := 0.0
+	for _, count := range frequencies {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
@@ -0,0 +1,161 @@
+package redaction
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/config"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+)
+
+func newTestRedactor(t *testing.T, token string) *Redactor {
+	t.Helper()
+	redactor, err := New(Policy{
+		Enabled:             true,
+		KeyPatterns:         config.DefaultRedactionKeyPatterns,
+		AnnotationAllowlist: config.DefaultRedactionAnnotationAllowlist,
+		EntropyThreshold:    4.2,
+		AccessToken:         token,
+	})
+	require.NoError(t, err)
+	return redactor
+}
+
+func TestRedactor_ShouldRedact(t *testing.T) {
+	redactor := newTestRedactor(t, "")
+
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		want  bool
+	}{
+		{name: "password key", key: "DB_PASSWORD", value: "hunter2", want: true},
+		{name: "lowercase token key", key: "github_token", value: "abc", want: true},
+		{name: "plain config", key: "LOG_LEVEL", value: "debug", want: false},
+		{name: "url without credentials", key: "UPSTREAM", value: "https://api.example.com/v1/endpoint", want: false},
+		{name: "url with credentials", key: "DATABASE_URL", value: "postgres://app:s3cr3t@db:5432/app", want: true},
+		{name: "high entropy value", key: "SIGNING", value: "q8Zk2LmN4xV7pR1tY9wB3cF6hJ0sD5gA", want: true},
+		{name: "md5 digest", key: "CHECKSUM", value: "9f86d081884c7d659a2feaa0c55ad015", want: false},
+		{name: "git commit sha", key: "BUILD_REF", value: "e83c5163316f89bfbde7d9ab23ca2e25604af290", want: false},
+		{name: "image digest", key: "IMAGE", value: "registry.example.com/app@sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", want: false},
+		{name: "odd length hex", key: "SIGNATURE", value: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822c", want: true},
+		{name: "short value", key: "REGION", value: "us-east-1", want: false},
+		{name: "sentence", key: "GREETING", value: "hello from the k8s cluster agent service", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactor.ShouldRedact(tt.key, tt.value))
+		})
+	}
+}
+
+func TestRedactor_RedactPodDescription(t *testing.T) {
+	redactor := newTestRedactor(t, "")
+
+	env := []v1.EnvVar{
+		{Name: "DB_PASSWORD", Value: "hunter2"},
+		{Name: "LOG_LEVEL", Value: "info"},
+		{Name: "API_TOKEN", ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{Key: "token"},
+		}},
+	}
+	description := &models.PodDescription{
+		Annotations: map[string]string{
+			"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"v1"}`,
+			"prometheus.io/scrape":                             "true",
+		},
+		Containers: []models.ContainerInfo{
+			{Name: "app", Environment: env},
+		},
+	}
+
+	redactor.RedactPodDescription(description)
+
+	assert.Equal(t, Marker, description.Annotations["kubectl.kubernetes.io/last-applied-configuration"])
+	assert.Equal(t, "true", description.Annotations["prometheus.io/scrape"])
+	assert.Equal(t, Marker, description.Containers[0].Environment[0].Value)
+	assert.Equal(t, "info", description.Containers[0].Environment[1].Value)
+	assert.NotNil(t, description.Containers[0].Environment[2].ValueFrom)
+	assert.Equal(t, "hunter2", env[0].Value, "source env slice must not be mutated")
+	assert.ElementsMatch(t, []string{
+		"annotations.kubectl.kubernetes.io/last-applied-configuration",
+		"containers[app].environment.DB_PASSWORD",
+	}, description.Redactions)
+}
+
+func TestRedactor_Disabled(t *testing.T) {
+	redactor, err := New(Policy{Enabled: false, KeyPatterns: []string{"*PASSWORD*"}})
+	require.NoError(t, err)
+
+	description := &models.PodDescription{
+		Containers: []models.ContainerInfo{
+			{Name: "app", Environment: []v1.EnvVar{{Name: "DB_PASSWORD", Value: "hunter2"}}},
+		},
+	}
+	redactor.RedactPodDescription(description)
+
+	assert.Equal(t, "hunter2", description.Containers[0].Environment[0].Value)
+	assert.Empty(t, description.Redactions)
+}
+
+func TestRedactor_AuthorizeUnredacted(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		want   error
+	}{
+		{name: "disabled without token", token: "", header: "Bearer anything", want: ErrUnredactedDisabled},
+		{name: "missing header", token: "s3cret", header: "", want: ErrUnauthorized},
+		{name: "wrong token", token: "s3cret", header: "Bearer nope", want: ErrUnauthorized},
+		{name: "valid token", token: "s3cret", header: "Bearer s3cret", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redactor := newTestRedactor(t, tt.token)
+			req := httptest.NewRequest("GET", "/api/v1/pods/default/app/describe?unredacted=true", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			err := redactor.AuthorizeUnredacted(req)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidPattern(t *testing.T) {
+	_, err := New(Policy{Enabled: true, KeyPatterns: []string{"[PASSWORD"}})
+	assert.Error(t, err)
+}
+
+func TestRedactor_RedactPodComparison(t *testing.T) {
+	redactor := newTestRedactor(t, "")
+
+	comparison := &models.PodComparison{
+		Differences: []models.PodDifference{
+			{Category: models.PodDiffCategoryEnv, Container: "app", Field: "DB_PASSWORD", A: "hunter2", B: "hunter3"},
+			{Category: models.PodDiffCategoryEnv, Container: "app", Field: "LOG_LEVEL", A: "info", B: "debug"},
+			{Category: models.PodDiffCategoryNodeLabels, Field: "secret-zone", A: "a", B: "b"},
+		},
+	}
+
+	redactor.RedactPodComparison(comparison)
+
+	assert.Equal(t, Marker, comparison.Differences[0].A)
+	assert.Equal(t, Marker, comparison.Differences[0].B)
+	assert.Equal(t, "debug", comparison.Differences[1].B)
+	assert.Equal(t, "a", comparison.Differences[2].A)
+	assert.Equal(t, []string{"containers[app].environment.DB_PASSWORD"}, comparison.Redactions)
+}
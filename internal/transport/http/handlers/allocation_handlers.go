@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
+)
+
+type AllocationHandlers struct {
+	allocationService core.AllocationService
+	logger            *slog.Logger
+}
+
+func NewAllocationHandlers(allocationService core.AllocationService, logger *slog.Logger) *AllocationHandlers {
+	return &AllocationHandlers{
+		allocationService: allocationService,
+		logger:            logger,
+	}
+}
+
+// GetNamespaceAllocation returns the resource allocation and estimated cost of a namespace
+// @Summary Get namespace resource allocation and cost
+// @Description Sums requests, limits, metrics-server usage and idle (requested but unused) resources of the running pods in the namespace and estimates their cost from per-vCPU-hour, per-GiB-hour and per-GPU-hour prices, with node-pool-specific prices
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param groupBy query string false "namespace (default) or label:<key>, e.g. label:app"
+// @Success 200 {object} responses.SuccessResponse[models.AllocationReport] "Namespace allocation report"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/allocation [get]
+func (h *AllocationHandlers) GetNamespaceAllocation(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	groupBy, err := parseAllocationGroupBy(r.URL.Query().Get("groupBy"))
+	if err == nil {
+		err = validateNamespace(namespace)
+	}
+	if err != nil {
+		h.logger.Warn("invalid namespace allocation request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	report, err := h.allocationService.GetAllocation(r.Context(), models.AllocationQuery{Namespace: namespace, GroupBy: groupBy})
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to calculate namespace allocation", namespace)
+		return
+	}
+
+	h.logger.Debug("namespace allocation request successful",
+		"namespace", namespace,
+		"monthly_cost", report.Total.Cost.Monthly,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(report))
+}
+
+// GetClusterAllocation returns the resource allocation and estimated cost of the cluster
+// @Summary Get cluster resource allocation and cost
+// @Description Sums requests, limits, metrics-server usage and idle resources of the running pods across all namespaces, grouped by namespace or by a pod label, and estimates their cost
+// @Tags Cluster
+// @Accept json
+// @Produce json
+// @Param groupBy query string false "namespace (default) or label:<key>, e.g. label:team"
+// @Success 200 {object} responses.SuccessResponse[models.AllocationReport] "Cluster allocation report"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /allocation [get]
+func (h *AllocationHandlers) GetClusterAllocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetReqID(r.Context())
+
+	groupBy, err := parseAllocationGroupBy(r.URL.Query().Get("groupBy"))
+	if err != nil {
+		h.logger.Warn("invalid cluster allocation request",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	report, err := h.allocationService.GetAllocation(r.Context(), models.AllocationQuery{GroupBy: groupBy})
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to calculate cluster allocation", "")
+		return
+	}
+
+	h.logger.Debug("cluster allocation request successful",
+		"group_by", groupBy,
+		"groups", len(report.Groups),
+		"monthly_cost", report.Total.Cost.Monthly,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(report))
+}
+
+func parseAllocationGroupBy(value string) (string, error) {
+	if value == "" || value == models.AllocationGroupByNamespace {
+		return models.AllocationGroupByNamespace, nil
+	}
+
+	labelKey, ok := strings.CutPrefix(value, models.AllocationGroupByLabelPrefix)
+	if !ok {
+		return "", fmt.Errorf("invalid groupBy %q: must be namespace or label:<key>", value)
+	}
+	if errs := validation.IsQualifiedName(labelKey); len(errs) > 0 {
+		return "", fmt.Errorf("invalid groupBy label %q: %s", labelKey, strings.Join(errs, "; "))
+	}
+	return value, nil
+}
+
+func (h *AllocationHandlers) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation, namespace string) {
+	requestID := middleware.GetReqID(r.Context())
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		h.logger.Warn("request timeout",
+			"operation", operation,
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteTimeout(w, "Request timeout")
+	default:
+		h.logger.Error("internal server error",
+			"operation", operation,
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteInternalError(w, "Internal server error")
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
 	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/pagination"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
 )
 
@@ -32,7 +34,15 @@ func NewClusterIssuesHandler(service core.ClusterIssuesService, logger *slog.Log
 // @Produce json
 // @Param namespace query string false "Filter by namespace (default: all)"
 // @Param severity query string false "Filter by severity (critical, warning, info)"
-// @Success 200 {object} responses.SuccessResponse{data=models.ClusterIssues} "Cluster issues dashboard"
+// @Param labelSelector query string false "Label selector for the analyzed pods, e.g. app=checkout"
+// @Param fieldSelector query string false "Field selector for the analyzed pods"
+// @Param ownerName query string false "Only analyze pods whose owner or Deployment has this name"
+// @Param nodeName query string false "Only analyze pods on this node"
+// @Param issueType query string false "Only report issues of this category, e.g. CrashLoop"
+// @Param limit query int false "Maximum critical issues per page (1-500, default: 20)"
+// @Param continue query string false "Continue token from the previous page"
+// @Success 200 {object} responses.SuccessResponse[models.ClusterIssues] "Cluster issues dashboard"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
 // @Failure 500 {object} responses.ErrorResponse "Internal server error"
 // @Router /cluster/pod-issues [get]
@@ -46,7 +56,16 @@ func (h *ClusterIssuesHandler) GetClusterIssues(w http.ResponseWriter, r *http.R
 
 	severity := r.URL.Query().Get("severity")
 
-	clusterIssues, err := h.service.GetClusterIssues(r.Context(), namespace, severity)
+	opts, err := parseIssueListOptions(r)
+	if err != nil {
+		h.logger.Warn("invalid cluster issues request",
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	clusterIssues, err := h.service.GetClusterIssues(r.Context(), namespace, severity, opts)
 	if err != nil {
 		h.handleServiceError(w, r, err, "failed to get cluster issues", namespace, severity)
 		return
@@ -64,6 +83,12 @@ func (h *ClusterIssuesHandler) handleServiceError(w http.ResponseWriter, r *http
 	requestID := middleware.GetReqID(r.Context())
 
 	switch {
+	case errors.Is(err, pagination.ErrInvalidContinue):
+		h.logger.Warn("invalid continue token",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteBadRequest(w, err)
 	case err == context.DeadlineExceeded:
 		h.logger.Warn("request timeout",
 			slog.String("operation", operation),
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
+)
+
+type HealthHistoryHandler struct {
+	service core.HealthHistoryService
+	logger  *slog.Logger
+}
+
+func NewHealthHistoryHandler(service core.HealthHistoryService, logger *slog.Logger) *HealthHistoryHandler {
+	return &HealthHistoryHandler{
+		service: service,
+		logger:  logger.With(slog.String("handler", "health_history")),
+	}
+}
+
+// GetPodHealthHistory returns the recorded health scores of a pod
+// @Summary Get pod health score history
+// @Description Returns the health scores recorded for a pod at each history interval with its trend: the least squares slope per hour and, when the score has fallen noticeably, when the decline started. History is kept after the pod is gone until it ages out of retention
+// @Tags Pods
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param podName path string true "Pod name"
+// @Param since query string false "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)"
+// @Success 200 {object} responses.SuccessResponse[models.PodHealthHistory] "Pod health score history"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "No history recorded for the pod"
+// @Failure 503 {object} responses.ErrorResponse "Health score history is disabled"
+// @Router /pods/{namespace}/{podName}/health-score/history [get]
+func (h *HealthHistoryHandler) GetPodHealthHistory(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	podName := chi.URLParam(r, "podName")
+	requestID := middleware.GetReqID(r.Context())
+
+	since, err := parseSince(r.URL.Query().Get("since"))
+	if err == nil {
+		err = validatePodParams(namespace, podName)
+	}
+	if err != nil {
+		h.logger.Warn("invalid pod health history request",
+			slog.String("namespace", namespace),
+			slog.String("pod", podName),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	history, err := h.service.GetPodHealthHistory(r.Context(), namespace, podName, since)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get pod health history", namespace)
+		return
+	}
+
+	h.logger.Debug("pod health history request successful",
+		slog.String("namespace", namespace),
+		slog.String("pod", podName),
+		slog.Int("points", len(history.Points)),
+		slog.String("request_id", requestID))
+
+	responses.WriteJSON(w, responses.Success(history))
+}
+
+// GetNamespaceHealthTrends returns the health trends of the workloads in a namespace
+// @Summary Get namespace workload health trends
+// @Description Returns the recorded health trend of every workload in the namespace, the most degrading first, with the latest score, the slope per hour and when a decline started
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param since query string false "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)"
+// @Param includePoints query bool false "Include the recorded scores of each workload (default: false)"
+// @Success 200 {object} responses.SuccessResponse[models.WorkloadHealthTrends] "Namespace workload health trends"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 503 {object} responses.ErrorResponse "Health score history is disabled"
+// @Router /namespace/{namespace}/health-score/trends [get]
+func (h *HealthHistoryHandler) GetNamespaceHealthTrends(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace health trends request",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()),
+			slog.String("request_id", middleware.GetReqID(r.Context())))
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	h.getWorkloadHealthTrends(w, r, namespace)
+}
+
+// GetClusterHealthTrends returns the health trends of the workloads in the cluster
+// @Summary Get cluster workload health trends
+// @Description Returns the recorded health trend of every workload in the cluster, the most degrading first, with the latest score, the slope per hour and when a decline started
+// @Tags Cluster
+// @Accept json
+// @Produce json
+// @Param since query string false "Only use scores recorded within this duration, e.g. 6h (default: the whole retention)"
+// @Param includePoints query bool false "Include the recorded scores of each workload (default: false)"
+// @Success 200 {object} responses.SuccessResponse[models.WorkloadHealthTrends] "Cluster workload health trends"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 503 {object} responses.ErrorResponse "Health score history is disabled"
+// @Router /cluster/health-score/trends [get]
+func (h *HealthHistoryHandler) GetClusterHealthTrends(w http.ResponseWriter, r *http.Request) {
+	h.getWorkloadHealthTrends(w, r, "")
+}
+
+func (h *HealthHistoryHandler) getWorkloadHealthTrends(w http.ResponseWriter, r *http.Request, namespace string) {
+	requestID := middleware.GetReqID(r.Context())
+
+	since, err := parseSince(r.URL.Query().Get("since"))
+	var includePoints bool
+	if err == nil {
+		includePoints, err = parseIncludePoints(r.URL.Query().Get("includePoints"))
+	}
+	if err != nil {
+		h.logger.Warn("invalid health trends request",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	trends, err := h.service.GetWorkloadHealthTrends(r.Context(), namespace, since, includePoints)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get workload health trends", namespace)
+		return
+	}
+
+	h.logger.Debug("health trends request successful",
+		slog.String("namespace", namespace),
+		slog.Int("workloads", len(trends.Workloads)),
+		slog.String("request_id", requestID))
+
+	responses.WriteJSON(w, responses.Success(trends))
+}
+
+// parseSince parses the since query parameter; empty means no limit.
+func parseSince(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	since, err := time.ParseDuration(value)
+	if err != nil || since <= 0 {
+		return 0, fmt.Errorf("invalid since value %q: must be a positive duration such as 6h", value)
+	}
+	return since, nil
+}
+
+func parseIncludePoints(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	includePoints, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid includePoints value %q: must be a boolean", value)
+	}
+	return includePoints, nil
+}
+
+func (h *HealthHistoryHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation, namespace string) {
+	requestID := middleware.GetReqID(r.Context())
+
+	switch {
+	case errors.Is(err, core.ErrHealthHistoryDisabled):
+		h.logger.Warn("health history disabled",
+			slog.String("operation", operation),
+			slog.String("namespace", namespace),
+			slog.String("request_id", requestID))
+		responses.WriteServiceUnavailable(w, "Health score history is disabled; set HEALTH_HISTORY_INTERVAL to enable it")
+	case errors.Is(err, core.ErrHealthHistoryNotFound):
+		h.logger.Warn("health history not found",
+			slog.String("operation", operation),
+			slog.String("namespace", namespace),
+			slog.String("request_id", requestID))
+		responses.WriteNotFound(w, "No health score history recorded")
+	case errors.Is(err, context.DeadlineExceeded):
+		h.logger.Warn("request timeout",
+			slog.String("operation", operation),
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteTimeout(w, "Request timeout")
+	default:
+		h.logger.Error("internal server error",
+			slog.String("operation", operation),
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteInternalError(w, "Internal server error")
+	}
+}
@@ -34,7 +34,7 @@ func NewHealthScoreHandler(service core.HealthScoreService, logger *slog.Logger)
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=models.PodHealthScore} "Pod health score with detailed analysis"
+// @Success 200 {object} responses.SuccessResponse[models.PodHealthScore] "Pod health score with detailed analysis"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -69,6 +69,69 @@ func (h *HealthScoreHandler) GetPodHealthScore(w http.ResponseWriter, r *http.Re
 	responses.WriteJSON(w, responses.Success(healthScore))
 }
 
+// GetNamespaceHealthScore aggregates pod health scores across a namespace
+// @Summary Get namespace health score
+// @Description Returns a priority-weighted health score (0-100) for the namespace built from the health scores of its running pods grouped by workload, with replica readiness penalties and the worst workloads and pods
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Success 200 {object} responses.SuccessResponse[models.AggregateHealthScore] "Namespace health score"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/health-score [get]
+func (h *HealthScoreHandler) GetNamespaceHealthScore(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace health score request",
+			slog.String("namespace", namespace),
+			slog.String("error", err.Error()),
+			slog.String("request_id", requestID))
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	healthScore, err := h.service.CalculateNamespaceHealthScore(r.Context(), namespace)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to calculate namespace health score", namespace, "")
+		return
+	}
+
+	h.logger.Debug("namespace health score request successful",
+		slog.String("namespace", namespace),
+		slog.String("request_id", requestID))
+
+	responses.WriteJSON(w, responses.Success(healthScore))
+}
+
+// GetClusterHealthScore aggregates pod health scores across the cluster
+// @Summary Get cluster health score
+// @Description Returns a priority-weighted health score (0-100) for the cluster with a score per namespace and the worst workloads and pods
+// @Tags Cluster
+// @Accept json
+// @Produce json
+// @Success 200 {object} responses.SuccessResponse[models.AggregateHealthScore] "Cluster health score"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /cluster/health-score [get]
+func (h *HealthScoreHandler) GetClusterHealthScore(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetReqID(r.Context())
+
+	healthScore, err := h.service.CalculateClusterHealthScore(r.Context())
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to calculate cluster health score", "", "")
+		return
+	}
+
+	h.logger.Debug("cluster health score request successful",
+		slog.String("request_id", requestID))
+
+	responses.WriteJSON(w, responses.Success(healthScore))
+}
+
 func (h *HealthScoreHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation, namespace, podName string) {
 	requestID := middleware.GetReqID(r.Context())
 
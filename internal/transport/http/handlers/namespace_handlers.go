@@ -2,14 +2,20 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
-	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/pagination"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
 )
 
@@ -27,12 +33,20 @@ func NewNamespaceHandlers(namespaceService core.NamespaceService, logger *slog.L
 
 // GetNamespaceErrors returns an error analysis report for all pods in a namespace
 // @Summary Get namespace error analysis
-// @Description Returns a comprehensive error analysis report for all pods in the specified namespace
+// @Description Returns a comprehensive error analysis report for the pods in the specified namespace. Pods of every owner kind are analyzed unless ownerKinds narrows them; Job and CronJob pods are judged by their Job's outcome
 // @Tags Namespace
 // @Accept json
 // @Produce json
 // @Param namespace path string true "Namespace name"
-// @Success 200 {object} responses.SuccessResponse{data=models.NamespaceErrorReport} "Namespace error analysis report"
+// @Param ownerKinds query string false "Comma-separated owner kinds to analyze, e.g. DaemonSet,Job; Pod selects pods without an owner (default: all)"
+// @Param labelSelector query string false "Label selector for the analyzed pods, e.g. app=checkout"
+// @Param fieldSelector query string false "Field selector for the analyzed pods, e.g. status.phase!=Running"
+// @Param ownerName query string false "Only analyze pods whose owner or workload has this name"
+// @Param nodeName query string false "Only analyze pods on this node"
+// @Param issueType query string false "Only report issues of this type, e.g. CrashLoopBackOff"
+// @Param limit query int false "Maximum problematic pods per page (1-500, default: all)"
+// @Param continue query string false "Continue token from the previous page"
+// @Success 200 {object} responses.SuccessResponse[models.NamespaceErrorReport] "Namespace error analysis report"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 500 {object} responses.ErrorResponse "Internal server error"
 // @Router /namespace/{namespace}/error [get]
@@ -40,7 +54,15 @@ func (h *NamespaceHandlers) GetNamespaceErrors(w http.ResponseWriter, r *http.Re
 	namespace := chi.URLParam(r, "namespace")
 	requestID := middleware.GetReqID(r.Context())
 
-	if err := validateNamespace(namespace); err != nil {
+	ownerKinds, err := parseOwnerKinds(r.URL.Query().Get("ownerKinds"))
+	var listOpts models.IssueListOptions
+	if err == nil {
+		listOpts, err = parseIssueListOptions(r)
+	}
+	if err == nil {
+		err = validateNamespace(namespace)
+	}
+	if err != nil {
 		h.logger.Warn("invalid namespace error request",
 			"namespace", namespace,
 			"error", err.Error(),
@@ -50,7 +72,17 @@ func (h *NamespaceHandlers) GetNamespaceErrors(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	report, err := h.namespaceService.GetNamespaceErrors(r.Context(), namespace)
+	opts := models.NamespaceErrorOptions{OwnerKinds: ownerKinds, IssueListOptions: listOpts}
+	report, err := h.namespaceService.GetNamespaceErrors(r.Context(), namespace, opts)
+	if errors.Is(err, pagination.ErrInvalidContinue) {
+		h.logger.Warn("invalid namespace error request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error("failed to get namespace errors",
 			"namespace", namespace,
@@ -71,6 +103,144 @@ func (h *NamespaceHandlers) GetNamespaceErrors(w http.ResponseWriter, r *http.Re
 	)
 }
 
+// GetNamespaceStartupStats returns pod startup latency percentiles per workload
+// @Summary Get namespace startup statistics
+// @Description Returns p50/p95/max startup phase durations per workload for pods in the namespace that became Ready without restarting
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Success 200 {object} responses.SuccessResponse[models.NamespaceStartupStats] "Namespace startup statistics"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/startup-stats [get]
+func (h *NamespaceHandlers) GetNamespaceStartupStats(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace startup stats request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	stats, err := h.namespaceService.GetNamespaceStartupStats(r.Context(), namespace)
+	if err != nil {
+		h.logger.Error("failed to get namespace startup stats",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteInternalError(w, "Failed to compute namespace startup statistics")
+		return
+	}
+
+	responses.WriteJSON(w, responses.Success(stats))
+
+	h.logger.Info("namespace startup statistics served",
+		"namespace", namespace,
+		"ready_pods", stats.ReadyPods,
+		"workloads", len(stats.Workloads),
+		"request_id", requestID,
+	)
+}
+
+// GetNamespaceQuotas returns ResourceQuota and LimitRange usage for a namespace
+// @Summary Get namespace quota utilization
+// @Description Returns used and hard values of every ResourceQuota with percentages, scopes and the workloads consuming the most quota, and the LimitRange minimums, maximums and defaults applied to containers
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Success 200 {object} responses.SuccessResponse[models.NamespaceQuotaReport] "Namespace quota report"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/quotas [get]
+func (h *NamespaceHandlers) GetNamespaceQuotas(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace quotas request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	report, err := h.namespaceService.GetNamespaceQuotas(r.Context(), namespace)
+	if err != nil {
+		h.logger.Error("failed to get namespace quotas",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteInternalError(w, "Failed to analyze namespace quotas")
+		return
+	}
+
+	responses.WriteJSON(w, responses.Success(report))
+
+	h.logger.Info("namespace quota report served",
+		"namespace", namespace,
+		"quotas", len(report.Quotas),
+		"limit_ranges", len(report.LimitRanges),
+		"request_id", requestID,
+	)
+}
+
+// GetNamespaceEvents returns a digest of Warning events in a namespace
+// @Summary Get namespace event digest
+// @Description Aggregates the namespace's Warning events by reason and involved object kind, with counts, affected objects and first/last seen times
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Success 200 {object} responses.SuccessResponse[models.NamespaceEventDigest] "Namespace event digest"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/events [get]
+func (h *NamespaceHandlers) GetNamespaceEvents(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace events request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	digest, err := h.namespaceService.GetNamespaceEvents(r.Context(), namespace)
+	if err != nil {
+		h.logger.Error("failed to get namespace events",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteInternalError(w, "Failed to summarize namespace events")
+		return
+	}
+
+	responses.WriteJSON(w, responses.Success(digest))
+
+	h.logger.Info("namespace event digest served",
+		"namespace", namespace,
+		"warnings", digest.TotalWarnings,
+		"groups", len(digest.Groups),
+		"request_id", requestID,
+	)
+}
+
 func validateNamespace(namespace string) error {
 	if namespace == "" {
 		return errors.New("namespace is required")
@@ -80,3 +250,59 @@ func validateNamespace(namespace string) error {
 	}
 	return nil
 }
+
+// parseOwnerKinds splits a comma-separated list of owner kinds. An empty
+// value or "all" selects every pod and is returned as nil.
+func parseOwnerKinds(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var kinds []string
+	for _, kind := range strings.Split(value, ",") {
+		kind = strings.TrimSpace(kind)
+		if kind == "" {
+			return nil, errors.New("invalid ownerKinds: empty kind")
+		}
+		if strings.EqualFold(kind, models.OwnerKindAll) {
+			return nil, nil
+		}
+		for _, c := range kind {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+				return nil, fmt.Errorf("invalid ownerKinds: %q is not a valid kind", kind)
+			}
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+func parseIssueListOptions(r *http.Request) (models.IssueListOptions, error) {
+	query := r.URL.Query()
+
+	opts := models.IssueListOptions{
+		LabelSelector: query.Get("labelSelector"),
+		FieldSelector: query.Get("fieldSelector"),
+		OwnerName:     query.Get("ownerName"),
+		NodeName:      query.Get("nodeName"),
+		IssueType:     query.Get("issueType"),
+		Continue:      query.Get("continue"),
+	}
+
+	if _, err := labels.Parse(opts.LabelSelector); err != nil {
+		return opts, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	if _, err := fields.ParseSelector(opts.FieldSelector); err != nil {
+		return opts, fmt.Errorf("invalid fieldSelector: %w", err)
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > models.MaxIssueListLimit {
+			return opts, fmt.Errorf("invalid limit %q: must be between 1 and %d", value, models.MaxIssueListLimit)
+		}
+		opts.Limit = limit
+	}
+
+	return opts, nil
+}
@@ -6,12 +6,18 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
-	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
 )
 
@@ -34,7 +40,7 @@ func NewNodeHandlers(nodeService core.NodeService, logger *slog.Logger) *NodeHan
 // @Accept json
 // @Produce json
 // @Param nodeName path string true "Node name"
-// @Success 200 {object} responses.SuccessResponse{data=models.NodeUtilization} "Node utilization metrics"
+// @Success 200 {object} responses.SuccessResponse[models.NodeUtilization] "Node utilization metrics"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Node not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -69,6 +75,471 @@ func (h *NodeHandlers) GetNodeUtilization(w http.ResponseWriter, r *http.Request
 	responses.WriteJSON(w, responses.Success(utilization))
 }
 
+// ListNodes returns the node inventory grouped by topology
+// @Summary List nodes with topology and platform details
+// @Description Lists nodes with kubelet version, container runtime, OS, architecture, taints, allocatable resources and cordon state, grouped by region, zone and node pool. Flags kubelet version skew against the control plane and pools whose nodes differ
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param labelSelector query string false "Kubernetes label selector, e.g. kubernetes.io/arch=arm64"
+// @Param poolLabel query string false "Node label that identifies the pool (default: well-known GKE, EKS, AKS and Karpenter labels)"
+// @Success 200 {object} responses.SuccessResponse[models.NodeInventory] "Node inventory"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /nodes [get]
+func (h *NodeHandlers) ListNodes(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetReqID(r.Context())
+
+	opts, err := parseNodeInventoryOptions(r)
+	if err != nil {
+		h.logger.Warn("invalid node list request",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	inventory, err := h.nodeService.ListNodes(r.Context(), opts)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to list nodes", "")
+		return
+	}
+
+	h.logger.Debug("node list request successful",
+		"total_nodes", inventory.TotalNodes,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(inventory))
+}
+
+// ListNodeUtilization returns resource utilization metrics for all nodes
+// @Summary List node utilization metrics
+// @Description Returns CPU and memory utilization for every node matching the label selector, with cluster-wide totals (requires metrics server)
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param sort query string false "Sort order: cpu, memory or name (default: cpu)"
+// @Param labelSelector query string false "Kubernetes label selector, e.g. topology.kubernetes.io/zone=us-east-1a"
+// @Param cpuAbove query number false "Only return nodes with CPU utilization above this percentage"
+// @Param memoryAbove query number false "Only return nodes with memory utilization above this percentage"
+// @Success 200 {object} responses.SuccessResponse[models.NodeUtilizationList] "Node utilization list"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 503 {object} responses.ErrorResponse "Metrics server not available"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /nodes/utilization [get]
+func (h *NodeHandlers) ListNodeUtilization(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetReqID(r.Context())
+
+	opts, err := parseNodeUtilizationListOptions(r)
+	if err != nil {
+		h.logger.Warn("invalid node utilization list request",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	list, err := h.nodeService.ListNodeUtilization(r.Context(), opts)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to list node utilization", "")
+		return
+	}
+
+	h.logger.Debug("node utilization list request successful",
+		"total_nodes", list.TotalNodes,
+		"matching_nodes", list.MatchingNodes,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(list))
+}
+
+// GetNodePods returns the resource breakdown of every pod on a node
+// @Summary Get per-pod resource breakdown for a node
+// @Description Returns requests, limits, QoS class and live usage (when pod metrics are available) for every pod on the node, top consumers first, with totals against allocatable
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param nodeName path string true "Node name"
+// @Param sort query string false "Sort order: cpu, memory or name (default: cpu)"
+// @Success 200 {object} responses.SuccessResponse[models.NodePodBreakdown] "Node pod breakdown"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Node not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /nodes/{nodeName}/pods [get]
+func (h *NodeHandlers) GetNodePods(w http.ResponseWriter, r *http.Request) {
+	nodeName := chi.URLParam(r, "nodeName")
+	requestID := middleware.GetReqID(r.Context())
+
+	sortBy, err := parseNodeSort(r.URL.Query().Get("sort"))
+	if err == nil {
+		err = validateNodeParams(nodeName)
+	}
+	if err != nil {
+		h.logger.Warn("invalid node pods request",
+			"node", nodeName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	breakdown, err := h.nodeService.GetNodePods(r.Context(), nodeName, sortBy)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get node pods", nodeName)
+		return
+	}
+
+	h.logger.Debug("node pods request successful",
+		"node", nodeName,
+		"pods", breakdown.PodCount,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(breakdown))
+}
+
+// GetNodeHealth returns a health score for a node
+// @Summary Get node health diagnostics
+// @Description Scores the node from its conditions (including node-problem-detector conditions), kubelet Lease heartbeat, recent node events and cordon state, and reports kubelet and runtime versions
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param nodeName path string true "Node name"
+// @Success 200 {object} responses.SuccessResponse[models.NodeHealth] "Node health"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Node not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /nodes/{nodeName}/health [get]
+func (h *NodeHandlers) GetNodeHealth(w http.ResponseWriter, r *http.Request) {
+	nodeName := chi.URLParam(r, "nodeName")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNodeParams(nodeName); err != nil {
+		h.logger.Warn("invalid node health request",
+			"node", nodeName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	health, err := h.nodeService.GetNodeHealth(r.Context(), nodeName)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get node health", nodeName)
+		return
+	}
+
+	h.logger.Debug("node health request successful",
+		"node", nodeName,
+		"score", health.OverallScore,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(health))
+}
+
+// GetEvictionRanking returns the order in which the kubelet would evict pods
+// @Summary Get node eviction ranking
+// @Description Ranks the pods on the node in the order the kubelet would evict them under memory or ephemeral-storage pressure (usage above request, then priority, then overshoot) and reports headroom against the kubelet's hard eviction thresholds
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param nodeName path string true "Node name"
+// @Param signal query string false "Pressure signal: memory or ephemeral-storage (default: memory)"
+// @Success 200 {object} responses.SuccessResponse[models.EvictionRanking] "Eviction ranking"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Node not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Failure 503 {object} responses.ErrorResponse "Metrics server not available"
+// @Router /nodes/{nodeName}/eviction-ranking [get]
+func (h *NodeHandlers) GetEvictionRanking(w http.ResponseWriter, r *http.Request) {
+	nodeName := chi.URLParam(r, "nodeName")
+	requestID := middleware.GetReqID(r.Context())
+
+	signal, err := parseEvictionSignal(r.URL.Query().Get("signal"))
+	if err == nil {
+		err = validateNodeParams(nodeName)
+	}
+	if err != nil {
+		h.logger.Warn("invalid eviction ranking request",
+			"node", nodeName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	ranking, err := h.nodeService.GetEvictionRanking(r.Context(), nodeName, signal)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get eviction ranking", nodeName)
+		return
+	}
+
+	h.logger.Debug("eviction ranking request successful",
+		"node", nodeName,
+		"signal", signal,
+		"pods", len(ranking.Pods),
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(ranking))
+}
+
+// SimulateNodeDrain predicts the outcome of draining a node
+// @Summary Simulate draining a node
+// @Description Reports which pods on the node are DaemonSet-managed, mirror, unmanaged or use local storage, which evictions PodDisruptionBudgets would block, and whether each evicted pod fits on another node. Nothing in the cluster is changed
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param nodeName path string true "Node name"
+// @Success 200 {object} responses.SuccessResponse[models.DrainSimulation] "Drain simulation"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Node not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /nodes/{nodeName}/drain-simulation [post]
+func (h *NodeHandlers) SimulateNodeDrain(w http.ResponseWriter, r *http.Request) {
+	nodeName := chi.URLParam(r, "nodeName")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNodeParams(nodeName); err != nil {
+		h.logger.Warn("invalid drain simulation request",
+			"node", nodeName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	simulation, err := h.nodeService.SimulateNodeDrain(r.Context(), nodeName)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to simulate node drain", nodeName)
+		return
+	}
+
+	h.logger.Debug("drain simulation request successful",
+		"node", nodeName,
+		"drainable", simulation.Drainable,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(simulation))
+}
+
+// GetCapacity reports how many more pods of a given shape fit in the cluster
+// @Summary Get cluster capacity for a pod shape
+// @Description Computes per node and in total how many additional pods with the given requests fit, taking current allocations, taints and node selectors into account, and reports fragmentation and the largest pod that fits anywhere
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param cpu query string false "CPU request per pod, e.g. 2 or 500m"
+// @Param memory query string false "Memory request per pod, e.g. 4Gi"
+// @Param gpu query integer false "nvidia.com/gpu request per pod"
+// @Param nodeSelector query string false "Node selector as comma-separated key=value pairs"
+// @Param tolerations query string false "Comma-separated tolerations as key[=value][:effect]"
+// @Success 200 {object} responses.SuccessResponse[models.CapacityReport] "Capacity report"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /capacity [get]
+func (h *NodeHandlers) GetCapacity(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetReqID(r.Context())
+
+	query, err := parseCapacityQuery(r)
+	if err != nil {
+		h.logger.Warn("invalid capacity request",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	report, err := h.nodeService.GetCapacity(r.Context(), query)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to calculate capacity", "")
+		return
+	}
+
+	h.logger.Debug("capacity request successful",
+		"total_fit", report.TotalFit,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(report))
+}
+
+func parseCapacityQuery(r *http.Request) (models.CapacityQuery, error) {
+	params := r.URL.Query()
+	query := models.CapacityQuery{Requests: v1.ResourceList{}}
+
+	quantities := []struct {
+		param    string
+		resource v1.ResourceName
+	}{
+		{"cpu", v1.ResourceCPU},
+		{"memory", v1.ResourceMemory},
+		{"gpu", "nvidia.com/gpu"},
+	}
+	for _, q := range quantities {
+		value := params.Get(q.param)
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil || quantity.Sign() <= 0 {
+			return query, fmt.Errorf("invalid %s %q: must be a positive quantity", q.param, value)
+		}
+		query.Requests[q.resource] = quantity
+	}
+	if len(query.Requests) == 0 {
+		return query, fmt.Errorf("at least one of cpu, memory or gpu is required")
+	}
+
+	if value := params.Get("nodeSelector"); value != "" {
+		selector, err := labels.ConvertSelectorToLabelsMap(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid nodeSelector: %w", err)
+		}
+		query.NodeSelector = selector
+	}
+
+	if value := params.Get("tolerations"); value != "" {
+		for _, raw := range strings.Split(value, ",") {
+			toleration, err := parseToleration(strings.TrimSpace(raw))
+			if err != nil {
+				return query, err
+			}
+			query.Tolerations = append(query.Tolerations, toleration)
+		}
+	}
+
+	return query, nil
+}
+
+// parseToleration parses key[=value][:effect]. Without a value the
+// toleration uses the Exists operator.
+func parseToleration(raw string) (v1.Toleration, error) {
+	toleration := v1.Toleration{Operator: v1.TolerationOpExists}
+
+	spec, effect, hasEffect := strings.Cut(raw, ":")
+	if hasEffect {
+		switch v1.TaintEffect(effect) {
+		case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+			toleration.Effect = v1.TaintEffect(effect)
+		default:
+			return toleration, fmt.Errorf("invalid toleration %q: unknown effect %q", raw, effect)
+		}
+	}
+
+	key, value, hasValue := strings.Cut(spec, "=")
+	if key == "" {
+		return toleration, fmt.Errorf("invalid toleration %q: key is required", raw)
+	}
+	toleration.Key = key
+	if hasValue {
+		toleration.Operator = v1.TolerationOpEqual
+		toleration.Value = value
+	}
+
+	return toleration, nil
+}
+
+func parseNodeInventoryOptions(r *http.Request) (models.NodeInventoryOptions, error) {
+	query := r.URL.Query()
+
+	opts := models.NodeInventoryOptions{
+		LabelSelector: query.Get("labelSelector"),
+		PoolLabel:     query.Get("poolLabel"),
+	}
+
+	if _, err := labels.Parse(opts.LabelSelector); err != nil {
+		return opts, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+
+	if opts.PoolLabel != "" {
+		if errs := validation.IsQualifiedName(opts.PoolLabel); len(errs) > 0 {
+			return opts, fmt.Errorf("invalid poolLabel %q: %s", opts.PoolLabel, strings.Join(errs, "; "))
+		}
+	}
+
+	return opts, nil
+}
+
+func parseNodeUtilizationListOptions(r *http.Request) (models.NodeUtilizationListOptions, error) {
+	query := r.URL.Query()
+
+	opts := models.NodeUtilizationListOptions{
+		LabelSelector: query.Get("labelSelector"),
+	}
+
+	var err error
+	if opts.SortBy, err = parseNodeSort(query.Get("sort")); err != nil {
+		return opts, err
+	}
+
+	if _, err := labels.Parse(opts.LabelSelector); err != nil {
+		return opts, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+
+	if opts.CPUAbove, err = parsePercentageParam(query.Get("cpuAbove"), "cpuAbove"); err != nil {
+		return opts, err
+	}
+	if opts.MemoryAbove, err = parsePercentageParam(query.Get("memoryAbove"), "memoryAbove"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+func parseNodeSort(value string) (string, error) {
+	switch value {
+	case "":
+		return models.NodeUtilizationSortCPU, nil
+	case models.NodeUtilizationSortCPU, models.NodeUtilizationSortMemory, models.NodeUtilizationSortName:
+		return value, nil
+	default:
+		return "", fmt.Errorf("invalid sort %q: must be one of cpu, memory, name", value)
+	}
+}
+
+func parseEvictionSignal(value string) (string, error) {
+	switch value {
+	case "":
+		return models.EvictionSignalMemory, nil
+	case models.EvictionSignalMemory, models.EvictionSignalEphemeralStorage:
+		return value, nil
+	default:
+		return "", fmt.Errorf("invalid signal %q: must be one of memory, ephemeral-storage", value)
+	}
+}
+
+func parsePercentageParam(value, param string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	percentage, err := strconv.ParseFloat(value, 64)
+	if err != nil || percentage < 0 || percentage > 100 {
+		return 0, fmt.Errorf("invalid %s %q: must be a percentage between 0 and 100", param, value)
+	}
+
+	return percentage, nil
+}
+
 func validateNodeParams(nodeName string) error {
 	if nodeName == "" {
 		return fmt.Errorf("node name is required")
@@ -6,37 +6,46 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
-	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/redaction"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
 )
 
 type PodHandlers struct {
 	podService core.PodService
+	redactor   *redaction.Redactor
 	logger     *slog.Logger
 }
 
-func NewPodHandlers(podService core.PodService, logger *slog.Logger) *PodHandlers {
+func NewPodHandlers(podService core.PodService, redactor *redaction.Redactor, logger *slog.Logger) *PodHandlers {
 	return &PodHandlers{
 		podService: podService,
+		redactor:   redactor,
 		logger:     logger,
 	}
 }
 
 // GetPodDescribe returns a full description of a pod
 // @Summary Get pod description
-// @Description Returns comprehensive pod information including status, containers, volumes, and conditions
+// @Description Returns comprehensive pod information including status, containers, volumes, and conditions.
+// @Description Credential-like environment values and non-allowlisted annotations are redacted unless unredacted=true is sent with a valid bearer token.
 // @Tags Pods
 // @Accept json
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=map[string]interface{}} "Pod description"
+// @Param unredacted query bool false "Return environment values and annotations without redaction (requires bearer token)"
+// @Success 200 {object} responses.SuccessResponse[models.PodDescription] "Pod description"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 401 {object} responses.ErrorResponse "Missing or invalid bearer token for unredacted mode"
+// @Failure 403 {object} responses.ErrorResponse "Unredacted mode is disabled"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
 // @Failure 500 {object} responses.ErrorResponse "Internal server error"
@@ -57,15 +66,33 @@ func (h *PodHandlers) GetPodDescribe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	unredacted, err := h.unredactedRequested(r)
+	if err != nil {
+		h.handleRedactionError(w, r, err, namespace, podName)
+		return
+	}
+
 	description, err := h.podService.GetPodDescription(r.Context(), namespace, podName)
 	if err != nil {
 		h.handleServiceError(w, r, err, "failed to get pod description", namespace, podName)
 		return
 	}
 
+	if unredacted {
+		h.logger.Info("serving unredacted pod description",
+			"namespace", namespace,
+			"pod", podName,
+			"remote_addr", r.RemoteAddr,
+			"request_id", requestID,
+		)
+	} else {
+		h.redactor.RedactPodDescription(description)
+	}
+
 	h.logger.Debug("pod describe request successful",
 		"namespace", namespace,
 		"pod", podName,
+		"redactions", len(description.Redactions),
 		"request_id", requestID,
 	)
 
@@ -80,7 +107,7 @@ func (h *PodHandlers) GetPodDescribe(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=models.PodScheduling} "Pod scheduling information"
+// @Success 200 {object} responses.SuccessResponse[models.PodScheduling] "Pod scheduling information"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -125,7 +152,7 @@ func (h *PodHandlers) GetPodScheduling(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=models.PodResources} "Pod resource information"
+// @Success 200 {object} responses.SuccessResponse[models.PodResources] "Pod resource information"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -170,7 +197,7 @@ func (h *PodHandlers) GetPodResources(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=models.PodFailureEvents} "Pod failure events analysis"
+// @Success 200 {object} responses.SuccessResponse[models.PodFailureEvents] "Pod failure events analysis"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -218,7 +245,7 @@ func (h *PodHandlers) GetPodFailureEvents(w http.ResponseWriter, r *http.Request
 // @Produce json
 // @Param namespace path string true "Namespace name"
 // @Param podName path string true "Pod name"
-// @Success 200 {object} responses.SuccessResponse{data=models.SchedulingExplanation} "Pod scheduling explanation"
+// @Success 200 {object} responses.SuccessResponse[models.SchedulingExplanation] "Pod scheduling explanation"
 // @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
 // @Failure 404 {object} responses.ErrorResponse "Pod not found"
 // @Failure 408 {object} responses.ErrorResponse "Request timeout"
@@ -255,6 +282,218 @@ func (h *PodHandlers) GetPodSchedulingExplanation(w http.ResponseWriter, r *http
 	responses.WriteJSON(w, responses.Success(explanation))
 }
 
+// GetPodTimeline returns a chronological timeline of a pod's lifecycle
+// @Summary Get pod lifecycle timeline
+// @Description Merges pod creation, condition transitions, container start/termination times, image pulls, probe failures and kills into one chronological list with the gap between steps
+// @Tags Pods
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param podName path string true "Pod name"
+// @Success 200 {object} responses.SuccessResponse[models.PodTimeline] "Pod timeline"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Pod not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /pods/{namespace}/{podName}/timeline [get]
+func (h *PodHandlers) GetPodTimeline(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	podName := chi.URLParam(r, "podName")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validatePodParams(namespace, podName); err != nil {
+		h.logger.Warn("invalid pod timeline request",
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	timeline, err := h.podService.GetPodTimeline(r.Context(), namespace, podName)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get pod timeline", namespace, podName)
+		return
+	}
+
+	h.logger.Debug("pod timeline request successful",
+		"namespace", namespace,
+		"pod", podName,
+		"entries", len(timeline.Entries),
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(timeline))
+}
+
+// GetPodStartupLatency returns the startup phase breakdown of a pod
+// @Summary Get pod startup latency
+// @Description Breaks the time from creation to Ready into scheduling, image pull, init container and app readiness phases using pod conditions and Scheduled/Pulling/Pulled events
+// @Tags Pods
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param podName path string true "Pod name"
+// @Success 200 {object} responses.SuccessResponse[models.PodStartupLatency] "Pod startup latency"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Pod not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /pods/{namespace}/{podName}/startup [get]
+func (h *PodHandlers) GetPodStartupLatency(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	podName := chi.URLParam(r, "podName")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validatePodParams(namespace, podName); err != nil {
+		h.logger.Warn("invalid pod startup latency request",
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	latency, err := h.podService.GetPodStartupLatency(r.Context(), namespace, podName)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to get pod startup latency", namespace, podName)
+		return
+	}
+
+	h.logger.Debug("pod startup latency request successful",
+		"namespace", namespace,
+		"pod", podName,
+		"bottleneck", latency.Bottleneck,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(latency))
+}
+
+// ComparePods returns a structured diff of two pods
+// @Summary Compare two pods
+// @Description Diffs images and digests, env, resources, node, node labels and taints, volumes, QoS, owner revision and container states of two pods.
+// @Description Noisy fields such as UIDs, timestamps and IPs are ignored. Credential-like env values are redacted unless unredacted=true is sent with a valid bearer token.
+// @Tags Pods
+// @Accept json
+// @Produce json
+// @Param a query string true "First pod as namespace/name"
+// @Param b query string true "Second pod as namespace/name"
+// @Param unredacted query bool false "Return env values without redaction (requires bearer token)"
+// @Success 200 {object} responses.SuccessResponse[models.PodComparison] "Pod comparison"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 401 {object} responses.ErrorResponse "Missing or invalid bearer token for unredacted mode"
+// @Failure 403 {object} responses.ErrorResponse "Unredacted mode is disabled"
+// @Failure 404 {object} responses.ErrorResponse "Pod not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /pods/compare [get]
+func (h *PodHandlers) ComparePods(w http.ResponseWriter, r *http.Request) {
+	rawA := r.URL.Query().Get("a")
+	rawB := r.URL.Query().Get("b")
+	requestID := middleware.GetReqID(r.Context())
+
+	podA, errA := parsePodReference("a", rawA)
+	podB, errB := parsePodReference("b", rawB)
+	if err := errors.Join(errA, errB); err != nil {
+		h.logger.Warn("invalid pod compare request",
+			"a", rawA,
+			"b", rawB,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	unredacted, err := h.unredactedRequested(r)
+	if err != nil {
+		h.handleRedactionError(w, r, err, podA.Namespace, podA.Name)
+		return
+	}
+
+	comparison, err := h.podService.ComparePods(r.Context(), podA, podB)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to compare pods", podA.Namespace, podA.Name)
+		return
+	}
+
+	if unredacted {
+		h.logger.Info("serving unredacted pod comparison",
+			"a", rawA,
+			"b", rawB,
+			"remote_addr", r.RemoteAddr,
+			"request_id", requestID,
+		)
+	} else {
+		h.redactor.RedactPodComparison(comparison)
+	}
+
+	h.logger.Debug("pod compare request successful",
+		"a", rawA,
+		"b", rawB,
+		"differences", len(comparison.Differences),
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(comparison))
+}
+
+func parsePodReference(param, value string) (models.PodReference, error) {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found {
+		return models.PodReference{}, fmt.Errorf("query parameter %s must be in the form namespace/pod", param)
+	}
+	if err := validatePodParams(namespace, name); err != nil {
+		return models.PodReference{}, fmt.Errorf("query parameter %s: %w", param, err)
+	}
+	return models.PodReference{Namespace: namespace, Name: name}, nil
+}
+
+func (h *PodHandlers) unredactedRequested(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("unredacted")
+	if value == "" {
+		return false, nil
+	}
+
+	unredacted, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid unredacted value %q: must be a boolean", value)
+	}
+	if !unredacted {
+		return false, nil
+	}
+
+	if err := h.redactor.AuthorizeUnredacted(r); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *PodHandlers) handleRedactionError(w http.ResponseWriter, r *http.Request, err error, namespace, podName string) {
+	requestID := middleware.GetReqID(r.Context())
+
+	h.logger.Warn("unredacted request rejected",
+		"namespace", namespace,
+		"pod", podName,
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+
+	switch {
+	case errors.Is(err, redaction.ErrUnredactedDisabled):
+		responses.WriteForbidden(w, "Unredacted mode is disabled")
+	case errors.Is(err, redaction.ErrUnauthorized):
+		responses.WriteUnauthorized(w, "Valid bearer token required for unredacted mode")
+	default:
+		responses.WriteBadRequest(w, err)
+	}
+}
+
 func validatePodParams(namespace, podName string) error {
 	if namespace == "" {
 		return fmt.Errorf("namespace is required")
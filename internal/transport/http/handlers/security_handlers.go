@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	_ "github.com/sumandas0/k8s-cluster-agent/internal/core/models"
+	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/responses"
+)
+
+type SecurityHandlers struct {
+	securityService core.SecurityService
+	logger          *slog.Logger
+}
+
+func NewSecurityHandlers(securityService core.SecurityService, logger *slog.Logger) *SecurityHandlers {
+	return &SecurityHandlers{
+		securityService: securityService,
+		logger:          logger,
+	}
+}
+
+// GetPodSecurityAudit returns the Pod Security Standards posture of a pod
+// @Summary Get pod security posture audit
+// @Description Evaluates the pod against the baseline and restricted Pod Security Standards and the namespace's pod-security.kubernetes.io labels
+// @Tags Pods
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Param podName path string true "Pod name"
+// @Success 200 {object} responses.SuccessResponse[models.PodSecurityAudit] "Pod security audit"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Pod not found"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /pods/{namespace}/{podName}/security [get]
+func (h *SecurityHandlers) GetPodSecurityAudit(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	podName := chi.URLParam(r, "podName")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validatePodParams(namespace, podName); err != nil {
+		h.logger.Warn("invalid pod security audit request",
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	audit, err := h.securityService.GetPodSecurityAudit(r.Context(), namespace, podName)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to audit pod security", namespace, podName)
+		return
+	}
+
+	h.logger.Debug("pod security audit request successful",
+		"namespace", namespace,
+		"pod", podName,
+		"level", audit.Level,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(audit))
+}
+
+// GetNamespaceSecurityAudit returns the Pod Security Standards posture of every pod in a namespace
+// @Summary Get namespace security posture audit
+// @Description Evaluates all pods in the namespace against the Pod Security Standards and reports how many would be blocked if baseline or restricted were enforced
+// @Tags Namespace
+// @Accept json
+// @Produce json
+// @Param namespace path string true "Namespace name"
+// @Success 200 {object} responses.SuccessResponse[models.NamespaceSecurityAudit] "Namespace security audit"
+// @Failure 400 {object} responses.ErrorResponse "Bad request - invalid parameters"
+// @Failure 408 {object} responses.ErrorResponse "Request timeout"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /namespace/{namespace}/security [get]
+func (h *SecurityHandlers) GetNamespaceSecurityAudit(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	requestID := middleware.GetReqID(r.Context())
+
+	if err := validateNamespace(namespace); err != nil {
+		h.logger.Warn("invalid namespace security audit request",
+			"namespace", namespace,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteBadRequest(w, err)
+		return
+	}
+
+	audit, err := h.securityService.GetNamespaceSecurityAudit(r.Context(), namespace)
+	if err != nil {
+		h.handleServiceError(w, r, err, "failed to audit namespace security", namespace, "")
+		return
+	}
+
+	h.logger.Debug("namespace security audit request successful",
+		"namespace", namespace,
+		"total_pods", audit.TotalPods,
+		"request_id", requestID,
+	)
+
+	responses.WriteJSON(w, responses.Success(audit))
+}
+
+func (h *SecurityHandlers) handleServiceError(w http.ResponseWriter, r *http.Request, err error, operation, namespace, podName string) {
+	requestID := middleware.GetReqID(r.Context())
+
+	switch {
+	case errors.Is(err, core.ErrPodNotFound):
+		h.logger.Warn("pod not found",
+			"operation", operation,
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteNotFound(w, "Pod not found")
+	case errors.Is(err, context.DeadlineExceeded):
+		h.logger.Warn("request timeout",
+			"operation", operation,
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteTimeout(w, "Request timeout")
+	default:
+		h.logger.Error("internal server error",
+			"operation", operation,
+			"namespace", namespace,
+			"pod", podName,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		responses.WriteInternalError(w, "Internal server error")
+	}
+}
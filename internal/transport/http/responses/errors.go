@@ -41,6 +41,14 @@ func WriteBadRequest(w http.ResponseWriter, err error) {
 	WriteError(w, http.StatusBadRequest, "BAD_REQUEST", "Invalid request", err.Error())
 }
 
+func WriteUnauthorized(w http.ResponseWriter, message string) {
+	WriteError(w, http.StatusUnauthorized, "UNAUTHORIZED", message, "")
+}
+
+func WriteForbidden(w http.ResponseWriter, message string) {
+	WriteError(w, http.StatusForbidden, "FORBIDDEN", message, "")
+}
+
 func WriteNotFound(w http.ResponseWriter, message string) {
 	WriteError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", message, "")
 }
@@ -8,12 +8,13 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 
 	"github.com/sumandas0/k8s-cluster-agent/internal/core"
+	"github.com/sumandas0/k8s-cluster-agent/internal/redaction"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/handlers"
 	customMiddleware "github.com/sumandas0/k8s-cluster-agent/internal/transport/http/middleware"
 	"github.com/sumandas0/k8s-cluster-agent/internal/transport/http/openapi"
 )
 
-func NewRouter(services *core.Services, logger *slog.Logger) chi.Router {
+func NewRouter(services *core.Services, redactor *redaction.Redactor, logger *slog.Logger) chi.Router {
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
@@ -22,27 +23,54 @@ func NewRouter(services *core.Services, logger *slog.Logger) chi.Router {
 	r.Use(customMiddleware.LoggingMiddleware(logger))
 	r.Use(customMiddleware.TimeoutMiddleware(5 * time.Second))
 
-	podHandlers := handlers.NewPodHandlers(services.Pod, logger)
+	podHandlers := handlers.NewPodHandlers(services.Pod, redactor, logger)
 	nodeHandlers := handlers.NewNodeHandlers(services.Node, logger)
 	namespaceHandlers := handlers.NewNamespaceHandlers(services.Namespace, logger)
 	healthScoreHandler := handlers.NewHealthScoreHandler(services.HealthScore, logger)
+	healthHistoryHandler := handlers.NewHealthHistoryHandler(services.HealthHistory, logger)
 	clusterIssuesHandler := handlers.NewClusterIssuesHandler(services.ClusterIssues, logger)
+	securityHandlers := handlers.NewSecurityHandlers(services.Security, logger)
+	allocationHandlers := handlers.NewAllocationHandlers(services.Allocation, logger)
 
 	r.Route("/api/v1", func(r chi.Router) {
+		r.Get("/pods/compare", podHandlers.ComparePods)
+
 		r.Route("/pods/{namespace}/{podName}", func(r chi.Router) {
 			r.Get("/describe", podHandlers.GetPodDescribe)
 			r.Get("/scheduling", podHandlers.GetPodScheduling)
 			r.Get("/resources", podHandlers.GetPodResources)
 			r.Get("/failure-events", podHandlers.GetPodFailureEvents)
 			r.Get("/scheduling/explain", podHandlers.GetPodSchedulingExplanation)
+			r.Get("/timeline", podHandlers.GetPodTimeline)
+			r.Get("/startup", podHandlers.GetPodStartupLatency)
 			r.Get("/health-score", healthScoreHandler.GetPodHealthScore)
+			r.Get("/health-score/history", healthHistoryHandler.GetPodHealthHistory)
+			r.Get("/security", securityHandlers.GetPodSecurityAudit)
 		})
 
+		r.Get("/nodes", nodeHandlers.ListNodes)
+		r.Get("/nodes/utilization", nodeHandlers.ListNodeUtilization)
 		r.Get("/nodes/{nodeName}/utilization", nodeHandlers.GetNodeUtilization)
+		r.Get("/nodes/{nodeName}/pods", nodeHandlers.GetNodePods)
+		r.Get("/nodes/{nodeName}/health", nodeHandlers.GetNodeHealth)
+		r.Get("/nodes/{nodeName}/eviction-ranking", nodeHandlers.GetEvictionRanking)
+		r.Post("/nodes/{nodeName}/drain-simulation", nodeHandlers.SimulateNodeDrain)
+
+		r.Get("/capacity", nodeHandlers.GetCapacity)
+		r.Get("/allocation", allocationHandlers.GetClusterAllocation)
 
 		r.Get("/namespace/{namespace}/error", namespaceHandlers.GetNamespaceErrors)
+		r.Get("/namespace/{namespace}/startup-stats", namespaceHandlers.GetNamespaceStartupStats)
+		r.Get("/namespace/{namespace}/quotas", namespaceHandlers.GetNamespaceQuotas)
+		r.Get("/namespace/{namespace}/events", namespaceHandlers.GetNamespaceEvents)
+		r.Get("/namespace/{namespace}/security", securityHandlers.GetNamespaceSecurityAudit)
+		r.Get("/namespace/{namespace}/allocation", allocationHandlers.GetNamespaceAllocation)
+		r.Get("/namespace/{namespace}/health-score", healthScoreHandler.GetNamespaceHealthScore)
+		r.Get("/namespace/{namespace}/health-score/trends", healthHistoryHandler.GetNamespaceHealthTrends)
 
 		r.Get("/cluster/pod-issues", clusterIssuesHandler.GetClusterIssues)
+		r.Get("/cluster/health-score", healthScoreHandler.GetClusterHealthScore)
+		r.Get("/cluster/health-score/trends", healthHistoryHandler.GetClusterHealthTrends)
 	})
 
 	r.Get("/healthz", handlers.HandleHealth)